@@ -0,0 +1,166 @@
+package archiver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// lifecycleProgress is what has been archived of the lifecycle of a validator.
+type lifecycleProgress struct {
+	archived      uint32
+	queuePosition uint64
+}
+
+func (p *lifecycleProgress) has(t pb.ArchivedValidatorMilestone_Type) bool {
+	return p.archived&(1<<uint(t)) != 0
+}
+
+func (p *lifecycleProgress) add(m *pb.ArchivedValidatorMilestone) {
+	p.archived |= 1 << uint(m.Type)
+	if m.Type == pb.ArchivedValidatorMilestone_QUEUED {
+		p.queuePosition = m.QueuePosition
+	}
+}
+
+// loadLifecycles restores the archived progress of every validator lifecycle. Validators which
+// exist before any lifecycle is archived are caught up with in the first archived epoch.
+func (s *Service) loadLifecycles(ctx context.Context) error {
+	lifecycles, err := s.beaconDB.ArchivedValidatorLifecycles(ctx)
+	if err != nil {
+		return err
+	}
+	s.lifecycles = make([]lifecycleProgress, 0, len(lifecycles))
+	for idx, lifecycle := range lifecycles {
+		s.growLifecycles(int(idx) + 1)
+		for _, m := range lifecycle.Milestones {
+			s.lifecycles[idx].add(m)
+		}
+	}
+	s.catchUpLifecycles = len(lifecycles) == 0
+	return nil
+}
+
+func (s *Service) growLifecycles(n int) {
+	for len(s.lifecycles) < n {
+		s.lifecycles = append(s.lifecycles, lifecycleProgress{})
+	}
+}
+
+// We archive the lifecycle milestones each validator reached by the end of the epoch.
+func (s *Service) archiveValidatorLifecycles(ctx context.Context, headState *state.BeaconState, epoch uint64) error {
+	if s.lifecycles == nil {
+		if err := s.loadLifecycles(ctx); err != nil {
+			return errors.Wrap(err, "could not load validator lifecycles")
+		}
+	}
+	queue, err := activationQueuePositions(headState)
+	if err != nil {
+		return errors.Wrap(err, "could not determine activation queue")
+	}
+	genesisTime := headState.GenesisTime()
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	// Milestones observed while catching up may have happened in an earlier epoch.
+	approximate := s.catchUpLifecycles && epoch > 0
+	milestone := func(t pb.ArchivedValidatorMilestone_Type, epoch uint64) *pb.ArchivedValidatorMilestone {
+		slot := helpers.StartSlot(epoch)
+		return &pb.ArchivedValidatorMilestone{
+			Type:      t,
+			Epoch:     epoch,
+			Slot:      slot,
+			Timestamp: genesisTime + slot*params.BeaconConfig().SecondsPerSlot,
+		}
+	}
+	observed := func(t pb.ArchivedValidatorMilestone_Type) *pb.ArchivedValidatorMilestone {
+		m := milestone(t, epoch)
+		m.Approximate = approximate
+		return m
+	}
+
+	s.growLifecycles(headState.NumValidators())
+	milestones := make(map[uint64][]*pb.ArchivedValidatorMilestone)
+	if err := headState.ReadFromEveryValidator(func(idx int, val *state.ReadOnlyValidator) error {
+		progress := &s.lifecycles[idx]
+		var ms []*pb.ArchivedValidatorMilestone
+		if !progress.has(pb.ArchivedValidatorMilestone_INCLUDED) {
+			ms = append(ms, observed(pb.ArchivedValidatorMilestone_INCLUDED))
+		}
+		if val.ActivationEligibilityEpoch() != farFutureEpoch && !progress.has(pb.ArchivedValidatorMilestone_ELIGIBLE) {
+			ms = append(ms, milestone(pb.ArchivedValidatorMilestone_ELIGIBLE, val.ActivationEligibilityEpoch()))
+		}
+		if pos, ok := queue[uint64(idx)]; ok && pos != progress.queuePosition {
+			m := observed(pb.ArchivedValidatorMilestone_QUEUED)
+			m.QueuePosition = pos
+			ms = append(ms, m)
+		}
+		if val.ActivationEpoch() != farFutureEpoch && !progress.has(pb.ArchivedValidatorMilestone_ACTIVATED) {
+			ms = append(ms, milestone(pb.ArchivedValidatorMilestone_ACTIVATED, val.ActivationEpoch()))
+		}
+		if val.Slashed() && !progress.has(pb.ArchivedValidatorMilestone_SLASHED) {
+			ms = append(ms, observed(pb.ArchivedValidatorMilestone_SLASHED))
+		}
+		if val.ExitEpoch() != farFutureEpoch && !progress.has(pb.ArchivedValidatorMilestone_EXIT_INITIATED) {
+			ms = append(ms, observed(pb.ArchivedValidatorMilestone_EXIT_INITIATED))
+		}
+		if val.ExitEpoch() != farFutureEpoch && !progress.has(pb.ArchivedValidatorMilestone_EXITED) {
+			ms = append(ms, milestone(pb.ArchivedValidatorMilestone_EXITED, val.ExitEpoch()))
+		}
+		if val.WithdrawableEpoch() != farFutureEpoch && !progress.has(pb.ArchivedValidatorMilestone_WITHDRAWABLE) {
+			ms = append(ms, milestone(pb.ArchivedValidatorMilestone_WITHDRAWABLE, val.WithdrawableEpoch()))
+		}
+		if len(ms) != 0 {
+			milestones[uint64(idx)] = ms
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(milestones) != 0 {
+		if err := s.beaconDB.SaveArchivedValidatorMilestones(ctx, milestones); err != nil {
+			return errors.Wrap(err, "could not archive validator milestones")
+		}
+	}
+	for idx, ms := range milestones {
+		for _, m := range ms {
+			s.lifecycles[idx].add(m)
+		}
+	}
+	s.catchUpLifecycles = false
+	return nil
+}
+
+// activationQueuePositions of the validators in the activation queue, starting at 1. The queue
+// holds the validators eligible as of the finalized epoch, ordered by eligibility then index.
+func activationQueuePositions(headState *state.BeaconState) (map[uint64]uint64, error) {
+	finalizedEpoch := headState.FinalizedCheckpointEpoch()
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	type queued struct {
+		idx         uint64
+		eligibility uint64
+	}
+	var queue []queued
+	if err := headState.ReadFromEveryValidator(func(idx int, val *state.ReadOnlyValidator) error {
+		if val.ActivationEligibilityEpoch() <= finalizedEpoch && val.ActivationEpoch() == farFutureEpoch {
+			queue = append(queue, queued{idx: uint64(idx), eligibility: val.ActivationEligibilityEpoch()})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(queue, func(i, j int) bool {
+		if queue[i].eligibility != queue[j].eligibility {
+			return queue[i].eligibility < queue[j].eligibility
+		}
+		return queue[i].idx < queue[j].idx
+	})
+	positions := make(map[uint64]uint64, len(queue))
+	for i, q := range queue {
+		positions[q.idx] = uint64(i) + 1
+	}
+	return positions, nil
+}
@@ -0,0 +1,137 @@
+package archiver
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func milestoneTypes(lifecycle *pb.ArchivedValidatorLifecycle) []pb.ArchivedValidatorMilestone_Type {
+	var types []pb.ArchivedValidatorMilestone_Type
+	for _, m := range lifecycle.Milestones {
+		types = append(types, m.Type)
+	}
+	return types
+}
+
+func TestArchiverService_ArchivesValidatorLifecycles(t *testing.T) {
+	svc, beaconDB := setupService(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	validators := []*ethpb.Validator{
+		// Active since genesis.
+		{ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		// Waiting in the activation queue.
+		{ActivationEligibilityEpoch: 1, ActivationEpoch: farFuture, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		// Slashed and exiting.
+		{Slashed: true, ExitEpoch: 10, WithdrawableEpoch: 20},
+	}
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{
+		Slot:                params.BeaconConfig().SlotsPerEpoch * 3,
+		GenesisTime:         1000,
+		Validators:          validators,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.archiveValidatorLifecycles(ctx, st, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	lifecycle, err := beaconDB.ArchivedValidatorLifecycle(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []pb.ArchivedValidatorMilestone_Type{
+		pb.ArchivedValidatorMilestone_INCLUDED,
+		pb.ArchivedValidatorMilestone_ELIGIBLE,
+		pb.ArchivedValidatorMilestone_QUEUED,
+	}
+	if got := milestoneTypes(lifecycle); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("Wanted milestones %v, received %v", want, got)
+	}
+	if !lifecycle.Milestones[0].Approximate {
+		t.Error("Expected inclusion of a validator archived while catching up to be approximate")
+	}
+	if m := lifecycle.Milestones[1]; m.Epoch != 1 || m.Approximate {
+		t.Errorf("Expected exact eligibility at epoch 1, received %v", m)
+	}
+	if m := lifecycle.Milestones[2]; m.QueuePosition != 1 {
+		t.Errorf("Expected queue position 1, received %d", m.QueuePosition)
+	}
+	wantTime := 1000 + params.BeaconConfig().SlotsPerEpoch*3*params.BeaconConfig().SecondsPerSlot
+	if m := lifecycle.Milestones[0]; m.Timestamp != wantTime {
+		t.Errorf("Expected timestamp %d, received %d", wantTime, m.Timestamp)
+	}
+
+	lifecycle, err = beaconDB.ArchivedValidatorLifecycle(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []pb.ArchivedValidatorMilestone_Type{
+		pb.ArchivedValidatorMilestone_INCLUDED,
+		pb.ArchivedValidatorMilestone_ELIGIBLE,
+		pb.ArchivedValidatorMilestone_ACTIVATED,
+		pb.ArchivedValidatorMilestone_SLASHED,
+		pb.ArchivedValidatorMilestone_EXIT_INITIATED,
+		pb.ArchivedValidatorMilestone_EXITED,
+		pb.ArchivedValidatorMilestone_WITHDRAWABLE,
+	}
+	got := milestoneTypes(lifecycle)
+	if len(got) != len(want) {
+		t.Fatalf("Wanted milestones %v, received %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Wanted milestones %v, received %v", want, got)
+		}
+	}
+
+	// The next epoch activates the queued validator, and adds a new validator.
+	validators[1].ActivationEpoch = 5
+	validators = append(validators, &ethpb.Validator{
+		ActivationEligibilityEpoch: farFuture,
+		ActivationEpoch:            farFuture,
+		ExitEpoch:                  farFuture,
+		WithdrawableEpoch:          farFuture,
+	})
+	st, err = stateTrie.InitializeFromProto(&pb.BeaconState{
+		Slot:                params.BeaconConfig().SlotsPerEpoch * 4,
+		Validators:          validators,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.archiveValidatorLifecycles(ctx, st, 4); err != nil {
+		t.Fatal(err)
+	}
+	lifecycle, err = beaconDB.ArchivedValidatorLifecycle(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := milestoneTypes(lifecycle); len(got) != 4 || got[3] != pb.ArchivedValidatorMilestone_ACTIVATED {
+		t.Errorf("Expected activation to be archived once, received %v", got)
+	}
+	lifecycle, err = beaconDB.ArchivedValidatorLifecycle(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lifecycle.Milestones) != 1 || lifecycle.Milestones[0].Approximate || lifecycle.Milestones[0].Epoch != 4 {
+		t.Errorf("Expected exact inclusion at epoch 4, received %v", lifecycle.Milestones)
+	}
+	lifecycle, err = beaconDB.ArchivedValidatorLifecycle(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lifecycle.Milestones) != 3 {
+		t.Errorf("Expected no new milestones of the active validator, received %v", milestoneTypes(lifecycle))
+	}
+}
@@ -30,6 +30,8 @@ type Service struct {
 	participationFetcher blockchain.ParticipationFetcher
 	stateNotifier        statefeed.Notifier
 	lastArchivedEpoch    uint64
+	lifecycles           []lifecycleProgress
+	catchUpLifecycles    bool
 }
 
 // Config options for the archiver service.
@@ -179,6 +181,10 @@ func (s *Service) run(ctx context.Context) {
 					log.WithError(err).Error("Could not archive validator balances and active indices")
 					continue
 				}
+				if err := s.archiveValidatorLifecycles(ctx, headState, epochToArchive); err != nil {
+					log.WithError(err).Error("Could not archive validator lifecycles")
+					continue
+				}
 				log.WithField(
 					"epoch",
 					epochToArchive,
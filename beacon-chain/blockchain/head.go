@@ -1,15 +1,21 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/metrics"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -53,7 +59,8 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	defer span.End()
 
 	// Do nothing if head hasn't changed.
-	if headRoot == s.headRoot() {
+	oldHeadRoot := s.headRoot()
+	if headRoot == oldHeadRoot {
 		return nil
 	}
 
@@ -108,9 +115,133 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
 
+	// A new head which is not a child of the old head may have orphaned the old head.
+	if oldHeadRoot != params.BeaconConfig().ZeroHash && !bytes.Equal(newHeadBlock.Block.ParentRoot, oldHeadRoot[:]) {
+		if err := s.checkReorg(ctx, oldHeadRoot, headRoot, newHeadBlock.Block); err != nil {
+			log.WithError(err).Debug("Could not check for chain reorg")
+		}
+	}
+
 	return nil
 }
 
+// This finds the common ancestor of the old and the new head. If the old head is not
+// an ancestor of the new head, the chain has reorganized: a reorg event is sent to the
+// state feed and reorg metrics are updated.
+func (s *Service) checkReorg(ctx context.Context, oldRoot [32]byte, newRoot [32]byte, newBlock *ethpb.BeaconBlock) error {
+	oldBlock, err := s.beaconDB.Block(ctx, oldRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve old head block")
+	}
+	// There is nothing to compare against if the old head was never saved.
+	if oldBlock == nil || oldBlock.Block == nil {
+		return nil
+	}
+
+	ancestorRoot, ancestorSlot, err := s.commonAncestor(ctx, oldRoot, oldBlock.Block, newRoot, newBlock)
+	if err != nil {
+		return err
+	}
+	// The new head descends from the old head.
+	if ancestorRoot == oldRoot {
+		return nil
+	}
+
+	depth := oldBlock.Block.Slot - ancestorSlot
+	metrics.ReorgCount.Inc()
+	metrics.ReorgDepth.Observe(float64(depth))
+	log.WithFields(logrus.Fields{
+		"oldHeadRoot":        fmt.Sprintf("%#x", oldRoot),
+		"oldHeadSlot":        oldBlock.Block.Slot,
+		"newHeadRoot":        fmt.Sprintf("%#x", newRoot),
+		"newHeadSlot":        newBlock.Slot,
+		"commonAncestorSlot": ancestorSlot,
+		"depth":              depth,
+	}).Info("Chain reorg occurred")
+
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			OldHeadRoot:        oldRoot,
+			OldHeadSlot:        oldBlock.Block.Slot,
+			NewHeadRoot:        newRoot,
+			NewHeadSlot:        newBlock.Slot,
+			CommonAncestorRoot: ancestorRoot,
+			CommonAncestorSlot: ancestorSlot,
+			Depth:              depth,
+		},
+	})
+	return nil
+}
+
+// This walks back from two blocks, always stepping from the higher of the two, until
+// both walks reach the same block. It returns the root and slot of that block. As the common
+// ancestor cannot precede finality, the walk stops with an error once it passes the slot of the
+// finalized block, or of the lower block if that one is not finalized yet.
+func (s *Service) commonAncestor(
+	ctx context.Context,
+	root1 [32]byte,
+	block1 *ethpb.BeaconBlock,
+	root2 [32]byte,
+	block2 *ethpb.BeaconBlock,
+) ([32]byte, uint64, error) {
+	lowestSlot, err := s.finalizedBlockSlot(ctx)
+	if err != nil {
+		return [32]byte{}, 0, err
+	}
+	if block1.Slot < lowestSlot {
+		lowestSlot = block1.Slot
+	}
+	if block2.Slot < lowestSlot {
+		lowestSlot = block2.Slot
+	}
+	for root1 != root2 {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, ctx.Err()
+		}
+		if block1.Slot < lowestSlot || block2.Slot < lowestSlot {
+			return [32]byte{}, 0, fmt.Errorf("no common ancestor of %#x and %#x since slot %d", root1, root2, lowestSlot)
+		}
+		if block1.Slot >= block2.Slot {
+			root1, block1, err = s.parentBlock(ctx, block1)
+		} else {
+			root2, block2, err = s.parentBlock(ctx, block2)
+		}
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+	}
+	return root1, block1.Slot, nil
+}
+
+// This returns the slot of the finalized block, or 0 if it is not in the DB yet.
+func (s *Service) finalizedBlockSlot(ctx context.Context) (uint64, error) {
+	if s.finalizedCheckpt == nil {
+		return 0, nil
+	}
+	b, err := s.beaconDB.Block(ctx, bytesutil.ToBytes32(s.finalizedCheckpt.Root))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve finalized block")
+	}
+	if b == nil || b.Block == nil {
+		return 0, nil
+	}
+	return b.Block.Slot, nil
+}
+
+// This returns the root and the parent block of the given block.
+func (s *Service) parentBlock(ctx context.Context, b *ethpb.BeaconBlock) ([32]byte, *ethpb.BeaconBlock, error) {
+	parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+	parent, err := s.beaconDB.Block(ctx, parentRoot)
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not retrieve parent block")
+	}
+	if parent == nil || parent.Block == nil {
+		return [32]byte{}, nil, fmt.Errorf("parent block %#x of slot %d is not in the DB", parentRoot, b.Slot)
+	}
+	return parentRoot, parent.Block, nil
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of inital-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
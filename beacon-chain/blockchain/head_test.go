@@ -8,6 +8,8 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -70,3 +72,149 @@ func TestSaveHead_Different(t *testing.T) {
 		t.Error("Head did not change")
 	}
 }
+
+func TestSaveHead_Reorg(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := setupBeaconChain(t, db)
+	ctx := context.Background()
+
+	saveBlock := func(b *ethpb.BeaconBlock) [32]byte {
+		if err := db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: b}); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.HashTreeRoot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	genesisRoot := saveBlock(&ethpb.BeaconBlock{Slot: 0, ParentRoot: make([]byte, 32)})
+	ancestorRoot := saveBlock(&ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:]})
+	orphanRoot := saveBlock(&ethpb.BeaconBlock{Slot: 2, ParentRoot: ancestorRoot[:]})
+	oldHeadRoot := saveBlock(&ethpb.BeaconBlock{Slot: 3, ParentRoot: orphanRoot[:]})
+	newHeadRoot := saveBlock(&ethpb.BeaconBlock{Slot: 4, ParentRoot: ancestorRoot[:]})
+	headState, err := state.InitializeFromProto(&pb.BeaconState{Slot: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, headState, newHeadRoot); err != nil {
+		t.Fatal(err)
+	}
+	service.head = &head{slot: 3, root: oldHeadRoot}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	if err := service.saveHead(ctx, newHeadRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case e := <-stateChannel:
+		if e.Type != statefeed.Reorg {
+			t.Fatalf("Wanted reorg event, received %d", e.Type)
+		}
+		want := &statefeed.ReorgData{
+			OldHeadRoot:        oldHeadRoot,
+			OldHeadSlot:        3,
+			NewHeadRoot:        newHeadRoot,
+			NewHeadSlot:        4,
+			CommonAncestorRoot: ancestorRoot,
+			CommonAncestorSlot: 1,
+			Depth:              2,
+		}
+		if !reflect.DeepEqual(e.Data, want) {
+			t.Errorf("Wanted %+v, received %+v", want, e.Data)
+		}
+	default:
+		t.Fatal("Did not receive reorg event")
+	}
+}
+
+func TestCommonAncestor_BoundedByFinalizedBlock(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := setupBeaconChain(t, db)
+	ctx := context.Background()
+
+	saveBlock := func(b *ethpb.BeaconBlock) [32]byte {
+		if err := db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: b}); err != nil {
+			t.Fatal(err)
+		}
+		r, err := ssz.HashTreeRoot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	genesisRoot := saveBlock(&ethpb.BeaconBlock{Slot: 0, ParentRoot: make([]byte, 32)})
+	finalizedRoot := saveBlock(&ethpb.BeaconBlock{Slot: 5, ParentRoot: genesisRoot[:]})
+	service.finalizedCheckpt = &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}
+
+	// Two blocks building on the finalized block have it as their common ancestor.
+	block1 := &ethpb.BeaconBlock{Slot: 6, ParentRoot: finalizedRoot[:]}
+	block2 := &ethpb.BeaconBlock{Slot: 7, ParentRoot: finalizedRoot[:]}
+	ancestorRoot, ancestorSlot, err := service.commonAncestor(ctx, saveBlock(block1), block1, saveBlock(block2), block2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ancestorRoot != finalizedRoot || ancestorSlot != 5 {
+		t.Errorf("Wanted the finalized block %#x at slot 5, received %#x at slot %d", finalizedRoot, ancestorRoot, ancestorSlot)
+	}
+
+	// Two blocks forking before the finalized block have no common ancestor since finality.
+	block1 = &ethpb.BeaconBlock{Slot: 8, ParentRoot: genesisRoot[:]}
+	block2 = &ethpb.BeaconBlock{Slot: 9, ParentRoot: genesisRoot[:]}
+	if _, _, err := service.commonAncestor(ctx, saveBlock(block1), block1, saveBlock(block2), block2); err == nil {
+		t.Error("Expected an error walking back past the finalized block")
+	}
+}
+
+func TestSaveHead_NoReorgOnDescendant(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	service := setupBeaconChain(t, db)
+	ctx := context.Background()
+
+	oldHeadBlock := &ethpb.BeaconBlock{Slot: 1, ParentRoot: make([]byte, 32)}
+	if err := db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: oldHeadBlock}); err != nil {
+		t.Fatal(err)
+	}
+	oldHeadRoot, _ := ssz.HashTreeRoot(oldHeadBlock)
+	// The new head skips a block, so its parent is not the old head.
+	middleBlock := &ethpb.BeaconBlock{Slot: 2, ParentRoot: oldHeadRoot[:]}
+	if err := db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: middleBlock}); err != nil {
+		t.Fatal(err)
+	}
+	middleRoot, _ := ssz.HashTreeRoot(middleBlock)
+	newHeadBlock := &ethpb.BeaconBlock{Slot: 3, ParentRoot: middleRoot[:]}
+	if err := db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: newHeadBlock}); err != nil {
+		t.Fatal(err)
+	}
+	newHeadRoot, _ := ssz.HashTreeRoot(newHeadBlock)
+	headState, err := state.InitializeFromProto(&pb.BeaconState{Slot: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, headState, newHeadRoot); err != nil {
+		t.Fatal(err)
+	}
+	service.head = &head{slot: 1, root: oldHeadRoot}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	if err := service.saveHead(ctx, newHeadRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case e := <-stateChannel:
+		t.Errorf("Received unexpected event %d", e.Type)
+	default:
+	}
+	if service.headRoot() != newHeadRoot {
+		t.Error("Head did not change")
+	}
+}
@@ -24,6 +24,17 @@ var (
 		Name: "competing_blocks",
 		Help: "The # of blocks received and processed from a competing chain",
 	})
+	// ReorgCount is the number of times the head of the chain moved to a block which does not descend from the previous head.
+	ReorgCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_reorg_total",
+		Help: "The # of chain reorganizations",
+	})
+	// ReorgDepth is the distribution of the number of slots from the common ancestor to the orphaned head.
+	ReorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beacon_reorg_depth",
+		Help:    "The # of slots from the common ancestor to the orphaned head of chain reorganizations",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64, 128},
+	})
 	headFinalizedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "head_finalized_epoch",
 		Help: "Last finalized epoch of the head state",
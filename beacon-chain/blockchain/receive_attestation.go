@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -21,6 +22,7 @@ import (
 type AttestationReceiver interface {
 	ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Attestation) error
 	IsValidAttestation(ctx context.Context, att *ethpb.Attestation) bool
+	AttestationSignatureSet(ctx context.Context, att *ethpb.Attestation) (*bls.SignatureSet, error)
 }
 
 // ReceiveAttestationNoPubsub is a function that defines the operations that are preformed on
@@ -56,6 +58,16 @@ func (s *Service) IsValidAttestation(ctx context.Context, att *ethpb.Attestation
 	return true
 }
 
+// AttestationSignatureSet returns the signature set of the attestation computed against its pre-state,
+// leaving the signature verification to the caller.
+func (s *Service) AttestationSignatureSet(ctx context.Context, att *ethpb.Attestation) (*bls.SignatureSet, error) {
+	baseState, err := s.getAttPreState(ctx, att.Data.Target)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation pre state")
+	}
+	return blocks.AttestationSignatureSet(ctx, baseState, att)
+}
+
 // This processes attestations from the attestation pool to account for validator votes and fork choice.
 func (s *Service) processAttestation() {
 	// Wait for state to be initialized.
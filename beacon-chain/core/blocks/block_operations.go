@@ -765,6 +765,24 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *stateTrie.Beacon
 		return errors.New("attesting indices is not uniquely sorted")
 	}
 
+	sigSet, err := IndexedAttestationSignatureSet(ctx, beaconState, indexedAtt)
+	if err != nil {
+		return err
+	}
+
+	voted := sigSet.Len() > 0
+	if voted && !sigSet.Signatures[0].Verify(sigSet.Messages[0][:], sigSet.PublicKeys[0], sigSet.Domains[0]) {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// IndexedAttestationSignatureSet retrieves the signature of the indexed attestation along with its
+// aggregated attester public key, message and domain, so that it can be verified later in a batch.
+// The returned set is empty if the attestation has no attesting indices. The attesting indices
+// themselves are not validated, see VerifyIndexedAttestation.
+func IndexedAttestationSignatureSet(ctx context.Context, beaconState *stateTrie.BeaconState, indexedAtt *ethpb.IndexedAttestation) (*bls.SignatureSet, error) {
+	indices := indexedAtt.AttestingIndices
 	domain := helpers.Domain(beaconState.Fork(), indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
 	var pubkey *bls.PublicKey
 	var err error
@@ -772,13 +790,13 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *stateTrie.Beacon
 		pubkeyAtIdx := beaconState.PubkeyAtIndex(indices[0])
 		pubkey, err = bls.PublicKeyFromBytes(pubkeyAtIdx[:])
 		if err != nil {
-			return errors.Wrap(err, "could not deserialize validator public key")
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
 		}
 		for i := 1; i < len(indices); i++ {
 			pubkeyAtIdx = beaconState.PubkeyAtIndex(indices[i])
 			pk, err := bls.PublicKeyFromBytes(pubkeyAtIdx[:])
 			if err != nil {
-				return errors.Wrap(err, "could not deserialize validator public key")
+				return nil, errors.Wrap(err, "could not deserialize validator public key")
 			}
 			pubkey.Aggregate(pk)
 		}
@@ -786,19 +804,33 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *stateTrie.Beacon
 
 	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not tree hash att data")
+		return nil, errors.Wrap(err, "could not tree hash att data")
 	}
 
 	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
 	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
 	}
 
-	voted := len(indices) > 0
-	if voted && !sig.Verify(messageHash[:], pubkey, domain) {
-		return ErrSigFailedToVerify
+	set := bls.NewSet()
+	if len(indices) == 0 {
+		return set, nil
 	}
-	return nil
+	return set.Add(sig, pubkey, messageHash, domain), nil
+}
+
+// AttestationSignatureSet converts an attestation into an indexed attestation and returns the
+// signature set required to verify it.
+func AttestationSignatureSet(ctx context.Context, beaconState *stateTrie.BeaconState, att *ethpb.Attestation) (*bls.SignatureSet, error) {
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert to indexed attestation")
+	}
+	return IndexedAttestationSignatureSet(ctx, beaconState, indexedAtt)
 }
 
 // VerifyAttestation converts and attestation into an indexed attestation and verifies
@@ -932,9 +964,7 @@ func ProcessDeposit(
 	index, ok := beaconState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
 	numVals := beaconState.NumValidators()
 	if !ok {
-		domain := bls.ComputeDomain(params.BeaconConfig().DomainDeposit)
-		depositSig := deposit.Data.Signature
-		if err := verifyDepositDataSigningRoot(deposit.Data, pubKey, depositSig, domain); err != nil {
+		if err := VerifyDepositSignature(deposit.Data); err != nil {
 			// Ignore this error as in the spec pseudo code.
 			log.Errorf("Skipping deposit: could not verify deposit data signature: %v", err)
 			return beaconState, nil
@@ -969,6 +999,13 @@ func ProcessDeposit(
 	return beaconState, nil
 }
 
+// VerifyDepositSignature verifies the signature of deposit data over its signing root. Deposits
+// of new validators are skipped by the state transition when it does not verify.
+func VerifyDepositSignature(data *ethpb.Deposit_Data) error {
+	domain := bls.ComputeDomain(params.BeaconConfig().DomainDeposit)
+	return verifyDepositDataSigningRoot(data, data.PublicKey, data.Signature, domain)
+}
+
 func verifyDeposit(beaconState *stateTrie.BeaconState, deposit *ethpb.Deposit) error {
 	// Verify Merkle proof of deposit and deposit trie root.
 	eth1Data := beaconState.Eth1Data()
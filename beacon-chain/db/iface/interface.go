@@ -50,10 +50,16 @@ type ReadOnlyDatabase interface {
 	ArchivedCommitteeInfo(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedCommitteeInfo, error)
 	ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error)
 	ArchivedValidatorParticipation(ctx context.Context, epoch uint64) (*eth.ValidatorParticipation, error)
+	ArchivedValidatorLifecycle(ctx context.Context, validatorIdx uint64) (*ethereum_beacon_p2p_v1.ArchivedValidatorLifecycle, error)
+	ArchivedValidatorLifecycles(ctx context.Context) (map[uint64]*ethereum_beacon_p2p_v1.ArchivedValidatorLifecycle, error)
 	// Deposit contract related handlers.
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	Eth1Headers(ctx context.Context) ([]*db.ETH1Header, error)
+	DepositAudits(ctx context.Context) ([]*db.DepositAudit, error)
+	DepositAuditsByPublicKey(ctx context.Context, publicKey []byte) ([]*db.DepositAudit, error)
+	DepositAuditsBySender(ctx context.Context, sender []byte) ([]*db.DepositAudit, error)
 }
 
 // NoHeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.NoHeadAccessDatabase
@@ -95,10 +101,14 @@ type NoHeadAccessDatabase interface {
 	SaveArchivedCommitteeInfo(ctx context.Context, epoch uint64, info *ethereum_beacon_p2p_v1.ArchivedCommitteeInfo) error
 	SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error
 	SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error
+	SaveArchivedValidatorMilestones(ctx context.Context, milestones map[uint64][]*ethereum_beacon_p2p_v1.ArchivedValidatorMilestone) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	SaveEth1Headers(ctx context.Context, headers []*db.ETH1Header) error
+	DeleteEth1Headers(ctx context.Context, numbers []uint64) error
+	SaveDepositAudits(ctx context.Context, audits []*db.DepositAudit) error
 }
 
 // HeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.HeadAccessDatabase
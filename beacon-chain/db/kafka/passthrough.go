@@ -192,6 +192,16 @@ func (e Exporter) ArchivedValidatorParticipation(ctx context.Context, epoch uint
 	return e.db.ArchivedValidatorParticipation(ctx, epoch)
 }
 
+// ArchivedValidatorLifecycle -- passthrough.
+func (e Exporter) ArchivedValidatorLifecycle(ctx context.Context, validatorIdx uint64) (*ethereum_beacon_p2p_v1.ArchivedValidatorLifecycle, error) {
+	return e.db.ArchivedValidatorLifecycle(ctx, validatorIdx)
+}
+
+// ArchivedValidatorLifecycles -- passthrough.
+func (e Exporter) ArchivedValidatorLifecycles(ctx context.Context) (map[uint64]*ethereum_beacon_p2p_v1.ArchivedValidatorLifecycle, error) {
+	return e.db.ArchivedValidatorLifecycles(ctx)
+}
+
 // DepositContractAddress -- passthrough.
 func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
@@ -272,6 +282,11 @@ func (e Exporter) SaveArchivedValidatorParticipation(ctx context.Context, epoch
 	return e.db.SaveArchivedValidatorParticipation(ctx, epoch, part)
 }
 
+// SaveArchivedValidatorMilestones -- passthrough.
+func (e Exporter) SaveArchivedValidatorMilestones(ctx context.Context, milestones map[uint64][]*ethereum_beacon_p2p_v1.ArchivedValidatorMilestone) error {
+	return e.db.SaveArchivedValidatorMilestones(ctx, milestones)
+}
+
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
@@ -306,3 +321,38 @@ func (e Exporter) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error {
 	return e.db.SavePowchainData(ctx, data)
 }
+
+// Eth1Headers -- passthrough
+func (e Exporter) Eth1Headers(ctx context.Context) ([]*db.ETH1Header, error) {
+	return e.db.Eth1Headers(ctx)
+}
+
+// SaveEth1Headers -- passthrough
+func (e Exporter) SaveEth1Headers(ctx context.Context, headers []*db.ETH1Header) error {
+	return e.db.SaveEth1Headers(ctx, headers)
+}
+
+// DeleteEth1Headers -- passthrough
+func (e Exporter) DeleteEth1Headers(ctx context.Context, numbers []uint64) error {
+	return e.db.DeleteEth1Headers(ctx, numbers)
+}
+
+// DepositAudits -- passthrough
+func (e Exporter) DepositAudits(ctx context.Context) ([]*db.DepositAudit, error) {
+	return e.db.DepositAudits(ctx)
+}
+
+// DepositAuditsByPublicKey -- passthrough
+func (e Exporter) DepositAuditsByPublicKey(ctx context.Context, publicKey []byte) ([]*db.DepositAudit, error) {
+	return e.db.DepositAuditsByPublicKey(ctx, publicKey)
+}
+
+// DepositAuditsBySender -- passthrough
+func (e Exporter) DepositAuditsBySender(ctx context.Context, sender []byte) ([]*db.DepositAudit, error) {
+	return e.db.DepositAuditsBySender(ctx, sender)
+}
+
+// SaveDepositAudits -- passthrough
+func (e Exporter) SaveDepositAudits(ctx context.Context, audits []*db.DepositAudit) error {
+	return e.db.SaveDepositAudits(ctx, audits)
+}
@@ -143,6 +143,73 @@ func (k *Store) SaveArchivedValidatorParticipation(ctx context.Context, epoch ui
 	})
 }
 
+// ArchivedValidatorLifecycle retrieval by validator index.
+func (k *Store) ArchivedValidatorLifecycle(ctx context.Context, validatorIdx uint64) (*pb.ArchivedValidatorLifecycle, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedValidatorLifecycle")
+	defer span.End()
+
+	buf := uint64ToBytes(validatorIdx)
+	var target *pb.ArchivedValidatorLifecycle
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(archivedValidatorLifecyclesBucket)
+		enc := bkt.Get(buf)
+		if enc == nil {
+			return nil
+		}
+		target = &pb.ArchivedValidatorLifecycle{}
+		return decode(enc, target)
+	})
+	return target, err
+}
+
+// ArchivedValidatorLifecycles retrieves the lifecycles of all validators by validator index.
+func (k *Store) ArchivedValidatorLifecycles(ctx context.Context) (map[uint64]*pb.ArchivedValidatorLifecycle, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedValidatorLifecycles")
+	defer span.End()
+
+	lifecycles := make(map[uint64]*pb.ArchivedValidatorLifecycle)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(archivedValidatorLifecyclesBucket)
+		return bkt.ForEach(func(k, enc []byte) error {
+			lifecycle := &pb.ArchivedValidatorLifecycle{}
+			if err := decode(enc, lifecycle); err != nil {
+				return err
+			}
+			lifecycles[binary.LittleEndian.Uint64(k)] = lifecycle
+			return nil
+		})
+	})
+	return lifecycles, err
+}
+
+// SaveArchivedValidatorMilestones appends milestones to the lifecycles of validators, by
+// validator index.
+func (k *Store) SaveArchivedValidatorMilestones(ctx context.Context, milestones map[uint64][]*pb.ArchivedValidatorMilestone) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedValidatorMilestones")
+	defer span.End()
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedValidatorLifecyclesBucket)
+		for idx, ms := range milestones {
+			buf := uint64ToBytes(idx)
+			lifecycle := &pb.ArchivedValidatorLifecycle{}
+			if enc := bucket.Get(buf); enc != nil {
+				if err := decode(enc, lifecycle); err != nil {
+					return err
+				}
+			}
+			lifecycle.Milestones = append(lifecycle.Milestones, ms...)
+			enc, err := encode(lifecycle)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(buf, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func marshalBalances(bals []uint64) []byte {
 	res := make([]byte, len(bals)*8)
 	offset := 0
@@ -164,3 +164,48 @@ func TestStore_ArchivedValidatorParticipation(t *testing.T) {
 		t.Errorf("Wanted %v, received %v", part, retrieved)
 	}
 }
+
+func TestStore_ArchivedValidatorLifecycles(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	included := &pbp2p.ArchivedValidatorMilestone{Type: pbp2p.ArchivedValidatorMilestone_INCLUDED, Epoch: 1, Slot: 8}
+	queued := &pbp2p.ArchivedValidatorMilestone{Type: pbp2p.ArchivedValidatorMilestone_QUEUED, Epoch: 2, Slot: 16, QueuePosition: 3}
+	activated := &pbp2p.ArchivedValidatorMilestone{Type: pbp2p.ArchivedValidatorMilestone_ACTIVATED, Epoch: 6, Slot: 48}
+	if err := db.SaveArchivedValidatorMilestones(ctx, map[uint64][]*pbp2p.ArchivedValidatorMilestone{
+		0: {included, queued},
+		1: {included},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedValidatorMilestones(ctx, map[uint64][]*pbp2p.ArchivedValidatorMilestone{
+		0: {activated},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	retrieved, err := db.ArchivedValidatorLifecycle(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pbp2p.ArchivedValidatorLifecycle{
+		Milestones: []*pbp2p.ArchivedValidatorMilestone{included, queued, activated},
+	}
+	if !proto.Equal(retrieved, want) {
+		t.Errorf("Wanted %v, received %v", want, retrieved)
+	}
+	lifecycles, err := db.ArchivedValidatorLifecycles(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lifecycles) != 2 || !proto.Equal(lifecycles[0], want) || len(lifecycles[1].Milestones) != 1 {
+		t.Errorf("Unexpected lifecycles %v", lifecycles)
+	}
+	missing, err := db.ArchivedValidatorLifecycle(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing != nil {
+		t.Errorf("Expected no lifecycle for an unknown validator, received %v", missing)
+	}
+}
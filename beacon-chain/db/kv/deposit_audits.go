@@ -0,0 +1,167 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"go.opencensus.io/trace"
+)
+
+// SaveDepositAudits saves deposit audits, replacing any saved audit of the same deposit index.
+// Audits are indexed by public key and, once known, by eth1 sender.
+func (k *Store) SaveDepositAudits(ctx context.Context, audits []*db.DepositAudit) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositAudits")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositAuditsBucket)
+		pubkeyBkt := tx.Bucket(depositAuditPubkeyIndicesBucket)
+		senderBkt := tx.Bucket(depositAuditSenderIndicesBucket)
+		for _, audit := range audits {
+			key := depositAuditKey(audit.Index)
+			if enc := bkt.Get(key); enc != nil {
+				prev := &db.DepositAudit{}
+				if err := proto.Unmarshal(enc, prev); err != nil {
+					return err
+				}
+				if err := removeDepositAuditIndex(pubkeyBkt, prev.PublicKey, key); err != nil {
+					return err
+				}
+				if err := removeDepositAuditIndex(senderBkt, prev.Eth1Sender, key); err != nil {
+					return err
+				}
+			}
+			enc, err := proto.Marshal(audit)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(key, enc); err != nil {
+				return err
+			}
+			if err := addDepositAuditIndex(pubkeyBkt, audit.PublicKey, key); err != nil {
+				return err
+			}
+			if err := addDepositAuditIndex(senderBkt, audit.Eth1Sender, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DepositAudits retrieves all saved deposit audits in ascending order of deposit index.
+func (k *Store) DepositAudits(ctx context.Context) ([]*db.DepositAudit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositAudits")
+	defer span.End()
+
+	var audits []*db.DepositAudit
+	err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(depositAuditsBucket).ForEach(func(_, enc []byte) error {
+			audit := &db.DepositAudit{}
+			if err := proto.Unmarshal(enc, audit); err != nil {
+				return err
+			}
+			audits = append(audits, audit)
+			return nil
+		})
+	})
+	return audits, err
+}
+
+// DepositAuditsByPublicKey retrieves the deposit audits of a validator public key in ascending
+// order of deposit index.
+func (k *Store) DepositAuditsByPublicKey(ctx context.Context, publicKey []byte) ([]*db.DepositAudit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositAuditsByPublicKey")
+	defer span.End()
+
+	return k.depositAuditsByIndex(depositAuditPubkeyIndicesBucket, publicKey)
+}
+
+// DepositAuditsBySender retrieves the deposit audits of deposits sent from an eth1 address in
+// ascending order of deposit index.
+func (k *Store) DepositAuditsBySender(ctx context.Context, sender []byte) ([]*db.DepositAudit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositAuditsBySender")
+	defer span.End()
+
+	return k.depositAuditsByIndex(depositAuditSenderIndicesBucket, sender)
+}
+
+func (k *Store) depositAuditsByIndex(indicesBucket []byte, indexKey []byte) ([]*db.DepositAudit, error) {
+	var audits []*db.DepositAudit
+	if len(indexKey) == 0 {
+		return audits, nil
+	}
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositAuditsBucket)
+		keys := tx.Bucket(indicesBucket).Get(indexKey)
+		for i := 0; i+8 <= len(keys); i += 8 {
+			enc := bkt.Get(keys[i : i+8])
+			if enc == nil {
+				continue
+			}
+			audit := &db.DepositAudit{}
+			if err := proto.Unmarshal(enc, audit); err != nil {
+				return err
+			}
+			audits = append(audits, audit)
+		}
+		return nil
+	})
+	return audits, err
+}
+
+// addDepositAuditIndex adds the audit key to the sorted keys stored at the index key.
+func addDepositAuditIndex(bkt *bolt.Bucket, indexKey []byte, key []byte) error {
+	if len(indexKey) == 0 {
+		return nil
+	}
+	keys := bkt.Get(indexKey)
+	pos := len(keys)
+	for i := 0; i+8 <= len(keys); i += 8 {
+		cmp := bytes.Compare(keys[i:i+8], key)
+		if cmp == 0 {
+			return nil
+		}
+		if cmp > 0 {
+			pos = i
+			break
+		}
+	}
+	updated := make([]byte, 0, len(keys)+8)
+	updated = append(updated, keys[:pos]...)
+	updated = append(updated, key...)
+	updated = append(updated, keys[pos:]...)
+	return bkt.Put(indexKey, updated)
+}
+
+// removeDepositAuditIndex removes the audit key from the keys stored at the index key.
+func removeDepositAuditIndex(bkt *bolt.Bucket, indexKey []byte, key []byte) error {
+	if len(indexKey) == 0 {
+		return nil
+	}
+	keys := bkt.Get(indexKey)
+	for i := 0; i+8 <= len(keys); i += 8 {
+		if !bytes.Equal(keys[i:i+8], key) {
+			continue
+		}
+		if len(keys) == 8 {
+			return bkt.Delete(indexKey)
+		}
+		updated := make([]byte, 0, len(keys)-8)
+		updated = append(updated, keys[:i]...)
+		updated = append(updated, keys[i+8:]...)
+		return bkt.Put(indexKey, updated)
+	}
+	return nil
+}
+
+// depositAuditKey encodes the deposit index in big-endian, so audits are iterated in order.
+func depositAuditKey(index uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, index)
+	return key
+}
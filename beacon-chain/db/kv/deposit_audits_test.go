@@ -0,0 +1,82 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_DepositAudits(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	audits := []*dbpb.DepositAudit{
+		{Index: 2, PublicKey: []byte("A"), Classification: dbpb.DepositAudit_TOP_UP},
+		{Index: 0, PublicKey: []byte("A"), Eth1Sender: []byte("x")},
+		{Index: 1, PublicKey: []byte("B"), Eth1Sender: []byte("x"), Classification: dbpb.DepositAudit_INVALID_SIGNATURE},
+	}
+	if err := db.SaveDepositAudits(ctx, audits); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := db.DepositAudits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved) != 3 {
+		t.Fatalf("Expected 3 audits, received %d", len(retrieved))
+	}
+	for i, audit := range retrieved {
+		if audit.Index != uint64(i) {
+			t.Errorf("Expected audit %d to be deposit %d, received %d", i, i, audit.Index)
+		}
+	}
+	byPubkey, err := db.DepositAuditsByPublicKey(ctx, []byte("A"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byPubkey) != 2 || byPubkey[0].Index != 0 || byPubkey[1].Index != 2 {
+		t.Errorf("Unexpected audits by public key %v", byPubkey)
+	}
+
+	// The sender of deposit 2 is resolved later.
+	resolved := &dbpb.DepositAudit{Index: 2, PublicKey: []byte("A"), Eth1Sender: []byte("y"), Classification: dbpb.DepositAudit_TOP_UP}
+	if err := db.SaveDepositAudits(ctx, []*dbpb.DepositAudit{resolved}); err != nil {
+		t.Fatal(err)
+	}
+	bySender, err := db.DepositAuditsBySender(ctx, []byte("y"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bySender) != 1 || !proto.Equal(bySender[0], resolved) {
+		t.Errorf("Unexpected audits by sender %v", bySender)
+	}
+	byPubkey, err = db.DepositAuditsByPublicKey(ctx, []byte("A"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byPubkey) != 2 {
+		t.Errorf("Expected 2 audits by public key after update, received %d", len(byPubkey))
+	}
+
+	// Replacing deposit 1 with another public key and sender moves it between indices.
+	moved := &dbpb.DepositAudit{Index: 1, PublicKey: []byte("C"), Eth1Sender: []byte("y")}
+	if err := db.SaveDepositAudits(ctx, []*dbpb.DepositAudit{moved}); err != nil {
+		t.Fatal(err)
+	}
+	if audits, err := db.DepositAuditsByPublicKey(ctx, []byte("B")); err != nil || len(audits) != 0 {
+		t.Errorf("Expected no audits for replaced public key, received %v, %v", audits, err)
+	}
+	if audits, err := db.DepositAuditsBySender(ctx, []byte("x")); err != nil || len(audits) != 1 || audits[0].Index != 0 {
+		t.Errorf("Unexpected audits for previous sender %v, %v", audits, err)
+	}
+	bySender, err = db.DepositAuditsBySender(ctx, []byte("y"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bySender) != 2 || bySender[0].Index != 1 || bySender[1].Index != 2 {
+		t.Errorf("Unexpected audits by sender %v", bySender)
+	}
+}
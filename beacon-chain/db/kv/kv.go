@@ -94,7 +94,10 @@ func NewKVStore(dirPath string) (*Store, error) {
 			archivedCommitteeInfoBucket,
 			archivedBalancesBucket,
 			archivedValidatorParticipationBucket,
+			archivedValidatorLifecyclesBucket,
 			powchainBucket,
+			eth1HeadersBucket,
+			depositAuditsBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -104,6 +107,8 @@ func NewKVStore(dirPath string) (*Store, error) {
 			blockSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			depositAuditPubkeyIndicesBucket,
+			depositAuditSenderIndicesBucket,
 			// Migration bucket.
 			migrationBucket,
 		)
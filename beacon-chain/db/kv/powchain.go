@@ -2,6 +2,7 @@ package kv
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
@@ -41,3 +42,66 @@ func (k *Store) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 	})
 	return data, err
 }
+
+// SaveEth1Headers saves eth1 headers, replacing any saved header with the same block number.
+func (k *Store) SaveEth1Headers(ctx context.Context, headers []*db.ETH1Header) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveEth1Headers")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(eth1HeadersBucket)
+		for _, h := range headers {
+			enc, err := proto.Marshal(h)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(eth1HeaderKey(h.Number), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Eth1Headers retrieves all saved eth1 headers in ascending order of block number.
+func (k *Store) Eth1Headers(ctx context.Context) ([]*db.ETH1Header, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Eth1Headers")
+	defer span.End()
+
+	var headers []*db.ETH1Header
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(eth1HeadersBucket)
+		return bkt.ForEach(func(_, enc []byte) error {
+			h := &db.ETH1Header{}
+			if err := proto.Unmarshal(enc, h); err != nil {
+				return err
+			}
+			headers = append(headers, h)
+			return nil
+		})
+	})
+	return headers, err
+}
+
+// DeleteEth1Headers deletes the saved eth1 headers with the given block numbers.
+func (k *Store) DeleteEth1Headers(ctx context.Context, numbers []uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteEth1Headers")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(eth1HeadersBucket)
+		for _, n := range numbers {
+			if err := bkt.Delete(eth1HeaderKey(n)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eth1HeaderKey encodes the block number in big-endian, so headers are iterated in order.
+func eth1HeaderKey(number uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, number)
+	return key
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_Eth1Headers(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	headers := []*dbpb.ETH1Header{
+		{Number: 300, BlockHash: []byte("c"), ParentHash: []byte("b"), DepositCount: 3},
+		{Number: 1, BlockHash: []byte("a"), DepositCount: 1},
+		{Number: 256, BlockHash: []byte("b"), ParentHash: []byte("a"), DepositCount: 2},
+	}
+	if err := db.SaveEth1Headers(ctx, headers); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := db.Eth1Headers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved) != 3 {
+		t.Fatalf("Expected 3 headers, received %d", len(retrieved))
+	}
+	for i, want := range []uint64{1, 256, 300} {
+		if retrieved[i].Number != want {
+			t.Errorf("Expected header %d to be block %d, received %d", i, want, retrieved[i].Number)
+		}
+	}
+
+	replacement := &dbpb.ETH1Header{Number: 300, BlockHash: []byte("d"), ParentHash: []byte("b"), DepositCount: 4}
+	if err := db.SaveEth1Headers(ctx, []*dbpb.ETH1Header{replacement}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteEth1Headers(ctx, []uint64{1}); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err = db.Eth1Headers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved) != 2 {
+		t.Fatalf("Expected 2 headers, received %d", len(retrieved))
+	}
+	if !proto.Equal(retrieved[1], replacement) {
+		t.Errorf("Wanted %v, received %v", replacement, retrieved[1])
+	}
+}
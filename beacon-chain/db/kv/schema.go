@@ -20,7 +20,10 @@ var (
 	archivedCommitteeInfoBucket          = []byte("archived-committee-info")
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
+	archivedValidatorLifecyclesBucket    = []byte("archived-validator-lifecycles")
 	powchainBucket                       = []byte("powchain")
+	eth1HeadersBucket                    = []byte("eth1-headers")
+	depositAuditsBucket                  = []byte("deposit-audits")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -31,6 +34,8 @@ var (
 	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
+	depositAuditPubkeyIndicesBucket     = []byte("deposit-audit-pubkey-indices")
+	depositAuditSenderIndicesBucket     = []byte("deposit-audit-sender-indices")
 
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
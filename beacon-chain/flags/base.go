@@ -22,6 +22,21 @@ var (
 		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint.",
 		Value: "wss://goerli.prylabs.net/websocket",
 	}
+	// FallbackWeb3ProviderFlag defines eth1 endpoints to fail over to, in order of preference.
+	FallbackWeb3ProviderFlag = cli.StringSliceFlag{
+		Name:  "fallback-web3provider",
+		Usage: "A fallback mainchain web3 provider endpoint, used when the preferred endpoints are unhealthy. Can be used multiple times, paired by position with --fallback-http-web3provider.",
+	}
+	// FallbackHTTPWeb3ProviderFlag defines the HTTP endpoints of the fallback eth1 endpoints.
+	FallbackHTTPWeb3ProviderFlag = cli.StringSliceFlag{
+		Name:  "fallback-http-web3provider",
+		Usage: "The http endpoint of a fallback mainchain web3 provider. Can be used multiple times, paired by position with --fallback-web3provider.",
+	}
+	// Eth1QuorumFlag requires the eth1 endpoints to agree on deposit logs and blocks.
+	Eth1QuorumFlag = cli.BoolFlag{
+		Name:  "eth1-quorum",
+		Usage: "Only accept deposit logs and blocks which a majority of the configured eth1 endpoints agree on. Requests fail while no such majority answers.",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = cli.StringFlag{
 		Name:  "deposit-contract",
@@ -56,6 +71,27 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// ClientCAFlag defines a flag for the certificate authority of gRPC client certificates.
+	ClientCAFlag = cli.StringFlag{
+		Name:  "tls-client-ca",
+		Usage: "Certificate authority which signs gRPC client certificates. Verified client certificates identify callers by their common name in the rpc-auth-policy file.",
+	}
+	// RPCAuthPolicyFlag defines a flag for the file which authorizes callers of the gRPC server.
+	RPCAuthPolicyFlag = cli.StringFlag{
+		Name:  "rpc-auth-policy",
+		Usage: "YAML file mapping bearer tokens and client certificate common names to the gRPC methods they may call. The gRPC gateway enforces the same policy. All methods are open when not set.",
+	}
+	// RPCRateLimit defines the budget of RPC clients, in cost units per second.
+	RPCRateLimit = cli.Float64Flag{
+		Name:  "rpc-rate-limit",
+		Usage: "Cost units per second each RPC client may spend. Most calls cost 1 unit, expensive list methods cost more and grow with the page size. Clients on the same host, such as a local validator client, are not limited, and remote validator clients can be given their own limit in the RPC auth policy. Rate limiting is disabled by default, or when set to 0.",
+	}
+	// RPCRateLimitBurst defines the most RPC clients can spend at once, in cost units.
+	RPCRateLimitBurst = cli.Float64Flag{
+		Name:  "rpc-rate-limit-burst",
+		Usage: "Cost units an idle RPC client may spend at once.",
+		Value: 1000,
+	}
 	// GRPCGatewayPort enables a gRPC gateway to be exposed for Prysm.
 	GRPCGatewayPort = cli.IntFlag{
 		Name:  "grpc-gateway-port",
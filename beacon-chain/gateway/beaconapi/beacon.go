@@ -0,0 +1,652 @@
+package beaconapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// pageSize of the paginated v1alpha1 requests the standard API makes.
+const pageSize = 250
+
+// maxAncestryEpochs bounds how many epochs of blocks are loaded to resolve a slot to the block in
+// the canonical chain.
+const maxAncestryEpochs = 64
+
+func (s *Server) genesis(ctx context.Context, _ *http.Request, _ map[string]string) (interface{}, error) {
+	genesis, err := s.node.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if genesis.GenesisTime == nil || genesis.GenesisTime.Seconds <= 0 {
+		return nil, notFound("Chain genesis info is not yet known")
+	}
+	return map[string]interface{}{
+		"genesis_time":         strconv.FormatInt(genesis.GenesisTime.Seconds, 10),
+		"genesis_fork_version": marshal(params.BeaconConfig().GenesisForkVersion),
+	}, nil
+}
+
+// block resolves a block ID: head, genesis, finalized, justified, a slot or a 0x prefixed root.
+// A slot resolves to the block of the slot in the canonical chain of the head.
+func (s *Server) block(ctx context.Context, blockID string) (*ethpb.BeaconBlockContainer, error) {
+	var container *ethpb.BeaconBlockContainer
+	var err error
+	switch blockID {
+	case "head", "finalized", "justified":
+		container, err = s.checkpointBlock(ctx, blockID)
+	case "genesis":
+		container, err = s.listBlock(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Genesis{Genesis: true}})
+	default:
+		if strings.HasPrefix(blockID, "0x") {
+			root, decodeErr := decodeHex(blockID)
+			if decodeErr != nil || len(root) != 32 {
+				return nil, badRequest(fmt.Sprintf("Invalid block ID %q", blockID))
+			}
+			container, err = s.listBlock(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root}})
+			break
+		}
+		slot, parseErr := strconv.ParseUint(blockID, 10, 64)
+		if parseErr != nil {
+			return nil, badRequest(fmt.Sprintf("Invalid block ID %q", blockID))
+		}
+		container, err = s.canonicalBlock(ctx, slot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if container == nil {
+		return nil, notFound(fmt.Sprintf("Block %s not found", blockID))
+	}
+	return container, nil
+}
+
+// checkpointBlock returns the head, finalized or justified block of the chain head.
+func (s *Server) checkpointBlock(ctx context.Context, blockID string) (*ethpb.BeaconBlockContainer, error) {
+	head, err := s.beacon.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	root := head.HeadBlockRoot
+	if blockID == "finalized" {
+		root = head.FinalizedBlockRoot
+	} else if blockID == "justified" {
+		root = head.JustifiedBlockRoot
+	}
+	return s.listBlock(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root}})
+}
+
+// canonicalBlock returns the block of the slot in the ancestry of the head, or nil if the slot
+// was skipped. The blocks stored for the slot are checked against the ancestry of the head, or of
+// the finalized block for finalized slots, which is loaded an epoch at a time. Slots more than
+// maxAncestryEpochs behind the block the ancestry is loaded from are not resolved.
+func (s *Server) canonicalBlock(ctx context.Context, slot uint64) (*ethpb.BeaconBlockContainer, error) {
+	head, err := s.beacon.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if slot > head.HeadSlot {
+		return nil, nil
+	}
+	root, anchorSlot := head.HeadBlockRoot, head.HeadSlot
+	if slot <= head.FinalizedSlot {
+		root, anchorSlot = head.FinalizedBlockRoot, head.FinalizedSlot
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	epoch, anchorEpoch := slot/slotsPerEpoch, anchorSlot/slotsPerEpoch
+	if anchorEpoch-epoch >= maxAncestryEpochs {
+		return nil, notFound(fmt.Sprintf("Slot %d is too far behind the chain to be resolved", slot))
+	}
+	candidates, err := s.listBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: slot}})
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+
+	ancestry := make(map[string]*ethpb.BeaconBlockContainer)
+	// Epochs from loadedEpoch up to the anchor epoch have been loaded into the ancestry.
+	loadedEpoch := anchorEpoch + 1
+	for {
+		container, ok := ancestry[string(root)]
+		if !ok {
+			// A parent is always at an earlier slot, so the epochs below are loaded until the
+			// block is found, or the epoch of the slot has been searched.
+			if loadedEpoch <= epoch {
+				return nil, nil
+			}
+			loadedEpoch--
+			blocks, err := s.listBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: loadedEpoch}})
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range blocks {
+				ancestry[string(c.BlockRoot)] = c
+			}
+			continue
+		}
+		blk := container.Block.Block
+		if blk.Slot < slot {
+			return nil, nil
+		}
+		if blk.Slot == slot {
+			break
+		}
+		root = blk.ParentRoot
+	}
+	for _, c := range candidates {
+		if bytes.Equal(c.BlockRoot, root) {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+// listBlocks returns the blocks of every page listed for the request.
+func (s *Server) listBlocks(ctx context.Context, req *ethpb.ListBlocksRequest) ([]*ethpb.BeaconBlockContainer, error) {
+	req.PageSize = pageSize
+	var containers []*ethpb.BeaconBlockContainer
+	for {
+		page, err := s.beacon.ListBlocks(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page.BlockContainers {
+			if c.Block != nil && c.Block.Block != nil {
+				containers = append(containers, c)
+			}
+		}
+		if page.NextPageToken == "" || len(page.BlockContainers) == 0 {
+			return containers, nil
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+// listBlock returns the first block listed for the request, or nil if there is none.
+func (s *Server) listBlock(ctx context.Context, req *ethpb.ListBlocksRequest) (*ethpb.BeaconBlockContainer, error) {
+	res, err := s.beacon.ListBlocks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.BlockContainers) == 0 || res.BlockContainers[0].Block == nil || res.BlockContainers[0].Block.Block == nil {
+		return nil, nil
+	}
+	return res.BlockContainers[0], nil
+}
+
+func (s *Server) blockHeader(ctx context.Context, _ *http.Request, vars map[string]string) (interface{}, error) {
+	container, err := s.block(ctx, vars["block_id"])
+	if err != nil {
+		return nil, err
+	}
+	blk := container.Block.Block
+	bodyRoot, err := ssz.HashTreeRoot(blk.Body)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"root": marshal(container.BlockRoot),
+		"header": map[string]interface{}{
+			"message": map[string]interface{}{
+				"slot":        strconv.FormatUint(blk.Slot, 10),
+				"parent_root": marshal(blk.ParentRoot),
+				"state_root":  marshal(blk.StateRoot),
+				"body_root":   marshal(bodyRoot[:]),
+			},
+			"signature": marshal(container.Block.Signature),
+		},
+	}, nil
+}
+
+func (s *Server) getBlock(ctx context.Context, _ *http.Request, vars map[string]string) (interface{}, error) {
+	container, err := s.block(ctx, vars["block_id"])
+	if err != nil {
+		return nil, err
+	}
+	return marshal(container.Block), nil
+}
+
+func (s *Server) blockRoot(ctx context.Context, _ *http.Request, vars map[string]string) (interface{}, error) {
+	container, err := s.block(ctx, vars["block_id"])
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"root": marshal(container.BlockRoot)}, nil
+}
+
+func (s *Server) submitBlock(ctx context.Context, r *http.Request, _ map[string]string) (interface{}, error) {
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := readBody(r, blk); err != nil {
+		return nil, err
+	}
+	if blk.Block == nil {
+		return nil, badRequest("Request body has no block")
+	}
+	if _, err := s.validator.ProposeBlock(ctx, blk); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// stateEpoch resolves a state ID to the epoch whose data answers for the state. The v1alpha1
+// services serve validator data by epoch, so a slot resolves to its epoch and state roots are
+// not supported.
+func (s *Server) stateEpoch(ctx context.Context, stateID string) (uint64, error) {
+	switch stateID {
+	case "head", "finalized", "justified":
+		head, err := s.beacon.GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			return 0, err
+		}
+		switch stateID {
+		case "finalized":
+			return head.FinalizedEpoch, nil
+		case "justified":
+			return head.JustifiedEpoch, nil
+		}
+		return head.HeadEpoch, nil
+	case "genesis":
+		return 0, nil
+	}
+	if strings.HasPrefix(stateID, "0x") {
+		return 0, badRequest("State roots are not supported as state IDs")
+	}
+	slot, err := strconv.ParseUint(stateID, 10, 64)
+	if err != nil {
+		return 0, badRequest(fmt.Sprintf("Invalid state ID %q", stateID))
+	}
+	return slot / params.BeaconConfig().SlotsPerEpoch, nil
+}
+
+func (s *Server) finalityCheckpoints(ctx context.Context, _ *http.Request, vars map[string]string) (interface{}, error) {
+	if vars["state_id"] != "head" {
+		return nil, badRequest("Finality checkpoints are only available for the head state")
+	}
+	head, err := s.beacon.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := func(epoch uint64, root []byte) interface{} {
+		return marshal(&ethpb.Checkpoint{Epoch: epoch, Root: root})
+	}
+	return map[string]interface{}{
+		"previous_justified": checkpoint(head.PreviousJustifiedEpoch, head.PreviousJustifiedBlockRoot),
+		"current_justified":  checkpoint(head.JustifiedEpoch, head.JustifiedBlockRoot),
+		"finalized":          checkpoint(head.FinalizedEpoch, head.FinalizedBlockRoot),
+	}, nil
+}
+
+// validatorIDs splits the comma separated or repeated values of a query parameter into
+// validator indices and public keys.
+func validatorIDs(r *http.Request, name string) ([]uint64, [][]byte, error) {
+	var indices []uint64
+	var pubKeys [][]byte
+	for _, value := range r.URL.Query()[name] {
+		for _, id := range strings.Split(value, ",") {
+			if id == "" {
+				continue
+			}
+			idx, pubKey, err := parseValidatorID(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if pubKey != nil {
+				pubKeys = append(pubKeys, pubKey)
+			} else {
+				indices = append(indices, idx)
+			}
+		}
+	}
+	return indices, pubKeys, nil
+}
+
+// parseValidatorID parses a validator index, or a 0x prefixed public key.
+func parseValidatorID(id string) (uint64, []byte, error) {
+	if strings.HasPrefix(id, "0x") {
+		pubKey, err := decodeHex(id)
+		if err != nil || len(pubKey) != 48 {
+			return 0, nil, badRequest(fmt.Sprintf("Invalid validator ID %q", id))
+		}
+		return 0, pubKey, nil
+	}
+	idx, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, nil, badRequest(fmt.Sprintf("Invalid validator ID %q", id))
+	}
+	return idx, nil, nil
+}
+
+// balances of the validators at the epoch, by index. All validators are listed when no
+// validator is requested.
+func (s *Server) balances(ctx context.Context, epoch uint64, indices []uint64, pubKeys [][]byte) (map[uint64]uint64, error) {
+	res := make(map[uint64]uint64)
+	req := &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: epoch},
+		Indices:     indices,
+		PublicKeys:  pubKeys,
+		PageSize:    pageSize,
+	}
+	for {
+		page, err := s.beacon.ListValidatorBalances(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range page.Balances {
+			res[b.Index] = b.Balance
+		}
+		if page.NextPageToken == "" || len(page.Balances) == 0 {
+			return res, nil
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+func (s *Server) listValidators(ctx context.Context, r *http.Request, vars map[string]string) (interface{}, error) {
+	epoch, err := s.stateEpoch(ctx, vars["state_id"])
+	if err != nil {
+		return nil, err
+	}
+	indices, pubKeys, err := validatorIDs(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]bool)
+	for _, value := range r.URL.Query()["status"] {
+		for _, st := range strings.Split(value, ",") {
+			statuses[st] = true
+		}
+	}
+
+	var containers []*ethpb.Validators_ValidatorContainer
+	if len(indices) == 0 && len(pubKeys) == 0 {
+		req := &ethpb.ListValidatorsRequest{
+			QueryFilter: &ethpb.ListValidatorsRequest_Epoch{Epoch: epoch},
+			PageSize:    pageSize,
+		}
+		for {
+			page, err := s.beacon.ListValidators(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			containers = append(containers, page.ValidatorList...)
+			if page.NextPageToken == "" || len(page.ValidatorList) == 0 {
+				break
+			}
+			req.PageToken = page.NextPageToken
+		}
+	} else {
+		for _, idx := range indices {
+			c, err := s.validatorByID(ctx, idx, nil)
+			if err != nil {
+				return nil, err
+			}
+			containers = append(containers, c)
+		}
+		for _, pubKey := range pubKeys {
+			c, err := s.validatorByID(ctx, 0, pubKey)
+			if err != nil {
+				return nil, err
+			}
+			containers = append(containers, c)
+		}
+	}
+	balances, err := s.balances(ctx, epoch, indices, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, 0, len(containers))
+	for _, c := range containers {
+		v := validatorData(c, balances[c.Index], epoch)
+		if len(statuses) != 0 && !statuses[v["status"].(string)] {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+func (s *Server) getValidator(ctx context.Context, _ *http.Request, vars map[string]string) (interface{}, error) {
+	epoch, err := s.stateEpoch(ctx, vars["state_id"])
+	if err != nil {
+		return nil, err
+	}
+	idx, pubKey, err := parseValidatorID(vars["validator_id"])
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.validatorByID(ctx, idx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := s.balances(ctx, epoch, []uint64{c.Index}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return validatorData(c, balances[c.Index], epoch), nil
+}
+
+// validatorByID returns the validator with the public key, if given, or else the index.
+func (s *Server) validatorByID(ctx context.Context, idx uint64, pubKey []byte) (*ethpb.Validators_ValidatorContainer, error) {
+	if pubKey != nil {
+		res, err := s.validator.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+		if err != nil {
+			return nil, err
+		}
+		idx = res.Index
+	}
+	val, err := s.beacon.GetValidator(ctx, &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_Index{Index: idx},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.Validators_ValidatorContainer{Index: idx, Validator: val}, nil
+}
+
+// validatorData is the standard representation of a validator, which names its public key
+// pubkey.
+func validatorData(c *ethpb.Validators_ValidatorContainer, balance uint64, epoch uint64) map[string]interface{} {
+	val, _ := marshal(c.Validator).(map[string]interface{})
+	if val != nil {
+		val["pubkey"] = val["public_key"]
+		delete(val, "public_key")
+	}
+	return map[string]interface{}{
+		"index":     strconv.FormatUint(c.Index, 10),
+		"balance":   strconv.FormatUint(balance, 10),
+		"status":    validatorStatus(c.Validator, balance, epoch),
+		"validator": val,
+	}
+}
+
+// validatorStatus of a validator at an epoch, as defined by the standard API.
+func validatorStatus(val *ethpb.Validator, balance uint64, epoch uint64) string {
+	if val == nil {
+		return "pending_initialized"
+	}
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case val.ActivationEligibilityEpoch == farFutureEpoch:
+		return "pending_initialized"
+	case epoch < val.ActivationEpoch:
+		return "pending_queued"
+	case epoch < val.ExitEpoch:
+		if val.Slashed {
+			return "active_slashed"
+		}
+		if val.ExitEpoch != farFutureEpoch {
+			return "active_exiting"
+		}
+		return "active_ongoing"
+	case epoch < val.WithdrawableEpoch:
+		if val.Slashed {
+			return "exited_slashed"
+		}
+		return "exited_unslashed"
+	case balance != 0:
+		return "withdrawal_possible"
+	default:
+		return "withdrawal_done"
+	}
+}
+
+func (s *Server) validatorBalances(ctx context.Context, r *http.Request, vars map[string]string) (interface{}, error) {
+	epoch, err := s.stateEpoch(ctx, vars["state_id"])
+	if err != nil {
+		return nil, err
+	}
+	indices, pubKeys, err := validatorIDs(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	req := &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: epoch},
+		Indices:     indices,
+		PublicKeys:  pubKeys,
+		PageSize:    pageSize,
+	}
+	res := make([]map[string]string, 0)
+	for {
+		page, err := s.beacon.ListValidatorBalances(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range page.Balances {
+			res = append(res, map[string]string{
+				"index":   strconv.FormatUint(b.Index, 10),
+				"balance": strconv.FormatUint(b.Balance, 10),
+			})
+		}
+		if page.NextPageToken == "" || len(page.Balances) == 0 {
+			return res, nil
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+// uint64Query parses an optional unsigned integer query parameter.
+func uint64Query(r *http.Request, name string) (uint64, bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, badRequest(fmt.Sprintf("Invalid %s %q", name, value))
+	}
+	return n, true, nil
+}
+
+func (s *Server) committees(ctx context.Context, r *http.Request, vars map[string]string) (interface{}, error) {
+	epoch, err := s.stateEpoch(ctx, vars["state_id"])
+	if err != nil {
+		return nil, err
+	}
+	if e, ok, err := uint64Query(r, "epoch"); err != nil {
+		return nil, err
+	} else if ok {
+		epoch = e
+	}
+	index, filterIndex, err := uint64Query(r, "index")
+	if err != nil {
+		return nil, err
+	}
+	slot, filterSlot, err := uint64Query(r, "slot")
+	if err != nil {
+		return nil, err
+	}
+	committees, err := s.beacon.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: epoch},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]map[string]interface{}, 0)
+	startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+	for i := uint64(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+		sl := startSlot + i
+		list, ok := committees.Committees[sl]
+		if !ok || (filterSlot && sl != slot) {
+			continue
+		}
+		for committeeIndex, committee := range list.Committees {
+			if filterIndex && uint64(committeeIndex) != index {
+				continue
+			}
+			res = append(res, map[string]interface{}{
+				"index":      strconv.Itoa(committeeIndex),
+				"slot":       strconv.FormatUint(sl, 10),
+				"validators": marshal(committee.ValidatorIndices),
+			})
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) poolAttestations(ctx context.Context, r *http.Request, _ map[string]string) (interface{}, error) {
+	slot, filterSlot, err := uint64Query(r, "slot")
+	if err != nil {
+		return nil, err
+	}
+	index, filterIndex, err := uint64Query(r, "committee_index")
+	if err != nil {
+		return nil, err
+	}
+	req := &ethpb.AttestationPoolRequest{PageSize: pageSize}
+	res := make([]interface{}, 0)
+	for {
+		page, err := s.beacon.AttestationPool(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, att := range page.Attestations {
+			if att.Data == nil || (filterSlot && att.Data.Slot != slot) || (filterIndex && att.Data.CommitteeIndex != index) {
+				continue
+			}
+			res = append(res, marshal(att))
+		}
+		if page.NextPageToken == "" || len(page.Attestations) == 0 {
+			return res, nil
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+func (s *Server) submitAttestations(ctx context.Context, r *http.Request, _ map[string]string) (interface{}, error) {
+	var atts []*ethpb.Attestation
+	if err := readBody(r, &atts); err != nil {
+		return nil, err
+	}
+	var failures []string
+	for i, att := range atts {
+		if _, err := s.validator.ProposeAttestation(ctx, att); err != nil {
+			failures = append(failures, fmt.Sprintf("attestation %d: %v", i, err))
+		}
+	}
+	if len(failures) != 0 {
+		return nil, badRequest("Some attestations failed: " + strings.Join(failures, "; "))
+	}
+	return nil, nil
+}
+
+func (s *Server) submitVoluntaryExit(ctx context.Context, r *http.Request, _ map[string]string) (interface{}, error) {
+	exit := &ethpb.SignedVoluntaryExit{}
+	if err := readBody(r, exit); err != nil {
+		return nil, err
+	}
+	if exit.Exit == nil {
+		return nil, badRequest("Request body has no voluntary exit")
+	}
+	if _, err := s.validator.ProposeExit(ctx, exit); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
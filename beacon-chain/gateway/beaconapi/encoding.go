@@ -0,0 +1,190 @@
+package beaconapi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The standard API encodes integers as decimal strings and byte strings as 0x prefixed hex.
+// Objects are encoded with the snake case names of their fields, which match the SSZ container
+// definitions of the specification. Signed containers wrap their object in a message field.
+
+// encode converts a value, typically a generated protobuf message, into a value which encodes
+// to JSON following the conventions of the standard API.
+func encode(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return encode(v.Elem())
+	case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uint:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return "0x" + hex.EncodeToString(v.Bytes())
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = encode(v.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		fields := protoFields(v.Type())
+		obj := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			obj[f.name] = encode(v.Field(f.index))
+		}
+		return obj
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+// decode sets a value, typically a generated protobuf message, from JSON following the
+// conventions of the standard API.
+func decode(raw json.RawMessage, v reflect.Value) error {
+	if string(raw) == "null" {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decode(raw, v.Elem())
+	case reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uint:
+		s, err := decodeString(raw)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
+		s, err := decodeString(raw)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Bool:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.String:
+		s, err := decodeString(raw)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, err := decodeString(raw)
+			if err != nil {
+				return err
+			}
+			b, err := decodeHex(s)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(b)
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decode(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return err
+		}
+		for _, f := range protoFields(v.Type()) {
+			fieldRaw, ok := obj[f.name]
+			if !ok {
+				continue
+			}
+			if err := decode(fieldRaw, v.Field(f.index)); err != nil {
+				return fmt.Errorf("%s: %v", f.name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("cannot decode into %s", v.Type())
+	}
+	return nil
+}
+
+func decodeString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("%q is not 0x prefixed hex", s)
+	}
+	return hex.DecodeString(s[2:])
+}
+
+type protoField struct {
+	index int
+	name  string
+}
+
+// protoFields returns the fields of a generated protobuf message with their original names.
+// The object of a signed container, which has only the object and its signature, is named
+// message.
+func protoFields(t reflect.Type) []protoField {
+	var fields []protoField
+	hasSignature := false
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+		for _, part := range strings.Split(tag, ",") {
+			if strings.HasPrefix(part, "name=") {
+				name := strings.TrimPrefix(part, "name=")
+				fields = append(fields, protoField{index: i, name: name})
+				hasSignature = hasSignature || name == "signature"
+			}
+		}
+	}
+	if hasSignature && len(fields) == 2 {
+		for i, f := range fields {
+			ft := t.Field(f.index).Type
+			if f.name != "signature" && ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct {
+				fields[i].name = "message"
+			}
+		}
+	}
+	return fields
+}
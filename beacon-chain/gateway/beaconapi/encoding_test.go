@@ -0,0 +1,88 @@
+package beaconapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testCheckpoint struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root  []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+type testSignedCheckpoint struct {
+	Checkpoint    *testCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Signature     []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_sizecache int32           `json:"-"`
+}
+
+type testVotes struct {
+	Slashed     bool              `protobuf:"varint,1,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	Checkpoints []*testCheckpoint `protobuf:"bytes,2,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	Indices     []uint64          `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func TestEncode_StandardConventions(t *testing.T) {
+	signed := &testSignedCheckpoint{
+		Checkpoint: &testCheckpoint{Epoch: 12, Root: []byte{0xab, 0xcd}},
+		Signature:  []byte{0x01},
+	}
+	enc, err := json.Marshal(marshal(signed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"message":{"epoch":"12","root":"0xabcd"},"signature":"0x01"}`
+	if string(enc) != want {
+		t.Errorf("Wanted %s, received %s", want, enc)
+	}
+
+	votes := &testVotes{Slashed: true, Checkpoints: []*testCheckpoint{{Epoch: 1}}, Indices: []uint64{3, 4}}
+	enc, err = json.Marshal(marshal(votes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `{"checkpoints":[{"epoch":"1","root":"0x"}],"indices":["3","4"],"slashed":true}`
+	if string(enc) != want {
+		t.Errorf("Wanted %s, received %s", want, enc)
+	}
+}
+
+func TestDecode_RoundTrip(t *testing.T) {
+	signed := &testSignedCheckpoint{
+		Checkpoint: &testCheckpoint{Epoch: 12, Root: []byte{0xab, 0xcd}},
+		Signature:  []byte{0x01},
+	}
+	enc, err := json.Marshal(marshal(signed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &testSignedCheckpoint{}
+	if err := decode(enc, reflect.ValueOf(decoded).Elem()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(signed, decoded) {
+		t.Errorf("Wanted %v, received %v", signed, decoded)
+	}
+
+	var indices []uint64
+	if err := decode([]byte(`["1","2"]`), reflect.ValueOf(&indices).Elem()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(indices, []uint64{1, 2}) {
+		t.Errorf("Wanted [1 2], received %v", indices)
+	}
+}
+
+func TestDecode_RejectsNonStandardValues(t *testing.T) {
+	tests := []string{
+		`{"message":{"epoch":12}}`,
+		`{"message":{"root":"abcd"}}`,
+		`{"signature":"0xzz"}`,
+	}
+	for _, tt := range tests {
+		if err := decode([]byte(tt), reflect.ValueOf(&testSignedCheckpoint{}).Elem()); err == nil {
+			t.Errorf("Expected an error decoding %s", tt)
+		}
+	}
+}
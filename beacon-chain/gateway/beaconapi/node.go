@@ -0,0 +1,92 @@
+package beaconapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+func (s *Server) nodeVersion(ctx context.Context, _ *http.Request, _ map[string]string) (interface{}, error) {
+	v, err := s.node.GetVersion(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"version": v.Version}, nil
+}
+
+func (s *Server) nodeSyncing(ctx context.Context, _ *http.Request, _ map[string]string) (interface{}, error) {
+	syncStatus, err := s.node.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	head, err := s.beacon.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := s.node.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	var distance uint64
+	if genesis.GenesisTime != nil {
+		if now := uint64(roughtime.Now().Unix()); now > uint64(genesis.GenesisTime.Seconds) {
+			currentSlot := (now - uint64(genesis.GenesisTime.Seconds)) / params.BeaconConfig().SecondsPerSlot
+			if currentSlot > head.HeadSlot {
+				distance = currentSlot - head.HeadSlot
+			}
+		}
+	}
+	return map[string]interface{}{
+		"head_slot":     strconv.FormatUint(head.HeadSlot, 10),
+		"sync_distance": strconv.FormatUint(distance, 10),
+		"is_syncing":    syncStatus.Syncing,
+	}, nil
+}
+
+// nodeHealth reports the health of the node with the status code only: 200 when the node is
+// synced, 206 while it is syncing and 503 when it cannot be reached.
+func (s *Server) nodeHealth(ctx context.Context, _ *http.Request, _ map[string]string) (interface{}, error) {
+	syncStatus, err := s.node.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		return noContent(http.StatusServiceUnavailable), nil
+	}
+	if syncStatus.Syncing {
+		return noContent(http.StatusPartialContent), nil
+	}
+	return noContent(http.StatusOK), nil
+}
+
+func (s *Server) nodePeers(ctx context.Context, _ *http.Request, _ map[string]string) (interface{}, error) {
+	peers, err := s.node.ListPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]map[string]string, 0, len(peers.Peers))
+	for _, p := range peers.Peers {
+		// Peer addresses end with the peer ID, as /p2p/<id>.
+		address, peerID := p.Address, ""
+		if i := strings.LastIndex(p.Address, "/p2p/"); i >= 0 {
+			address, peerID = p.Address[:i], p.Address[i+len("/p2p/"):]
+		}
+		res = append(res, map[string]string{
+			"peer_id":               peerID,
+			"last_seen_p2p_address": address,
+			"state":                 "connected",
+			"direction":             strings.ToLower(p.Direction.String()),
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) configSpec(ctx context.Context, _ *http.Request, _ map[string]string) (interface{}, error) {
+	cfg, err := s.beacon.GetBeaconConfig(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Config, nil
+}
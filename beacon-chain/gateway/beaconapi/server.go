@@ -0,0 +1,230 @@
+// Package beaconapi serves the standard beacon node API shared by the Ethereum 2.0 clients,
+// alongside the v1alpha1 gRPC gateway. Requests are served by calling the v1alpha1 gRPC
+// services of the beacon node, so they are authorized and rate limited like gateway requests.
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PathPrefix is the HTTP path prefix of the standard API.
+const PathPrefix = "/eth/v1/"
+
+// maxBodySize is the largest request body accepted, enough for a block with full operations.
+const maxBodySize = 10 << 20
+
+// handler serves a route. The returned data is written as the data field of the response,
+// unless it is nil, in which case the response has no body.
+type handler func(ctx context.Context, r *http.Request, vars map[string]string) (interface{}, error)
+
+type route struct {
+	method   string
+	segments []string
+	handle   handler
+}
+
+// apiError is an error with the HTTP status code it is reported with.
+type apiError struct {
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(message string) error {
+	return &apiError{code: http.StatusBadRequest, message: message}
+}
+
+func notFound(message string) error {
+	return &apiError{code: http.StatusNotFound, message: message}
+}
+
+// noContent is returned by handlers which report their result with a status code only.
+type noContent int
+
+// Server serves the standard API from the v1alpha1 gRPC services.
+type Server struct {
+	node      ethpb.NodeClient
+	beacon    ethpb.BeaconChainClient
+	validator ethpb.BeaconNodeValidatorClient
+	routes    []route
+}
+
+// NewServer returns a standard API server calling the gRPC services of the connection.
+func NewServer(conn *grpc.ClientConn) *Server {
+	return newServer(ethpb.NewNodeClient(conn), ethpb.NewBeaconChainClient(conn), ethpb.NewBeaconNodeValidatorClient(conn))
+}
+
+func newServer(node ethpb.NodeClient, beacon ethpb.BeaconChainClient, validator ethpb.BeaconNodeValidatorClient) *Server {
+	s := &Server{
+		node:      node,
+		beacon:    beacon,
+		validator: validator,
+	}
+	s.handle(http.MethodGet, "node/version", s.nodeVersion)
+	s.handle(http.MethodGet, "node/syncing", s.nodeSyncing)
+	s.handle(http.MethodGet, "node/health", s.nodeHealth)
+	s.handle(http.MethodGet, "node/peers", s.nodePeers)
+	s.handle(http.MethodGet, "config/spec", s.configSpec)
+	s.handle(http.MethodGet, "beacon/genesis", s.genesis)
+	s.handle(http.MethodGet, "beacon/headers/{block_id}", s.blockHeader)
+	s.handle(http.MethodGet, "beacon/blocks/{block_id}", s.getBlock)
+	s.handle(http.MethodGet, "beacon/blocks/{block_id}/root", s.blockRoot)
+	s.handle(http.MethodPost, "beacon/blocks", s.submitBlock)
+	s.handle(http.MethodGet, "beacon/states/{state_id}/finality_checkpoints", s.finalityCheckpoints)
+	s.handle(http.MethodGet, "beacon/states/{state_id}/validators", s.listValidators)
+	s.handle(http.MethodGet, "beacon/states/{state_id}/validators/{validator_id}", s.getValidator)
+	s.handle(http.MethodGet, "beacon/states/{state_id}/validator_balances", s.validatorBalances)
+	s.handle(http.MethodGet, "beacon/states/{state_id}/committees", s.committees)
+	s.handle(http.MethodGet, "beacon/pool/attestations", s.poolAttestations)
+	s.handle(http.MethodPost, "beacon/pool/attestations", s.submitAttestations)
+	s.handle(http.MethodPost, "beacon/pool/voluntary_exits", s.submitVoluntaryExit)
+	s.handle(http.MethodGet, "validator/duties/attester/{epoch}", s.attesterDuties)
+	s.handle(http.MethodPost, "validator/duties/attester/{epoch}", s.attesterDuties)
+	s.handle(http.MethodGet, "validator/duties/proposer/{epoch}", s.proposerDuties)
+	s.handle(http.MethodGet, "validator/attestation_data", s.attestationData)
+	s.handle(http.MethodGet, "validator/blocks/{slot}", s.produceBlock)
+	return s
+}
+
+func (s *Server) handle(method string, path string, h handler) {
+	s.routes = append(s.routes, route{method: method, segments: strings.Split(path, "/"), handle: h})
+}
+
+// ServeHTTP routes a request under the path prefix to its handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, PathPrefix), "/"), "/")
+	pathFound := false
+	for _, rt := range s.routes {
+		params, ok := match(rt.segments, segments)
+		if !ok {
+			continue
+		}
+		pathFound = true
+		if rt.method != r.Method {
+			continue
+		}
+		ctx := outgoingContext(r)
+		data, err := rt.handle(ctx, r, params)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeData(w, data)
+		return
+	}
+	if pathFound {
+		writeError(w, &apiError{code: http.StatusMethodNotAllowed, message: "Method not allowed"})
+		return
+	}
+	writeError(w, notFound("Route not found"))
+}
+
+// match returns the path parameters of the segments, if they match the route.
+func match(route []string, segments []string) (map[string]string, bool) {
+	if len(route) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, seg := range route {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			if segments[i] == "" {
+				return nil, false
+			}
+			params[strings.Trim(seg, "{}")] = segments[i]
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// outgoingContext forwards the credentials and address of the caller to the gRPC server, as the
+// gRPC gateway does.
+func outgoingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		md.Set("authorization", auth)
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		md.Set("x-forwarded-for", host)
+	}
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	if code, ok := data.(noContent); ok {
+		w.WriteHeader(int(code))
+		return
+	}
+	if data == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	message := err.Error()
+	if e, ok := err.(*apiError); ok {
+		code = e.code
+	} else if st, ok := status.FromError(err); ok {
+		message = st.Message()
+		switch st.Code() {
+		case codes.InvalidArgument, codes.OutOfRange:
+			code = http.StatusBadRequest
+		case codes.NotFound:
+			code = http.StatusNotFound
+		case codes.Unauthenticated:
+			code = http.StatusUnauthorized
+		case codes.PermissionDenied:
+			code = http.StatusForbidden
+		case codes.ResourceExhausted:
+			code = http.StatusTooManyRequests
+		case codes.Unavailable:
+			code = http.StatusServiceUnavailable
+		}
+	}
+	writeJSON(w, code, map[string]interface{}{"code": code, "message": message})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Debug("Could not write response")
+	}
+}
+
+// readBody decodes the JSON request body into a protobuf message, or a slice of them.
+func readBody(r *http.Request, v interface{}) error {
+	var raw json.RawMessage
+	if err := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBodySize)).Decode(&raw); err != nil {
+		return badRequest("Could not read request body: " + err.Error())
+	}
+	if err := decode(raw, reflect.ValueOf(v).Elem()); err != nil {
+		return badRequest("Invalid request body: " + err.Error())
+	}
+	return nil
+}
+
+// marshal encodes a protobuf message following the conventions of the standard API.
+func marshal(v interface{}) interface{} {
+	return encode(reflect.ValueOf(v))
+}
@@ -0,0 +1,316 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeNodeClient struct {
+	ethpb.NodeClient
+	syncing bool
+	err     error
+	md      metadata.MD
+}
+
+func (f *fakeNodeClient) GetSyncStatus(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ethpb.SyncStatus{Syncing: f.syncing}, nil
+}
+
+func (f *fakeNodeClient) GetGenesis(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.Genesis, error) {
+	return &ethpb.Genesis{GenesisTime: &ptypes.Timestamp{Seconds: 1587000000}}, nil
+}
+
+type fakeBeaconChainClient struct {
+	ethpb.BeaconChainClient
+	validators      []*ethpb.Validator
+	balances        []uint64
+	blocks          map[string]*ethpb.SignedBeaconBlock
+	headSlot        uint64
+	headRoot        []byte
+	finalizedRoot   []byte
+	listBlocksCalls int
+}
+
+func (f *fakeBeaconChainClient) GetChainHead(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{
+		HeadSlot:           f.headSlot,
+		HeadEpoch:          2,
+		HeadBlockRoot:      f.headRoot,
+		FinalizedEpoch:     1,
+		FinalizedBlockRoot: f.finalizedRoot,
+	}, nil
+}
+
+func (f *fakeBeaconChainClient) ListBlocks(_ context.Context, req *ethpb.ListBlocksRequest, _ ...grpc.CallOption) (*ethpb.ListBlocksResponse, error) {
+	f.listBlocksCalls++
+	res := &ethpb.ListBlocksResponse{}
+	for root, blk := range f.blocks {
+		var match bool
+		switch q := req.QueryFilter.(type) {
+		case *ethpb.ListBlocksRequest_Root:
+			match = root == string(q.Root)
+		case *ethpb.ListBlocksRequest_Slot:
+			match = blk.Block.Slot == q.Slot
+		case *ethpb.ListBlocksRequest_Epoch:
+			match = blk.Block.Slot/params.BeaconConfig().SlotsPerEpoch == q.Epoch
+		}
+		if match {
+			res.BlockContainers = append(res.BlockContainers, &ethpb.BeaconBlockContainer{Block: blk, BlockRoot: []byte(root)})
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeBeaconChainClient) GetValidator(_ context.Context, req *ethpb.GetValidatorRequest, _ ...grpc.CallOption) (*ethpb.Validator, error) {
+	idx := req.QueryFilter.(*ethpb.GetValidatorRequest_Index).Index
+	if idx >= uint64(len(f.validators)) {
+		return nil, status.Error(codes.NotFound, "No validator with index")
+	}
+	return f.validators[idx], nil
+}
+
+// ListValidators serves the validators in pages of one, to exercise pagination.
+func (f *fakeBeaconChainClient) ListValidators(_ context.Context, req *ethpb.ListValidatorsRequest, _ ...grpc.CallOption) (*ethpb.Validators, error) {
+	start := 0
+	if req.PageToken != "" {
+		start = int(req.PageToken[0] - '0')
+	}
+	res := &ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{{Index: uint64(start), Validator: f.validators[start]}},
+	}
+	if start+1 < len(f.validators) {
+		res.NextPageToken = string(rune('0' + start + 1))
+	}
+	return res, nil
+}
+
+func (f *fakeBeaconChainClient) ListValidatorBalances(_ context.Context, req *ethpb.ListValidatorBalancesRequest, _ ...grpc.CallOption) (*ethpb.ValidatorBalances, error) {
+	res := &ethpb.ValidatorBalances{}
+	for i, b := range f.balances {
+		requested := len(req.Indices) == 0
+		for _, idx := range req.Indices {
+			requested = requested || idx == uint64(i)
+		}
+		if requested {
+			res.Balances = append(res.Balances, &ethpb.ValidatorBalances_Balance{Index: uint64(i), Balance: b})
+		}
+	}
+	return res, nil
+}
+
+type fakeValidatorClient struct {
+	ethpb.BeaconNodeValidatorClient
+	exits []*ethpb.SignedVoluntaryExit
+}
+
+func (f *fakeValidatorClient) ProposeExit(_ context.Context, exit *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	f.exits = append(f.exits, exit)
+	return &ptypes.Empty{}, nil
+}
+
+func testServer() (*Server, *fakeNodeClient, *fakeValidatorClient) {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	node := &fakeNodeClient{}
+	beacon := &fakeBeaconChainClient{
+		validators: []*ethpb.Validator{
+			{PublicKey: []byte{1}, ActivationEligibilityEpoch: 0, ActivationEpoch: 0, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+			{PublicKey: []byte{2}, ActivationEligibilityEpoch: 1, ActivationEpoch: 5, ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+		},
+		balances: []uint64{32000000000, 31000000000},
+		headSlot: 70,
+	}
+	validator := &fakeValidatorClient{}
+	return newServer(node, beacon, validator), node, validator
+}
+
+func serve(s *Server, method string, target string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+func TestServer_Routing(t *testing.T) {
+	s, _, _ := testServer()
+	if w := serve(s, http.MethodGet, "/eth/v1/node/unknown", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Wanted status %d for an unknown route, received %d", http.StatusNotFound, w.Code)
+	}
+	if w := serve(s, http.MethodDelete, "/eth/v1/node/health", ""); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Wanted status %d for an unsupported method, received %d", http.StatusMethodNotAllowed, w.Code)
+	}
+	w := serve(s, http.MethodGet, "/eth/v1/beacon/states/head/validators/7", "")
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Wanted status %d for a missing validator, received %d", http.StatusNotFound, w.Code)
+	}
+	var errRes struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &errRes); err != nil {
+		t.Fatal(err)
+	}
+	if errRes.Code != http.StatusNotFound || errRes.Message != "No validator with index" {
+		t.Errorf("Unexpected error response %s", w.Body.String())
+	}
+}
+
+func TestServer_NodeHealth(t *testing.T) {
+	s, node, _ := testServer()
+	r := httptest.NewRequest(http.MethodGet, "/eth/v1/node/health", nil)
+	r.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("Wanted status %d, received %d", http.StatusOK, w.Code)
+	}
+	if auth := node.md.Get("authorization"); len(auth) != 1 || auth[0] != "Bearer token" {
+		t.Errorf("Expected the authorization header to be forwarded, received %v", node.md)
+	}
+
+	node.syncing = true
+	if w := serve(s, http.MethodGet, "/eth/v1/node/health", ""); w.Code != http.StatusPartialContent {
+		t.Errorf("Wanted status %d while syncing, received %d", http.StatusPartialContent, w.Code)
+	}
+	node.err = status.Error(codes.Unavailable, "down")
+	if w := serve(s, http.MethodGet, "/eth/v1/node/health", ""); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Wanted status %d when unavailable, received %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
+
+func TestServer_ListValidators(t *testing.T) {
+	s, _, _ := testServer()
+	w := serve(s, http.MethodGet, "/eth/v1/beacon/states/head/validators?status=pending_queued", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, received %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var res struct {
+		Data []struct {
+			Index     string            `json:"index"`
+			Balance   string            `json:"balance"`
+			Status    string            `json:"status"`
+			Validator map[string]string `json:"validator"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Data) != 1 {
+		t.Fatalf("Wanted 1 pending validator, received %d", len(res.Data))
+	}
+	v := res.Data[0]
+	if v.Index != "1" || v.Balance != "31000000000" || v.Status != "pending_queued" {
+		t.Errorf("Unexpected validator %+v", v)
+	}
+	if v.Validator["pubkey"] != "0x02" || v.Validator["activation_epoch"] != "5" {
+		t.Errorf("Unexpected validator record %v", v.Validator)
+	}
+}
+
+func TestServer_SubmitVoluntaryExit(t *testing.T) {
+	s, _, validator := testServer()
+	body := `{"message":{"epoch":"3","validator_index":"1"},"signature":"0x0102"}`
+	if w := serve(s, http.MethodPost, "/eth/v1/beacon/pool/voluntary_exits", body); w.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, received %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if len(validator.exits) != 1 {
+		t.Fatalf("Wanted 1 proposed exit, received %d", len(validator.exits))
+	}
+	exit := validator.exits[0]
+	if exit.Exit.Epoch != 3 || exit.Exit.ValidatorIndex != 1 || len(exit.Signature) != 2 {
+		t.Errorf("Unexpected exit %v", exit)
+	}
+
+	if w := serve(s, http.MethodPost, "/eth/v1/beacon/pool/voluntary_exits", `{"message":{"epoch":3}}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Wanted status %d for an invalid exit, received %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestServer_BlockAtSlotFollowsCanonicalChain(t *testing.T) {
+	s, _, _ := testServer()
+	beacon := s.beacon.(*fakeBeaconChainClient)
+	block := func(slot uint64, parent byte) *ethpb.SignedBeaconBlock {
+		return &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: []byte{parent}}}
+	}
+	// The block at slot 2 is orphaned by the head at slot 3, which builds on the block at slot 1.
+	beacon.blocks = map[string]*ethpb.SignedBeaconBlock{
+		"\x00": block(0, 0xff),
+		"\x01": block(1, 0),
+		"\x02": block(2, 1),
+		"\x03": block(3, 1),
+	}
+	beacon.headRoot = []byte{3}
+	beacon.finalizedRoot = []byte{0}
+
+	for slot, root := range map[string]byte{"0": 0, "1": 1, "3": 3} {
+		container, err := s.block(context.Background(), slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(container.BlockRoot) != 1 || container.BlockRoot[0] != root {
+			t.Errorf("Wanted the block at slot %s to have root %d, received %v", slot, root, container.BlockRoot)
+		}
+	}
+	for _, slot := range []string{"2", "4"} {
+		if _, err := s.block(context.Background(), slot); err == nil {
+			t.Errorf("Expected no canonical block at slot %s", slot)
+		}
+	}
+}
+
+func TestServer_BlockAtSlotTooFarBehind(t *testing.T) {
+	s, _, _ := testServer()
+	beacon := s.beacon.(*fakeBeaconChainClient)
+	beacon.blocks = map[string]*ethpb.SignedBeaconBlock{
+		"\x01": {Block: &ethpb.BeaconBlock{Slot: 1}},
+	}
+	beacon.headRoot = []byte{1}
+	beacon.headSlot = maxAncestryEpochs * params.BeaconConfig().SlotsPerEpoch
+
+	_, err := s.block(context.Background(), "1")
+	if apiErr, ok := err.(*apiError); !ok || apiErr.code != http.StatusNotFound {
+		t.Errorf("Expected not found for a slot beyond the ancestry bound, received %v", err)
+	}
+	if beacon.listBlocksCalls != 0 {
+		t.Errorf("Expected no blocks to be listed, received %d calls", beacon.listBlocksCalls)
+	}
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		val     *ethpb.Validator
+		balance uint64
+		want    string
+	}{
+		{&ethpb.Validator{ActivationEligibilityEpoch: farFutureEpoch, ActivationEpoch: farFutureEpoch}, 1, "pending_initialized"},
+		{&ethpb.Validator{ActivationEligibilityEpoch: 1, ActivationEpoch: farFutureEpoch}, 1, "pending_queued"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: farFutureEpoch}, 1, "active_ongoing"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: 20}, 1, "active_exiting"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: 20, Slashed: true}, 1, "active_slashed"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 20}, 1, "exited_unslashed"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 20, Slashed: true}, 1, "exited_slashed"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 6}, 1, "withdrawal_possible"},
+		{&ethpb.Validator{ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 6}, 0, "withdrawal_done"},
+	}
+	for _, tt := range tests {
+		if got := validatorStatus(tt.val, tt.balance, 10); got != tt.want {
+			t.Errorf("Wanted status %s for %v, received %s", tt.want, tt.val, got)
+		}
+	}
+}
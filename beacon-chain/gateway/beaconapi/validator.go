@@ -0,0 +1,176 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func epochVar(vars map[string]string) (uint64, error) {
+	epoch, err := strconv.ParseUint(vars["epoch"], 10, 64)
+	if err != nil {
+		return 0, badRequest(fmt.Sprintf("Invalid epoch %q", vars["epoch"]))
+	}
+	return epoch, nil
+}
+
+// attesterDuties of the requested validators, given as indices in the request body, or as the
+// index query parameter.
+func (s *Server) attesterDuties(ctx context.Context, r *http.Request, vars map[string]string) (interface{}, error) {
+	epoch, err := epochVar(vars)
+	if err != nil {
+		return nil, err
+	}
+	var indices []uint64
+	if r.Method == http.MethodPost {
+		if err := readBody(r, &indices); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, value := range r.URL.Query()["index"] {
+			for _, id := range strings.Split(value, ",") {
+				idx, err := strconv.ParseUint(id, 10, 64)
+				if err != nil {
+					return nil, badRequest(fmt.Sprintf("Invalid validator index %q", id))
+				}
+				indices = append(indices, idx)
+			}
+		}
+	}
+	if len(indices) == 0 {
+		return nil, badRequest("No validator index requested")
+	}
+
+	pubKeys := make([][]byte, len(indices))
+	for i, idx := range indices {
+		c, err := s.validatorByID(ctx, idx, nil)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys[i] = c.Validator.PublicKey
+	}
+	duties, err := s.validator.GetDuties(ctx, &ethpb.DutiesRequest{Epoch: epoch, PublicKeys: pubKeys})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]map[string]interface{}, 0, len(duties.Duties))
+	for i, duty := range duties.Duties {
+		if len(duty.Committee) == 0 || i >= len(indices) {
+			continue
+		}
+		position := -1
+		for j, idx := range duty.Committee {
+			if idx == indices[i] {
+				position = j
+				break
+			}
+		}
+		if position < 0 {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"pubkey":                    marshal(duty.PublicKey),
+			"validator_index":           strconv.FormatUint(indices[i], 10),
+			"committee_index":           strconv.FormatUint(duty.CommitteeIndex, 10),
+			"committee_length":          strconv.Itoa(len(duty.Committee)),
+			"validator_committee_index": strconv.Itoa(position),
+			"slot":                      strconv.FormatUint(duty.AttesterSlot, 10),
+		})
+	}
+	return res, nil
+}
+
+// proposerDuties of the epoch, from the assignments of every active validator.
+func (s *Server) proposerDuties(ctx context.Context, _ *http.Request, vars map[string]string) (interface{}, error) {
+	epoch, err := epochVar(vars)
+	if err != nil {
+		return nil, err
+	}
+	req := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+		PageSize:    pageSize,
+	}
+	var proposers []*ethpb.ValidatorAssignments_CommitteeAssignment
+	for {
+		page, err := s.beacon.ListValidatorAssignments(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page.Assignments {
+			// No block is proposed at the genesis slot, so slot zero means no proposal.
+			if a.ProposerSlot != 0 {
+				proposers = append(proposers, a)
+			}
+		}
+		if page.NextPageToken == "" || len(page.Assignments) == 0 {
+			break
+		}
+		req.PageToken = page.NextPageToken
+	}
+	res := make([]map[string]interface{}, 0, len(proposers))
+	for _, a := range proposers {
+		idx, err := s.validator.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: a.PublicKey})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, map[string]interface{}{
+			"pubkey":          marshal(a.PublicKey),
+			"validator_index": strconv.FormatUint(idx.Index, 10),
+			"slot":            strconv.FormatUint(a.ProposerSlot, 10),
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) attestationData(ctx context.Context, r *http.Request, _ map[string]string) (interface{}, error) {
+	slot, ok, err := uint64Query(r, "slot")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, badRequest("Missing slot")
+	}
+	index, ok, err := uint64Query(r, "committee_index")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, badRequest("Missing committee_index")
+	}
+	data, err := s.validator.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: slot, CommitteeIndex: index})
+	if err != nil {
+		return nil, err
+	}
+	return marshal(data), nil
+}
+
+func (s *Server) produceBlock(ctx context.Context, r *http.Request, vars map[string]string) (interface{}, error) {
+	slot, err := strconv.ParseUint(vars["slot"], 10, 64)
+	if err != nil {
+		return nil, badRequest(fmt.Sprintf("Invalid slot %q", vars["slot"]))
+	}
+	randaoReveal, err := decodeHex(r.URL.Query().Get("randao_reveal"))
+	if err != nil {
+		return nil, badRequest("Invalid randao_reveal: " + err.Error())
+	}
+	var graffiti []byte
+	if g := r.URL.Query().Get("graffiti"); g != "" {
+		graffiti, err = decodeHex(g)
+		if err != nil {
+			return nil, badRequest("Invalid graffiti: " + err.Error())
+		}
+	}
+	blk, err := s.validator.GetBlock(ctx, &ethpb.BlockRequest{
+		Slot:         slot,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marshal(blk), nil
+}
@@ -0,0 +1,287 @@
+// Package events serves beacon chain events to HTTP clients as server-sent events. It complements
+// the gRPC gateway, which cannot proxy server side streams.
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+// Path is the HTTP path the events endpoint is served on.
+const Path = "/eth/v1alpha1/events"
+
+// Topics which clients can subscribe to.
+const (
+	// HeadTopic is sent when the head of the chain changes.
+	HeadTopic = "head"
+	// BlockTopic is sent when a block has been processed.
+	BlockTopic = "block"
+	// AttestationTopic is sent when an unaggregated or aggregated attestation has been received.
+	AttestationTopic = "attestation"
+	// ExitTopic is sent when a voluntary exit has been received.
+	ExitTopic = "voluntary_exit"
+	// FinalizedCheckpointTopic is sent when the finalized checkpoint changes.
+	FinalizedCheckpointTopic = "finalized_checkpoint"
+	// ChainReorgTopic is sent when the new head of the chain does not descend from the previous head.
+	ChainReorgTopic = "chain_reorg"
+)
+
+var allTopics = []string{
+	HeadTopic,
+	BlockTopic,
+	AttestationTopic,
+	ExitTopic,
+	FinalizedCheckpointTopic,
+	ChainReorgTopic,
+}
+
+// eventBufferSize is the number of events buffered for a client. A client which falls further
+// behind is disconnected, so a slow reader never blocks the feeds of the beacon node.
+const eventBufferSize = 256
+
+// keepAliveInterval is how often a comment is written to idle connections, so proxies do not
+// close them.
+const keepAliveInterval = 15 * time.Second
+
+var marshaler = &jsonpb.Marshaler{EmitDefaults: true}
+
+// HeadEvent is the data sent with head events.
+type HeadEvent struct {
+	Slot  uint64 `json:"slot,string"`
+	Block []byte `json:"block"`
+}
+
+// BlockEvent is the data sent with block events.
+type BlockEvent struct {
+	Slot     uint64 `json:"slot,string"`
+	Block    []byte `json:"block"`
+	Verified bool   `json:"verified"`
+}
+
+// ChainReorgEvent is the data sent with chain reorg events.
+type ChainReorgEvent struct {
+	Slot         uint64 `json:"slot,string"`
+	Depth        uint64 `json:"depth,string"`
+	OldHeadBlock []byte `json:"oldHeadBlock"`
+	NewHeadBlock []byte `json:"newHeadBlock"`
+}
+
+// event is a single message written to a client.
+type event struct {
+	topic string
+	data  interface{}
+}
+
+// Server serves the events endpoint.
+type Server struct {
+	Ctx                 context.Context
+	HeadFetcher         blockchain.HeadFetcher
+	FinalizationFetcher blockchain.FinalizationFetcher
+	StateNotifier       statefeed.Notifier
+	OperationNotifier   opfeed.Notifier
+}
+
+// ServeHTTP streams the events of the topics requested with the `topics` query parameter, which
+// may be repeated or comma separated. All topics are streamed when none are requested.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	topics, err := parseTopics(r.URL.Query()["topics"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	events := make(chan *event, eventBufferSize)
+	go s.forwardEvents(ctx, topics, events)
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				log.WithField("remoteAddr", r.RemoteAddr).Debug("Disconnecting events client which is too slow")
+				return
+			}
+			if err := writeEvent(w, ev); err != nil {
+				log.WithError(err).Debug("Could not write event")
+				return
+			}
+			flusher.Flush()
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ctx.Done():
+			return
+		case <-s.Ctx.Done():
+			return
+		}
+	}
+}
+
+// forwardEvents reads the beacon node feeds and queues the events of the requested topics. The
+// feeds are always drained promptly: when the queue of the client is full, the queue is closed and
+// forwarding stops.
+func (s *Server) forwardEvents(ctx context.Context, topics map[string]bool, events chan<- *event) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	headRoot, _ := s.HeadFetcher.HeadRoot(ctx)
+	finalized := s.FinalizationFetcher.FinalizedCheckpt()
+
+	for {
+		var queued []*event
+		select {
+		case e := <-stateChannel:
+			if e.Type == statefeed.Reorg {
+				if data, ok := e.Data.(*statefeed.ReorgData); ok {
+					queued = append(queued, &event{
+						topic: ChainReorgTopic,
+						data: &ChainReorgEvent{
+							Slot:         data.NewHeadSlot,
+							Depth:        data.Depth,
+							OldHeadBlock: data.OldHeadRoot[:],
+							NewHeadBlock: data.NewHeadRoot[:],
+						},
+					})
+				}
+				break
+			}
+			if e.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				continue
+			}
+			queued = append(queued, &event{
+				topic: BlockTopic,
+				data:  &BlockEvent{Slot: data.Slot, Block: data.BlockRoot[:], Verified: data.Verified},
+			})
+
+			newHeadRoot, err := s.HeadFetcher.HeadRoot(ctx)
+			if err == nil && !bytes.Equal(newHeadRoot, headRoot) {
+				headSlot := s.HeadFetcher.HeadSlot()
+				queued = append(queued, &event{topic: HeadTopic, data: &HeadEvent{Slot: headSlot, Block: newHeadRoot}})
+				headRoot = newHeadRoot
+			}
+
+			newFinalized := s.FinalizationFetcher.FinalizedCheckpt()
+			if newFinalized.Epoch != finalized.Epoch || !bytes.Equal(newFinalized.Root, finalized.Root) {
+				queued = append(queued, &event{topic: FinalizedCheckpointTopic, data: newFinalized})
+				finalized = newFinalized
+			}
+		case e := <-opChannel:
+			switch data := e.Data.(type) {
+			case *opfeed.UnAggregatedAttReceivedData:
+				queued = append(queued, &event{topic: AttestationTopic, data: data.Attestation})
+			case *opfeed.AggregatedAttReceivedData:
+				queued = append(queued, &event{topic: AttestationTopic, data: data.Attestation})
+			case *opfeed.ExitReceivedData:
+				queued = append(queued, &event{topic: ExitTopic, data: data.Exit})
+			}
+		case <-stateSub.Err():
+			return
+		case <-opSub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		for _, ev := range queued {
+			if !topics[ev.topic] {
+				continue
+			}
+			select {
+			case events <- ev:
+			default:
+				close(events)
+				return
+			}
+		}
+	}
+}
+
+// writeEvent writes an event in the server-sent events format.
+func writeEvent(w http.ResponseWriter, ev *event) error {
+	var data []byte
+	var err error
+	if msg, ok := ev.data.(proto.Message); ok {
+		var buf bytes.Buffer
+		err = marshaler.Marshal(&buf, msg)
+		data = buf.Bytes()
+	} else {
+		data, err = json.Marshal(ev.data)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.topic, data)
+	return err
+}
+
+// parseTopics returns the set of requested topics, or all topics if none are requested.
+func parseTopics(params []string) (map[string]bool, error) {
+	topics := make(map[string]bool)
+	for _, param := range params {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			if topic == "" {
+				continue
+			}
+			if !isValidTopic(topic) {
+				return nil, fmt.Errorf("invalid topic %q", topic)
+			}
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		for _, topic := range allTopics {
+			topics[topic] = true
+		}
+	}
+	return topics, nil
+}
+
+func isValidTopic(topic string) bool {
+	for _, t := range allTopics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+func testServer(ctx context.Context) *Server {
+	chainService := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{}}
+	return &Server{
+		Ctx:                 ctx,
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+		StateNotifier:       chainService.StateNotifier(),
+		OperationNotifier:   chainService.OperationNotifier(),
+	}
+}
+
+// sendUntilReceived sends the event until the feed has a subscriber to deliver it to.
+func sendUntilReceived(t *testing.T, f *event.Feed, e *feed.Event) {
+	for i := 0; i < 100; i++ {
+		if f.Send(e) > 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("No subscriber received the event")
+}
+
+func TestServeHTTP_StreamsRequestedTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := testServer(ctx)
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + Path + "?topics=" + ExitTopic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Wanted content type text/event-stream, received %s", ct)
+	}
+
+	// Attestations are not requested and must not be streamed.
+	sendUntilReceived(t, s.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{Attestation: &ethpb.Attestation{}},
+	})
+	sendUntilReceived(t, s.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 7}},
+		},
+	})
+
+	reader := bufio.NewReader(res.Body)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "event: " + ExitTopic + "\n"; line != want {
+		t.Fatalf("Wanted %q, received %q", want, line)
+	}
+	line, err = reader.ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(line, "data: ") || !strings.Contains(line, `"validatorIndex":"7"`) {
+		t.Errorf("Unexpected event data %q", line)
+	}
+}
+
+func TestServeHTTP_InvalidTopic(t *testing.T) {
+	s := testServer(context.Background())
+	req := httptest.NewRequest(http.MethodGet, Path+"?topics=head,unknown", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Wanted status %d, received %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestForwardEvents_ClosesQueueOfSlowClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := testServer(ctx)
+	topics, err := parseTopics(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An unbuffered queue which is never read behaves as a client which is too slow.
+	events := make(chan *event)
+	done := make(chan struct{})
+	go func() {
+		s.forwardEvents(ctx, topics, events)
+		close(done)
+	}()
+	sendUntilReceived(t, s.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{Exit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{}}},
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected forwarding to stop for a slow client")
+	}
+	if _, ok := <-events; ok {
+		t.Error("Expected the queue of the slow client to be closed")
+	}
+}
+
+func TestParseTopics(t *testing.T) {
+	topics, err := parseTopics([]string{"head,block", " attestation "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) != 3 || !topics[HeadTopic] || !topics[BlockTopic] || !topics[AttestationTopic] {
+		t.Errorf("Unexpected topics %v", topics)
+	}
+	topics, err = parseTopics(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) != len(allTopics) {
+		t.Errorf("Wanted all %d topics, received %d", len(allTopics), len(topics))
+	}
+}
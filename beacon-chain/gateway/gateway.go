@@ -9,6 +9,7 @@ import (
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway/beaconapi"
 	"github.com/prysmaticlabs/prysm/shared"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
@@ -61,6 +62,7 @@ func (g *Gateway) Start() {
 	}
 
 	g.mux.Handle("/", gwmux)
+	g.mux.Handle(beaconapi.PathPrefix, beaconapi.NewServer(conn))
 
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
@@ -28,10 +28,17 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.FallbackWeb3ProviderFlag,
+	flags.FallbackHTTPWeb3ProviderFlag,
+	flags.Eth1QuorumFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
 	flags.KeyFlag,
+	flags.ClientCAFlag,
+	flags.RPCAuthPolicyFlag,
+	flags.RPCRateLimit,
+	flags.RPCRateLimitBurst,
 	flags.GRPCGatewayPort,
 	flags.MinSyncPeers,
 	flags.RPCMaxPageSize,
@@ -47,6 +54,7 @@ var appFlags = []cli.Flag{
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.TrustedPeers,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
@@ -54,6 +62,10 @@ var appFlags = []cli.Flag{
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
 	cmd.P2PMaxPeers,
+	cmd.P2PMaxInboundPeers,
+	cmd.P2PMaxOutboundPeers,
+	cmd.P2PMaxPeersPerIP,
+	cmd.P2PMaxPeersPerSubnet,
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
 	cmd.P2PEncoding,
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path"
@@ -24,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway/events"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
@@ -31,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/auth"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -288,6 +291,7 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 	svc, err := p2p.NewService(&p2p.Config{
 		NoDiscovery:       ctx.GlobalBool(cmd.NoDiscovery.Name),
 		StaticPeers:       sliceutil.SplitCommaSeparated(ctx.GlobalStringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:      sliceutil.SplitCommaSeparated(ctx.GlobalStringSlice(cmd.TrustedPeers.Name)),
 		BootstrapNodeAddr: bootnodeAddrs,
 		RelayNodeAddr:     ctx.GlobalString(cmd.RelayNode.Name),
 		DataDir:           ctx.GlobalString(cmd.DataDirFlag.Name),
@@ -298,6 +302,10 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		TCPPort:           ctx.GlobalUint(cmd.P2PTCPPort.Name),
 		UDPPort:           ctx.GlobalUint(cmd.P2PUDPPort.Name),
 		MaxPeers:          ctx.GlobalUint(cmd.P2PMaxPeers.Name),
+		MaxInboundPeers:   uint(ctx.GlobalInt64(cmd.P2PMaxInboundPeers.Name)),
+		MaxOutboundPeers:  uint(ctx.GlobalInt64(cmd.P2PMaxOutboundPeers.Name)),
+		MaxPeersPerIP:     uint(ctx.GlobalInt64(cmd.P2PMaxPeersPerIP.Name)),
+		MaxPeersPerSubnet: uint(ctx.GlobalInt64(cmd.P2PMaxPeersPerSubnet.Name)),
 		WhitelistCIDR:     ctx.GlobalString(cmd.P2PWhitelist.Name),
 		EnableUPnP:        ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
 		Encoding:          ctx.GlobalString(cmd.P2PEncoding.Name),
@@ -367,14 +375,30 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
+	fallbackEndpoints := cliCtx.GlobalStringSlice(flags.FallbackWeb3ProviderFlag.Name)
+	fallbackHTTPEndpoints := cliCtx.GlobalStringSlice(flags.FallbackHTTPWeb3ProviderFlag.Name)
+	if len(fallbackEndpoints) != len(fallbackHTTPEndpoints) {
+		return fmt.Errorf(
+			"%d fallback web3 providers given with %d fallback http web3 providers, expected one of each per endpoint",
+			len(fallbackEndpoints),
+			len(fallbackHTTPEndpoints),
+		)
+	}
+	fallbacks := make([]powchain.Endpoint, len(fallbackEndpoints))
+	for i := range fallbackEndpoints {
+		fallbacks[i] = powchain.Endpoint{ETH1Endpoint: fallbackEndpoints[i], HTTPEndpoint: fallbackHTTPEndpoints[i]}
+	}
+
 	ctx := context.Background()
 	cfg := &powchain.Web3ServiceConfig{
-		ETH1Endpoint:    cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
-		HTTPEndPoint:    cliCtx.GlobalString(flags.HTTPWeb3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		BeaconDB:        b.db,
-		DepositCache:    b.depositCache,
-		StateNotifier:   b,
+		ETH1Endpoint:      cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
+		HTTPEndPoint:      cliCtx.GlobalString(flags.HTTPWeb3ProviderFlag.Name),
+		DepositContract:   common.HexToAddress(depAddress),
+		BeaconDB:          b.db,
+		DepositCache:      b.depositCache,
+		StateNotifier:     b,
+		FallbackEndpoints: fallbacks,
+		Eth1Quorum:        cliCtx.GlobalBool(flags.Eth1QuorumFlag.Name),
 	}
 	web3Service, err := powchain.NewService(ctx, cfg)
 	if err != nil {
@@ -412,14 +436,15 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	}
 
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
-		DB:            b.db,
-		P2P:           b.fetchP2P(ctx),
-		Chain:         chainService,
-		InitialSync:   initSync,
-		StateNotifier: b,
-		BlockNotifier: b,
-		AttPool:       b.attestationPool,
-		ExitPool:      b.exitPool,
+		DB:                b.db,
+		P2P:               b.fetchP2P(ctx),
+		Chain:             chainService,
+		InitialSync:       initSync,
+		StateNotifier:     b,
+		BlockNotifier:     b,
+		OperationNotifier: b,
+		AttPool:           b.attestationPool,
+		ExitPool:          b.exitPool,
 	})
 
 	return b.services.RegisterService(rs)
@@ -479,6 +504,11 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	port := ctx.GlobalString(flags.RPCPort.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	key := ctx.GlobalString(flags.KeyFlag.Name)
+	clientCA := ctx.GlobalString(flags.ClientCAFlag.Name)
+	authPolicy, err := loadRPCAuthPolicy(ctx)
+	if err != nil {
+		return err
+	}
 	slasherCert := ctx.GlobalString(flags.SlasherCertFlag.Name)
 	slasherProvider := ctx.GlobalString(flags.SlasherProviderFlag.Name)
 
@@ -488,6 +518,10 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		Port:                  port,
 		CertFlag:              cert,
 		KeyFlag:               key,
+		ClientCAFlag:          clientCA,
+		AuthPolicy:            authPolicy,
+		RateLimit:             ctx.GlobalFloat64(flags.RPCRateLimit.Name),
+		RateLimitBurst:        ctx.GlobalFloat64(flags.RPCRateLimitBurst.Name),
 		BeaconDB:              b.db,
 		Broadcaster:           b.fetchP2P(ctx),
 		PeersFetcher:          b.fetchP2P(ctx),
@@ -549,13 +583,46 @@ func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
 func (b *BeaconNode) registerGRPCGateway(ctx *cli.Context) error {
 	gatewayPort := ctx.GlobalInt(flags.GRPCGatewayPort.Name)
 	if gatewayPort > 0 {
+		var chainService *blockchain.Service
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		// The gateway cannot proxy server side streams, so chain events are served to HTTP
+		// clients directly from the beacon node feeds.
+		var eventsHandler http.Handler = &events.Server{
+			Ctx:                 context.Background(),
+			HeadFetcher:         chainService,
+			FinalizationFetcher: chainService,
+			StateNotifier:       b,
+			OperationNotifier:   b,
+		}
+		// Requests proxied to the gRPC server are authorized by its interceptors, with the
+		// authorization header forwarded by the gateway. Events bypass the gRPC server.
+		authPolicy, err := loadRPCAuthPolicy(ctx)
+		if err != nil {
+			return err
+		}
+		if authPolicy != nil {
+			eventsHandler = auth.HTTPHandler(authPolicy, eventsHandler)
+		}
+		mux := http.NewServeMux()
+		mux.Handle(events.Path, eventsHandler)
 		selfAddress := fmt.Sprintf("127.0.0.1:%d", ctx.GlobalInt(flags.RPCPort.Name))
 		gatewayAddress := fmt.Sprintf("0.0.0.0:%d", gatewayPort)
-		return b.services.RegisterService(gateway.New(context.Background(), selfAddress, gatewayAddress, nil /*optional mux*/))
+		return b.services.RegisterService(gateway.New(context.Background(), selfAddress, gatewayAddress, mux))
 	}
 	return nil
 }
 
+// loadRPCAuthPolicy loads the auth policy of the RPC server, if one is configured.
+func loadRPCAuthPolicy(ctx *cli.Context) (*auth.Policy, error) {
+	path := ctx.GlobalString(flags.RPCAuthPolicyFlag.Name)
+	if path == "" {
+		return nil, nil
+	}
+	return auth.LoadPolicy(path)
+}
+
 func (b *BeaconNode) registerInteropServices(ctx *cli.Context) error {
 	genesisTime := ctx.GlobalUint64(flags.InteropGenesisTimeFlag.Name)
 	genesisValidators := ctx.GlobalUint64(flags.InteropNumValidatorsFlag.Name)
@@ -5,6 +5,7 @@ package p2p
 type Config struct {
 	NoDiscovery           bool
 	StaticPeers           []string
+	TrustedPeers          []string
 	BootstrapNodeAddr     []string
 	KademliaBootStrapAddr []string
 	Discv5BootStrapAddr   []string
@@ -17,6 +18,10 @@ type Config struct {
 	TCPPort               uint
 	UDPPort               uint
 	MaxPeers              uint
+	MaxInboundPeers       uint
+	MaxOutboundPeers      uint
+	MaxPeersPerIP         uint
+	MaxPeersPerSubnet     uint
 	WhitelistCIDR         string
 	EnableUPnP            bool
 	Encoding              string
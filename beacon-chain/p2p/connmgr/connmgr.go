@@ -194,6 +194,8 @@ type peerInfo struct {
 	value int            // cached sum of all tag values
 	temp  bool           // this is a temporary entry holding early tags, and awaiting connections
 
+	inbound bool // whether the first connection of the peer was opened by the remote peer
+
 	conns map[network.Conn]time.Time // start time of each connection
 
 	firstSeen time.Time // timestamp when we began tracking this peer.
@@ -282,7 +284,12 @@ func (cm *BasicConnMgr) getConnsToClose(ctx context.Context) []network.Conn {
 			return left.temp
 		}
 		// otherwise, compare by value.
-		return left.value < right.value
+		if left.value != right.value {
+			return left.value < right.value
+		}
+		// peers which dialed us are preferred for pruning over peers we dialed, as inbound
+		// slots are the easiest for a single remote host to fill.
+		return left.inbound && !right.inbound
 	})
 
 	target := ncandidates - cm.lowWater
@@ -449,6 +456,7 @@ func (nn *cmNotifee) Connected(n network.Network, c network.Conn) {
 			firstSeen: time.Now(),
 			tags:      make(map[string]int),
 			conns:     make(map[network.Conn]time.Time),
+			inbound:   c.Stat().Direction == network.DirInbound,
 		}
 		s.peers[id] = pinfo
 	} else if pinfo.temp {
@@ -457,6 +465,7 @@ func (nn *cmNotifee) Connected(n network.Network, c network.Conn) {
 		// timestamp to the real one.
 		pinfo.temp = false
 		pinfo.firstSeen = time.Now()
+		pinfo.inbound = c.Stat().Direction == network.DirInbound
 	}
 
 	_, ok = pinfo.conns[c]
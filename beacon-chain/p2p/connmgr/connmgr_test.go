@@ -19,6 +19,7 @@ type tconn struct {
 
 	peer             peer.ID
 	closed           bool
+	direction        network.Direction
 	disconnectNotify func(net network.Network, conn network.Conn)
 }
 
@@ -42,6 +43,10 @@ func (c *tconn) RemoteMultiaddr() ma.Multiaddr {
 	return addr
 }
 
+func (c *tconn) Stat() network.Stat {
+	return network.Stat{Direction: c.direction}
+}
+
 func randConn(t testing.TB, discNotify func(network.Network, network.Conn)) network.Conn {
 	pid := tu.RandPeerIDFatal(t)
 	return &tconn{peer: pid, disconnectNotify: discNotify}
@@ -84,6 +89,35 @@ func TestConnTrimming(t *testing.T) {
 	}
 }
 
+func TestConnTrimming_PrefersInbound(t *testing.T) {
+	cm := NewConnManager(10, 20, 0)
+	not := cm.Notifee()
+
+	var conns []network.Conn
+	for i := 0; i < 20; i++ {
+		rc := randConn(t, nil)
+		if i%2 == 0 {
+			rc.(*tconn).direction = network.DirInbound
+		} else {
+			rc.(*tconn).direction = network.DirOutbound
+		}
+		conns = append(conns, rc)
+		not.Connected(nil, rc)
+	}
+
+	cm.TrimOpenConns(context.Background())
+
+	for i, c := range conns {
+		inbound := i%2 == 0
+		if inbound && !c.(*tconn).closed {
+			t.Error("inbound conn with equal value should have been closed")
+		}
+		if !inbound && c.(*tconn).closed {
+			t.Error("outbound conn with equal value should not have been closed")
+		}
+	}
+}
+
 func TestConnsToClose(t *testing.T) {
 	cm := NewConnManager(0, 10, 0)
 	conns := cm.getConnsToClose(context.Background())
@@ -27,14 +27,25 @@ func (s *Service) AddConnectionHandler(reqFunc func(ctx context.Context, id peer
 				return
 			}
 			s.peers.Add(conn.RemotePeer(), conn.RemoteMultiaddr(), conn.Stat().Direction)
-			if len(s.peers.Active()) >= int(s.cfg.MaxPeers) {
+			// Trusted peers are accepted regardless of the peer limits and their past responses,
+			// static peers regardless of the peer limits.
+			trusted := s.trustedPeers[conn.RemotePeer()]
+			exempt := trusted || s.staticPeers[conn.RemotePeer()]
+			if !exempt && len(s.peers.Active()) >= int(s.cfg.MaxPeers) {
 				log.WithField("reason", "at peer limit").Trace("Ignoring connection request")
 				if err := s.Disconnect(conn.RemotePeer()); err != nil {
 					log.WithError(err).Error("Unable to disconnect from peer")
 				}
 				return
 			}
-			if s.peers.IsBad(conn.RemotePeer()) {
+			if reason := s.peerLimitReached(conn.RemoteMultiaddr(), conn.Stat().Direction); !exempt && reason != "" {
+				log.WithField("reason", reason).Trace("Ignoring connection request")
+				if err := s.Disconnect(conn.RemotePeer()); err != nil {
+					log.WithError(err).Error("Unable to disconnect from peer")
+				}
+				return
+			}
+			if !trusted && s.peers.IsBad(conn.RemotePeer()) {
 				log.WithField("reason", "bad peer").Trace("Ignoring connection request")
 				if err := s.Disconnect(conn.RemotePeer()); err != nil {
 					log.WithError(err).Error("Unable to disconnect from peer")
@@ -71,7 +82,9 @@ func (s *Service) AddConnectionHandler(reqFunc func(ctx context.Context, id peer
 					s.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnected)
 					return
 				}
-				s.host.ConnManager().Protect(conn.RemotePeer(), "protocol")
+				// Peers are tagged rather than protected, so the connection manager can still trim the
+				// lowest scored peers when above the high water mark.
+				s.host.ConnManager().TagPeer(conn.RemotePeer(), protocolTag, protocolPeerValue)
 				s.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnected)
 				log.Info("Peer connected")
 			}()
@@ -98,7 +111,6 @@ func (s *Service) AddDisconnectionHandler(handler func(ctx context.Context, id p
 					log.WithError(err).Error("Disconnect handler failed")
 				}
 				s.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnected)
-				s.host.ConnManager().Unprotect(conn.RemotePeer(), "protocol")
 				// Only log disconnections if we were fully connected.
 				if priorState == peers.PeerConnected {
 					log.WithField("active", len(s.peers.Active())).Info("Peer disconnected")
@@ -0,0 +1,101 @@
+package p2p
+
+import (
+	"net"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
+// Tags used with the connection manager.
+const (
+	// protocolTag marks peers that completed the handshake.
+	protocolTag = "protocol"
+	// scoreTag holds the peer score from the peers status, so connections of low scored peers
+	// are the first to be trimmed.
+	scoreTag = "score"
+	// subnetTag protects peers which are among the few peers of an attestation subnet we need.
+	subnetTag = "subnet"
+)
+
+// protocolPeerValue is the connection manager value of a peer that completed the handshake.
+const protocolPeerValue = 100
+
+// minSubnetPeers is the number of peers of a needed attestation subnet below which all of its
+// peers are protected from trimming.
+const minSubnetPeers = 2
+
+// subnetMaskBits is the size of the IPv4 subnet used to limit peers sharing a network.
+const subnetMaskBits = 24
+
+// peerLimitReached returns a non empty reason if accepting a connection from the peer at the
+// given address and direction would exceed one of the configured connection limits.
+func (s *Service) peerLimitReached(addr ma.Multiaddr, direction network.Direction) string {
+	if s.cfg.MaxInboundPeers > 0 && direction == network.DirInbound &&
+		len(s.peers.ActiveWithDirection(network.DirInbound)) >= int(s.cfg.MaxInboundPeers) {
+		return "at inbound peer limit"
+	}
+	if s.cfg.MaxOutboundPeers > 0 && direction == network.DirOutbound &&
+		len(s.peers.ActiveWithDirection(network.DirOutbound)) >= int(s.cfg.MaxOutboundPeers) {
+		return "at outbound peer limit"
+	}
+	ip := peers.IPFromMultiaddr(addr)
+	if ip == nil {
+		return ""
+	}
+	if s.cfg.MaxPeersPerIP > 0 {
+		ipNet := &net.IPNet{IP: ip, Mask: net.CIDRMask(32, 32)}
+		if len(s.peers.ActiveWithinIPNet(ipNet)) >= int(s.cfg.MaxPeersPerIP) {
+			return "at peer limit for ip"
+		}
+	}
+	if s.cfg.MaxPeersPerSubnet > 0 {
+		mask := net.CIDRMask(subnetMaskBits, 32)
+		ipNet := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+		if len(s.peers.ActiveWithinIPNet(ipNet)) >= int(s.cfg.MaxPeersPerSubnet) {
+			return "at peer limit for subnet"
+		}
+	}
+	return ""
+}
+
+// updatePeerScores copies the score of every active peer into the connection manager, so trimming
+// disconnects the lowest scored peers first.
+func (s *Service) updatePeerScores() {
+	cm := s.host.ConnManager()
+	for _, pid := range s.peers.Active() {
+		cm.TagPeer(pid, scoreTag, s.peers.Score(pid))
+	}
+}
+
+// protectSubnetPeers protects the peers of the attestation subnets we are subscribed to when these
+// subnets have at most minSubnetPeers peers, so trimming does not leave us without a path to them.
+// Peers which no longer cover such a subnet are unprotected.
+func (s *Service) protectSubnetPeers() {
+	cm := s.host.ConnManager()
+	needed := make(map[peer.ID]bool)
+	for _, topic := range s.pubsub.GetTopics() {
+		if !strings.Contains(topic, "committee_index") {
+			continue
+		}
+		subnetPeers := s.pubsub.ListPeers(topic)
+		if len(subnetPeers) > minSubnetPeers {
+			continue
+		}
+		for _, pid := range subnetPeers {
+			needed[pid] = true
+		}
+	}
+	for pid := range needed {
+		cm.Protect(pid, subnetTag)
+	}
+	for pid := range s.subnetProtected {
+		if !needed[pid] {
+			cm.Unprotect(pid, subnetTag)
+		}
+	}
+	s.subnetProtected = needed
+}
@@ -0,0 +1,96 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
+func addActivePeer(t *testing.T, p *peers.Status, id string, addr string, direction network.Direction) {
+	maddr, err := ma.NewMultiaddr(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := peer.ID(id)
+	p.Add(pid, maddr, direction)
+	p.SetConnectionState(pid, peers.PeerConnected)
+}
+
+func TestPeerLimitReached(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *Config
+		addr      string
+		direction network.Direction
+		want      string
+	}{
+		{
+			name:      "no limits",
+			cfg:       &Config{},
+			addr:      "/ip4/10.0.0.1/tcp/13000",
+			direction: network.DirInbound,
+			want:      "",
+		},
+		{
+			name:      "inbound limit",
+			cfg:       &Config{MaxInboundPeers: 2},
+			addr:      "/ip4/10.0.1.1/tcp/13000",
+			direction: network.DirInbound,
+			want:      "at inbound peer limit",
+		},
+		{
+			name:      "inbound limit allows outbound",
+			cfg:       &Config{MaxInboundPeers: 2},
+			addr:      "/ip4/10.0.1.1/tcp/13000",
+			direction: network.DirOutbound,
+			want:      "",
+		},
+		{
+			name:      "outbound limit",
+			cfg:       &Config{MaxOutboundPeers: 1},
+			addr:      "/ip4/10.0.1.1/tcp/13000",
+			direction: network.DirOutbound,
+			want:      "at outbound peer limit",
+		},
+		{
+			name:      "ip limit",
+			cfg:       &Config{MaxPeersPerIP: 2},
+			addr:      "/ip4/10.0.0.1/tcp/13001",
+			direction: network.DirOutbound,
+			want:      "at peer limit for ip",
+		},
+		{
+			name:      "subnet limit",
+			cfg:       &Config{MaxPeersPerSubnet: 3},
+			addr:      "/ip4/10.0.0.200/tcp/13000",
+			direction: network.DirOutbound,
+			want:      "at peer limit for subnet",
+		},
+		{
+			name:      "subnet limit other subnet",
+			cfg:       &Config{MaxPeersPerSubnet: 3},
+			addr:      "/ip4/10.0.1.1/tcp/13000",
+			direction: network.DirOutbound,
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := peers.NewStatus(3)
+			addActivePeer(t, p, "a", "/ip4/10.0.0.1/tcp/13000", network.DirInbound)
+			addActivePeer(t, p, "b", "/ip4/10.0.0.1/tcp/13001", network.DirInbound)
+			addActivePeer(t, p, "c", "/ip4/10.0.0.2/tcp/13000", network.DirOutbound)
+			s := &Service{cfg: tt.cfg, peers: p}
+			maddr, err := ma.NewMultiaddr(tt.addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.peerLimitReached(maddr, tt.direction); got != tt.want {
+				t.Errorf("peerLimitReached() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
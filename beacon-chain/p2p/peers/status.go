@@ -21,6 +21,7 @@ package peers
 
 import (
 	"errors"
+	"net"
 	"sort"
 	"sync"
 	"time"
@@ -48,6 +49,13 @@ const (
 	PeerDisconnecting
 )
 
+const (
+	// chainStateScore is the score given to a peer which has shared its chain state with us.
+	chainStateScore = 10
+	// badResponseScore is the score deducted for every bad response given by a peer.
+	badResponseScore = 20
+)
+
 var (
 	// ErrPeerUnknown is returned when there is an attempt to obtain data from a peer that is not known.
 	ErrPeerUnknown = errors.New("peer unknown")
@@ -193,6 +201,16 @@ func (p *Status) IncrementBadResponses(pid peer.ID) {
 	status.badResponses++
 }
 
+// SetBadResponses sets the number of bad responses we have received from the given remote peer, for
+// example to restore the bad responses recorded before a restart.
+func (p *Status) SetBadResponses(pid peer.ID, badResponses int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	status.badResponses = badResponses
+}
+
 // BadResponses obtains the number of bad responses we have received from the given remote peer.
 // This will error if the peer does not exist.
 func (p *Status) BadResponses(pid peer.ID) (int, error) {
@@ -256,6 +274,54 @@ func (p *Status) Active() []peer.ID {
 	return peers
 }
 
+// ActiveWithDirection returns the peers that are connecting or connected in the given direction.
+func (p *Status) ActiveWithDirection(direction network.Direction) []peer.ID {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, status := range p.status {
+		if (status.peerState == PeerConnecting || status.peerState == PeerConnected) && status.direction == direction {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
+// ActiveWithinIPNet returns the peers that are connecting or connected from an IPv4 address within the given network.
+func (p *Status) ActiveWithinIPNet(ipNet *net.IPNet) []peer.ID {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, status := range p.status {
+		if status.peerState != PeerConnecting && status.peerState != PeerConnected {
+			continue
+		}
+		if ip := IPFromMultiaddr(status.address); ip != nil && ipNet.Contains(ip) {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
+// Score returns the connection score of the given remote peer. Peers with a lower score are the first
+// to be disconnected when the number of connections must be reduced. Peers gain score by sharing their
+// chain state and lose score for every bad response.
+// If the peer is unknown this will return 0.
+func (p *Status) Score(pid peer.ID) int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	status, ok := p.status[pid]
+	if !ok {
+		return 0
+	}
+	score := 0
+	if status.chainState != nil {
+		score += chainStateScore
+	}
+	return score - status.badResponses*badResponseScore
+}
+
 // Disconnecting returns the peers that are disconnecting.
 func (p *Status) Disconnecting() []peer.ID {
 	p.lock.RLock()
@@ -386,6 +452,18 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 	return targetRoot[:], targetEpoch, potentialPIDs
 }
 
+// IPFromMultiaddr returns the IPv4 address of the multiaddress, or nil if it has none.
+func IPFromMultiaddr(addr ma.Multiaddr) net.IP {
+	if addr == nil {
+		return nil
+	}
+	ipStr, err := addr.ValueForProtocol(ma.P_IP4)
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(ipStr)
+}
+
 // fetch is a helper function that fetches a peer status, possibly creating it.
 func (p *Status) fetch(pid peer.ID) *peerStatus {
 	if _, ok := p.status[pid]; !ok {
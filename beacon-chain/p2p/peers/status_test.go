@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"fmt"
+	"net"
 	"testing"
 
 	"github.com/libp2p/go-libp2p-core/network"
@@ -304,6 +305,67 @@ func TestPeerConnectionStatuses(t *testing.T) {
 	}
 }
 
+func TestPeerActiveWithDirection(t *testing.T) {
+	p := peers.NewStatus(2)
+
+	inbound := addPeer(t, p, peers.PeerConnected)
+	p.Add(inbound, nil, network.DirInbound)
+	outbound := addPeer(t, p, peers.PeerConnecting)
+	p.Add(outbound, nil, network.DirOutbound)
+	disconnected := addPeer(t, p, peers.PeerDisconnected)
+	p.Add(disconnected, nil, network.DirInbound)
+
+	if n := len(p.ActiveWithDirection(network.DirInbound)); n != 1 {
+		t.Errorf("Unexpected number of active inbound peers: expected 1, received %d", n)
+	}
+	if n := len(p.ActiveWithDirection(network.DirOutbound)); n != 1 {
+		t.Errorf("Unexpected number of active outbound peers: expected 1, received %d", n)
+	}
+}
+
+func TestPeerActiveWithinIPNet(t *testing.T) {
+	p := peers.NewStatus(2)
+
+	for _, addr := range []string{"/ip4/10.0.0.1/tcp/13000", "/ip4/10.0.0.2/tcp/13000", "/ip4/10.0.1.1/tcp/13000"} {
+		address, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pid := addPeer(t, p, peers.PeerConnected)
+		p.Add(pid, address, network.DirInbound)
+	}
+
+	_, subnet, err := net.ParseCIDR("10.0.0.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(p.ActiveWithinIPNet(subnet)); n != 2 {
+		t.Errorf("Unexpected number of peers within subnet: expected 2, received %d", n)
+	}
+	_, single, err := net.ParseCIDR("10.0.1.1/32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(p.ActiveWithinIPNet(single)); n != 1 {
+		t.Errorf("Unexpected number of peers with IP: expected 1, received %d", n)
+	}
+}
+
+func TestPeerScore(t *testing.T) {
+	p := peers.NewStatus(2)
+
+	good := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(good, &pb.Status{})
+	unknown := addPeer(t, p, peers.PeerConnected)
+	bad := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(bad, &pb.Status{})
+	p.IncrementBadResponses(bad)
+
+	if !(p.Score(good) > p.Score(unknown) && p.Score(unknown) > p.Score(bad)) {
+		t.Errorf("Unexpected score ordering: good %d, unknown %d, bad %d", p.Score(good), p.Score(unknown), p.Score(bad))
+	}
+}
+
 func TestDecay(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(maxBadResponses)
@@ -0,0 +1,178 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+const peerStoreFileName = "peerstore.json"
+
+// peerStoreRetention is how long a peer which has not been seen is kept in the persisted peer store.
+const peerStoreRetention = 7 * 24 * time.Hour
+
+// persistedPeer is the record kept on disk for a peer we have been connected to.
+type persistedPeer struct {
+	ID           string    `json:"id"`
+	Addrs        []string  `json:"addrs"`
+	LastSeen     time.Time `json:"lastSeen"`
+	Score        int       `json:"score"`
+	BadResponses int       `json:"badResponses"`
+}
+
+// loadPeerStore reads the persisted peers from the data directory. A missing file is not an error.
+func loadPeerStore(dataDir string) (map[peer.ID]*persistedPeer, error) {
+	knownPeers := make(map[peer.ID]*persistedPeer)
+	enc, err := ioutil.ReadFile(path.Join(dataDir, peerStoreFileName))
+	if os.IsNotExist(err) {
+		return knownPeers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []*persistedPeer
+	if err := json.Unmarshal(enc, &records); err != nil {
+		return nil, errors.Wrap(err, "could not decode peer store")
+	}
+	for _, record := range records {
+		pid, err := peer.IDB58Decode(record.ID)
+		if err != nil {
+			log.WithError(err).WithField("peer", record.ID).Debug("Skipping invalid persisted peer")
+			continue
+		}
+		knownPeers[pid] = record
+	}
+	return knownPeers, nil
+}
+
+// savePeerStore writes the given peers to the data directory, replacing any previous peer store.
+func savePeerStore(dataDir string, knownPeers map[peer.ID]*persistedPeer) error {
+	records := make([]*persistedPeer, 0, len(knownPeers))
+	for _, record := range knownPeers {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+	enc, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so a crash never leaves a truncated peer store behind.
+	tmpPath := path.Join(dataDir, peerStoreFileName+".tmp")
+	if err := ioutil.WriteFile(tmpPath, enc, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path.Join(dataDir, peerStoreFileName))
+}
+
+// updateKnownPeers records the address, score and bad responses of every connected peer and drops
+// peers which have not been seen within the retention period.
+func updateKnownPeers(knownPeers map[peer.ID]*persistedPeer, status *peers.Status, now time.Time) {
+	for _, pid := range status.Connected() {
+		addr, err := status.Address(pid)
+		if err != nil || addr == nil {
+			continue
+		}
+		badResponses, err := status.BadResponses(pid)
+		if err != nil {
+			continue
+		}
+		knownPeers[pid] = &persistedPeer{
+			ID:           pid.Pretty(),
+			Addrs:        []string{addr.String()},
+			LastSeen:     now,
+			Score:        status.Score(pid),
+			BadResponses: badResponses,
+		}
+	}
+	for pid, record := range knownPeers {
+		if now.Sub(record.LastSeen) > peerStoreRetention {
+			delete(knownPeers, pid)
+		}
+	}
+}
+
+// restorePeerStatus restores the bad responses of the persisted peers, so peers which misbehaved
+// before a restart are still scored down, and rejected once bad, after it.
+func restorePeerStatus(knownPeers map[peer.ID]*persistedPeer, status *peers.Status) {
+	for pid, record := range knownPeers {
+		if record.BadResponses <= 0 || len(record.Addrs) == 0 {
+			continue
+		}
+		addr, err := ma.NewMultiaddr(record.Addrs[0])
+		if err != nil {
+			continue
+		}
+		status.Add(pid, addr, network.DirUnknown)
+		status.SetBadResponses(pid, record.BadResponses)
+	}
+}
+
+// peersToRedial returns the p2p multiaddresses of the persisted peers worth reconnecting to at
+// startup, best scored and most recently seen first, limited to max entries.
+func peersToRedial(knownPeers map[peer.ID]*persistedPeer, max int) []ma.Multiaddr {
+	records := make([]*persistedPeer, 0, len(knownPeers))
+	for _, record := range knownPeers {
+		if record.Score < 0 {
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Score != records[j].Score {
+			return records[i].Score > records[j].Score
+		}
+		return records[i].LastSeen.After(records[j].LastSeen)
+	})
+	addrs := make([]ma.Multiaddr, 0, len(records))
+	for _, record := range records {
+		if len(addrs) >= max {
+			break
+		}
+		for _, addr := range record.Addrs {
+			maddr, err := ma.NewMultiaddr(addr + "/p2p/" + record.ID)
+			if err != nil {
+				continue
+			}
+			addrs = append(addrs, maddr)
+			break
+		}
+	}
+	return addrs
+}
+
+// persistPeers saves the peers we are connected to, so they can be dialed again after a restart.
+func (s *Service) persistPeers() {
+	if s.cfg.DataDir == "" {
+		return
+	}
+	s.knownPeersLock.Lock()
+	defer s.knownPeersLock.Unlock()
+	updateKnownPeers(s.knownPeers, s.peers, roughtime.Now())
+	if err := savePeerStore(s.cfg.DataDir, s.knownPeers); err != nil {
+		log.WithError(err).Error("Could not persist peer store")
+	}
+}
+
+// connectWithPersistedPeers dials the peers persisted by a previous run of the node.
+func (s *Service) connectWithPersistedPeers() {
+	s.knownPeersLock.Lock()
+	addrs := peersToRedial(s.knownPeers, int(s.cfg.MaxPeers))
+	s.knownPeersLock.Unlock()
+	if len(addrs) == 0 {
+		return
+	}
+	log.WithField("peers", len(addrs)).Debug("Reconnecting to persisted peers")
+	s.connectWithAllPeers(addrs)
+}
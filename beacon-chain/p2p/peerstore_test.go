@@ -0,0 +1,165 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+const (
+	testPeerA = "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+	testPeerB = "16Uiu2HAm7Qwe19vz9WzD2Mxn7fXd1vgHHp4iccuyq7TxwRXoAGfc"
+)
+
+func TestPeerStore_SaveAndLoad(t *testing.T) {
+	dataDir, err := ioutil.TempDir(testutil.TempDir(), "peerstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dataDir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	loaded, err := loadPeerStore(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 0 {
+		t.Errorf("Expected no peers from missing peer store, got %d", len(loaded))
+	}
+
+	pid, err := peer.IDB58Decode(testPeerA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	knownPeers := map[peer.ID]*persistedPeer{
+		pid: {
+			ID:       testPeerA,
+			Addrs:    []string{"/ip4/10.0.0.1/tcp/13000"},
+			LastSeen: time.Unix(1000, 0).UTC(),
+			Score:    10,
+		},
+	}
+	if err := savePeerStore(dataDir, knownPeers); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err = loadPeerStore(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded, knownPeers) {
+		t.Errorf("Wanted %v, received %v", knownPeers, loaded)
+	}
+}
+
+func TestUpdateKnownPeers(t *testing.T) {
+	pidA, err := peer.IDB58Decode(testPeerA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pidB, err := peer.IDB58Decode(testPeerB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status := peers.NewStatus(3)
+	status.Add(pidA, addr, network.DirOutbound)
+	status.SetConnectionState(pidA, peers.PeerConnected)
+	status.IncrementBadResponses(pidA)
+
+	now := time.Now()
+	knownPeers := map[peer.ID]*persistedPeer{
+		pidB: {ID: testPeerB, LastSeen: now.Add(-2 * peerStoreRetention)},
+	}
+	updateKnownPeers(knownPeers, status, now)
+
+	if _, ok := knownPeers[pidB]; ok {
+		t.Error("Expected expired peer to be removed")
+	}
+	record, ok := knownPeers[pidA]
+	if !ok {
+		t.Fatal("Expected connected peer to be recorded")
+	}
+	if !record.LastSeen.Equal(now) {
+		t.Errorf("Wanted last seen %v, received %v", now, record.LastSeen)
+	}
+	if len(record.Addrs) != 1 || record.Addrs[0] != addr.String() {
+		t.Errorf("Wanted addresses [%s], received %v", addr, record.Addrs)
+	}
+	if record.BadResponses != 1 {
+		t.Errorf("Wanted 1 bad response, received %d", record.BadResponses)
+	}
+}
+
+func TestRestorePeerStatus(t *testing.T) {
+	pidA, err := peer.IDB58Decode(testPeerA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pidB, err := peer.IDB58Decode(testPeerB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	knownPeers := map[peer.ID]*persistedPeer{
+		pidA: {ID: testPeerA, Addrs: []string{"/ip4/10.0.0.1/tcp/13000"}, Score: -60, BadResponses: 3},
+		pidB: {ID: testPeerB, Addrs: []string{"/ip4/10.0.0.2/tcp/13000"}, Score: 10},
+	}
+	status := peers.NewStatus(3)
+	restorePeerStatus(knownPeers, status)
+
+	if !status.IsBad(pidA) {
+		t.Error("Expected peer which was bad before the restart to still be bad")
+	}
+	if score := status.Score(pidA); score != -60 {
+		t.Errorf("Wanted restored score -60, received %d", score)
+	}
+	if _, err := status.BadResponses(pidB); err != peers.ErrPeerUnknown {
+		t.Errorf("Expected peer without bad responses not to be restored, received %v", err)
+	}
+}
+
+func TestPeersToRedial(t *testing.T) {
+	pidA, err := peer.IDB58Decode(testPeerA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pidB, err := peer.IDB58Decode(testPeerB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	knownPeers := map[peer.ID]*persistedPeer{
+		pidA: {ID: testPeerA, Addrs: []string{"/ip4/10.0.0.1/tcp/13000"}, LastSeen: now, Score: 0},
+		pidB: {ID: testPeerB, Addrs: []string{"/ip4/10.0.0.2/tcp/13000"}, LastSeen: now, Score: 10},
+	}
+
+	addrs := peersToRedial(knownPeers, 2)
+	if len(addrs) != 2 {
+		t.Fatalf("Wanted 2 addresses, received %d", len(addrs))
+	}
+	if want := "/ip4/10.0.0.2/tcp/13000/p2p/" + testPeerB; addrs[0].String() != want {
+		t.Errorf("Wanted best scored peer %s first, received %s", want, addrs[0])
+	}
+
+	if addrs := peersToRedial(knownPeers, 1); len(addrs) != 1 {
+		t.Errorf("Wanted 1 address, received %d", len(addrs))
+	}
+
+	knownPeers[pidA].Score = -20
+	if addrs := peersToRedial(knownPeers, 2); len(addrs) != 1 {
+		t.Errorf("Expected negatively scored peer to be skipped, received %d addresses", len(addrs))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -51,6 +52,12 @@ type Service struct {
 	privKey       *ecdsa.PrivateKey
 	dht           *kaddht.IpfsDHT
 	peers         *peers.Status
+
+	subnetProtected map[peer.ID]bool
+	trustedPeers    map[peer.ID]bool
+	staticPeers     map[peer.ID]bool
+	knownPeers      map[peer.ID]*persistedPeer
+	knownPeersLock  sync.Mutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -127,6 +134,36 @@ func NewService(cfg *Config) (*Service, error) {
 
 	s.peers = peers.NewStatus(maxBadResponses)
 
+	s.trustedPeers = make(map[peer.ID]bool)
+	for _, addr := range cfg.TrustedPeers {
+		info, err := MakePeer(addr)
+		if err != nil {
+			log.WithError(err).WithField("addr", addr).Error("Could not parse trusted peer")
+			continue
+		}
+		s.trustedPeers[info.ID] = true
+	}
+	s.staticPeers = make(map[peer.ID]bool)
+	for _, addr := range cfg.StaticPeers {
+		info, err := MakePeer(addr)
+		if err != nil {
+			log.WithError(err).WithField("addr", addr).Error("Could not parse static peer")
+			continue
+		}
+		s.staticPeers[info.ID] = true
+	}
+
+	s.knownPeers = make(map[peer.ID]*persistedPeer)
+	if cfg.DataDir != "" {
+		knownPeers, err := loadPeerStore(cfg.DataDir)
+		if err != nil {
+			log.WithError(err).Error("Could not load persisted peer store")
+		} else {
+			s.knownPeers = knownPeers
+		}
+	}
+	restorePeerStatus(s.knownPeers, s.peers)
+
 	return s, nil
 }
 
@@ -197,13 +234,25 @@ func (s *Service) Start() {
 
 	s.started = true
 
-	if len(s.cfg.StaticPeers) > 0 {
-		addrs, err := manyMultiAddrsFromString(s.cfg.StaticPeers)
+	// Static and trusted peers are always dialed, redialed when disconnected and never trimmed.
+	staticPeers := append(append([]string{}, s.cfg.StaticPeers...), s.cfg.TrustedPeers...)
+	if len(staticPeers) > 0 {
+		addrs, err := manyMultiAddrsFromString(staticPeers)
 		if err != nil {
 			log.Errorf("Could not connect to static peer: %v", err)
 		}
 		s.connectWithAllPeers(addrs)
+		for _, addr := range staticPeers {
+			peer, err := MakePeer(addr)
+			if err != nil {
+				log.WithError(err).Errorf("Could not create peer")
+				continue
+			}
+			peersToWatch = append(peersToWatch, addr)
+			s.host.ConnManager().Protect(peer.ID, "static")
+		}
 	}
+	go s.connectWithPersistedPeers()
 
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, 5*time.Second, func() {
@@ -211,6 +260,9 @@ func (s *Service) Start() {
 	})
 	runutil.RunEvery(s.ctx, time.Hour, s.Peers().Decay)
 	runutil.RunEvery(s.ctx, 10*time.Second, s.updateMetrics)
+	runutil.RunEvery(s.ctx, 10*time.Second, s.updatePeerScores)
+	runutil.RunEvery(s.ctx, 10*time.Second, s.protectSubnetPeers)
+	runutil.RunEvery(s.ctx, 5*time.Minute, s.persistPeers)
 
 	multiAddrs := s.host.Network().ListenAddresses()
 	logIP4Addr(s.host.ID(), multiAddrs...)
@@ -232,6 +284,7 @@ func (s *Service) Start() {
 func (s *Service) Stop() error {
 	defer s.cancel()
 	s.started = false
+	s.persistPeers()
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
@@ -14,6 +14,10 @@ func (s *Service) BlockExists(ctx context.Context, hash common.Hash) (bool, *big
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockExists")
 	defer span.End()
 
+	if h := s.headerCache.byHash(hash); h != nil {
+		span.AddAttributes(trace.BoolAttribute("headerCacheHit", true))
+		return true, new(big.Int).SetUint64(h.Number), nil
+	}
 	if exists, blkInfo, err := s.blockCache.BlockInfoByHash(hash); exists || err != nil {
 		if err != nil {
 			return false, nil, err
@@ -22,7 +26,7 @@ func (s *Service) BlockExists(ctx context.Context, hash common.Hash) (bool, *big
 		return true, blkInfo.Number, nil
 	}
 	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
-	block, err := s.blockFetcher.BlockByHash(ctx, hash)
+	block, err := s.fetcher().BlockByHash(ctx, hash)
 	if err != nil {
 		return false, big.NewInt(0), errors.Wrap(err, "could not query block with given hash")
 	}
@@ -47,7 +51,7 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 		return blkInfo.Hash, nil
 	}
 	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
-	block, err := s.blockFetcher.BlockByNumber(ctx, height)
+	block, err := s.fetcher().BlockByNumber(ctx, height)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not query block with given height")
 	}
@@ -61,7 +65,7 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 func (s *Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockTimeByHeight")
 	defer span.End()
-	block, err := s.blockFetcher.BlockByNumber(ctx, height)
+	block, err := s.fetcher().BlockByNumber(ctx, height)
 	if err != nil {
 		return 0, errors.Wrap(err, "could not query block with given height")
 	}
@@ -76,7 +80,7 @@ func (s *Service) BlockNumberByTimestamp(ctx context.Context, time uint64) (*big
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockByTimestamp")
 	defer span.End()
 
-	head, err := s.blockFetcher.BlockByNumber(ctx, nil)
+	head, err := s.fetcher().BlockByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +96,7 @@ func (s *Service) BlockNumberByTimestamp(ctx context.Context, time uint64) (*big
 		}
 
 		if !exists {
-			blk, err := s.blockFetcher.BlockByNumber(ctx, bn)
+			blk, err := s.fetcher().BlockByNumber(ctx, bn)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,255 @@
+package powchain
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	classifiedDepositsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "powchain_classified_deposits_total",
+		Help: "The number of deposits from the deposit contract by classification",
+	}, []string{"classification"})
+	unresolvedDepositSenders = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_unresolved_deposit_senders",
+		Help: "The number of audited deposits whose eth1 sender is not yet known",
+	})
+)
+
+// depositSenderBatchLimit is the number of deposit transactions looked up in one batch request.
+const depositSenderBatchLimit = 100
+
+// depositTransaction is the part of an eth1 transaction needed to audit deposits.
+type depositTransaction struct {
+	From common.Address `json:"from"`
+}
+
+// classifyDeposit determines what a deposit does to the beacon chain. A deposit for a public key
+// with a validator is a top-up, which the state transition applies without checking its
+// signature. Any other deposit creates a validator, unless its signature does not verify.
+func classifyDeposit(data *ethpb.Deposit_Data, hasValidator bool) protodb.DepositAudit_Classification {
+	if hasValidator {
+		return protodb.DepositAudit_TOP_UP
+	}
+	if err := blocks.VerifyDepositSignature(data); err != nil {
+		return protodb.DepositAudit_INVALID_SIGNATURE
+	}
+	creds := data.WithdrawalCredentials
+	if len(creds) != 32 || creds[0] != params.BeaconConfig().BLSWithdrawalPrefixByte {
+		return protodb.DepositAudit_INVALID_WITHDRAWAL_CREDENTIALS
+	}
+	return protodb.DepositAudit_NEW_VALIDATOR
+}
+
+// createsValidator is true for the classifications of deposits which add a validator to the
+// state. Funds of validators with invalid withdrawal credentials cannot be withdrawn.
+func createsValidator(c protodb.DepositAudit_Classification) bool {
+	return c == protodb.DepositAudit_NEW_VALIDATOR || c == protodb.DepositAudit_INVALID_WITHDRAWAL_CREDENTIALS
+}
+
+// newDepositAudit classifies the deposit and records which validator it creates. The caller
+// holds the deposit audit lock.
+func (s *Service) newDepositAudit(index uint64, data *ethpb.Deposit_Data) *protodb.DepositAudit {
+	pubkey := bytesutil.ToBytes48(data.PublicKey)
+	// A deposit processed again after a restart is compared with the deposits before it.
+	creator, ok := s.validatorDeposits[pubkey]
+	audit := &protodb.DepositAudit{
+		Index:                 index,
+		PublicKey:             data.PublicKey,
+		WithdrawalCredentials: data.WithdrawalCredentials,
+		Amount:                data.Amount,
+		Classification:        classifyDeposit(data, ok && creator < index),
+	}
+	if createsValidator(audit.Classification) {
+		s.validatorDeposits[pubkey] = index
+	}
+	classifiedDepositsCount.WithLabelValues(strings.ToLower(audit.Classification.String())).Inc()
+	return audit
+}
+
+// auditDeposit classifies a deposit from the deposit contract and saves it. The sender of the
+// deposit transaction is resolved later, in batches.
+func (s *Service) auditDeposit(ctx context.Context, depositLog gethTypes.Log, index uint64, data *ethpb.Deposit_Data) error {
+	s.depositAuditLock.Lock()
+	defer s.depositAuditLock.Unlock()
+
+	audit := s.newDepositAudit(index, data)
+	audit.Eth1BlockNumber = depositLog.BlockNumber
+	audit.Eth1BlockHash = depositLog.BlockHash.Bytes()
+	audit.Eth1TxHash = depositLog.TxHash.Bytes()
+	if err := s.beaconDB.SaveDepositAudits(ctx, []*protodb.DepositAudit{audit}); err != nil {
+		return err
+	}
+	s.unresolvedAudits = append(s.unresolvedAudits, audit)
+	unresolvedDepositSenders.Set(float64(len(s.unresolvedAudits)))
+
+	if audit.Classification == protodb.DepositAudit_INVALID_SIGNATURE ||
+		audit.Classification == protodb.DepositAudit_INVALID_WITHDRAWAL_CREDENTIALS {
+		log.WithFields(logrus.Fields{
+			"publicKey":       fmt.Sprintf("%#x", data.PublicKey),
+			"merkleTreeIndex": index,
+			"eth1Tx":          depositLog.TxHash.Hex(),
+			"classification":  audit.Classification,
+		}).Warn("Deposit will not create a usable validator")
+	}
+	return nil
+}
+
+// initDepositAudits loads the saved deposit audits, and audits the deposits in the deposit cache
+// which were processed before deposits were audited.
+func (s *Service) initDepositAudits(ctx context.Context) error {
+	s.depositAuditLock.Lock()
+	defer s.depositAuditLock.Unlock()
+
+	audits, err := s.beaconDB.DepositAudits(ctx)
+	if err != nil {
+		return err
+	}
+	audited := make(map[uint64]*protodb.DepositAudit, len(audits))
+	for _, audit := range audits {
+		audited[audit.Index] = audit
+	}
+
+	var backfilled []*protodb.DepositAudit
+	s.unresolvedAudits = nil
+	for _, ctr := range s.depositCache.AllDepositContainers(ctx) {
+		index := uint64(ctr.Index)
+		if audit, ok := audited[index]; ok {
+			if createsValidator(audit.Classification) {
+				pubkey := bytesutil.ToBytes48(audit.PublicKey)
+				if _, ok := s.validatorDeposits[pubkey]; !ok {
+					s.validatorDeposits[pubkey] = index
+				}
+			}
+			if len(audit.Eth1Sender) == 0 {
+				s.unresolvedAudits = append(s.unresolvedAudits, audit)
+			}
+			continue
+		}
+		if ctr.Deposit == nil || ctr.Deposit.Data == nil {
+			continue
+		}
+		audit := s.newDepositAudit(index, ctr.Deposit.Data)
+		audit.Eth1BlockNumber = ctr.Eth1BlockHeight
+		backfilled = append(backfilled, audit)
+		s.unresolvedAudits = append(s.unresolvedAudits, audit)
+	}
+	unresolvedDepositSenders.Set(float64(len(s.unresolvedAudits)))
+	if len(backfilled) == 0 {
+		return nil
+	}
+	log.WithField("deposits", len(backfilled)).Info("Audited previously processed deposits")
+	return s.beaconDB.SaveDepositAudits(ctx, backfilled)
+}
+
+// resolveDepositSenders looks up the eth1 transactions of a batch of audited deposits, and saves
+// the addresses which sent them. Deposits audited from the deposit cache are first matched with
+// their deposit logs.
+func (s *Service) resolveDepositSenders(ctx context.Context) error {
+	s.depositAuditLock.Lock()
+	defer s.depositAuditLock.Unlock()
+	if len(s.unresolvedAudits) == 0 {
+		return nil
+	}
+	batch := s.unresolvedAudits
+	if len(batch) > depositSenderBatchLimit {
+		batch = batch[:depositSenderBatchLimit]
+	}
+	if err := s.resolveDepositTransactions(ctx, batch); err != nil {
+		return errors.Wrap(err, "could not get deposit logs")
+	}
+
+	elems := make([]gethRPC.BatchElem, len(batch))
+	txs := make([]*depositTransaction, len(batch))
+	for i, audit := range batch {
+		txs[i] = &depositTransaction{}
+		elems[i] = gethRPC.BatchElem{
+			Method: "eth_getTransactionByHash",
+			Args:   []interface{}{common.BytesToHash(audit.Eth1TxHash)},
+			Result: txs[i],
+		}
+	}
+	if err := s.batchCaller().BatchCall(elems); err != nil {
+		return errors.Wrap(err, "could not get deposit transactions")
+	}
+	resolved := make([]*protodb.DepositAudit, 0, len(batch))
+	for i, audit := range batch {
+		if elems[i].Error != nil {
+			return errors.Wrapf(elems[i].Error, "could not get deposit transaction %#x", audit.Eth1TxHash)
+		}
+		// A transaction removed by a reorg is retried after its deposit log is processed again.
+		if txs[i].From == (common.Address{}) {
+			continue
+		}
+		audit.Eth1Sender = txs[i].From.Bytes()
+		resolved = append(resolved, audit)
+	}
+	if err := s.beaconDB.SaveDepositAudits(ctx, resolved); err != nil {
+		return err
+	}
+	s.unresolvedAudits = s.unresolvedAudits[len(batch):]
+	unresolvedDepositSenders.Set(float64(len(s.unresolvedAudits)))
+	return nil
+}
+
+// resolveDepositTransactions sets the eth1 transaction of audits which do not have it, using the
+// deposit logs in the block range of the audits.
+func (s *Service) resolveDepositTransactions(ctx context.Context, audits []*protodb.DepositAudit) error {
+	missing := make(map[uint64]*protodb.DepositAudit)
+	var fromBlock, toBlock uint64
+	for _, audit := range audits {
+		if len(audit.Eth1TxHash) != 0 {
+			continue
+		}
+		if len(missing) == 0 || audit.Eth1BlockNumber < fromBlock {
+			fromBlock = audit.Eth1BlockNumber
+		}
+		if audit.Eth1BlockNumber > toBlock {
+			toBlock = audit.Eth1BlockNumber
+		}
+		missing[audit.Index] = audit
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	logs, err := s.filterer().FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{s.depositContractAddress},
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+	})
+	if err != nil {
+		return err
+	}
+	for _, depositLog := range logs {
+		if len(depositLog.Topics) == 0 || depositLog.Topics[0] != depositEventSignature {
+			continue
+		}
+		_, _, _, _, merkleTreeIndex, err := contracts.UnpackDepositLogData(depositLog.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not unpack log")
+		}
+		if audit, ok := missing[binary.LittleEndian.Uint64(merkleTreeIndex)]; ok {
+			audit.Eth1BlockHash = depositLog.BlockHash.Bytes()
+			audit.Eth1TxHash = depositLog.TxHash.Bytes()
+		}
+	}
+	return nil
+}
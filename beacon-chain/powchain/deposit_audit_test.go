@@ -0,0 +1,255 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// senderRPCClient answers transaction lookups with a fixed sender.
+type senderRPCClient struct {
+	from common.Address
+}
+
+func (c *senderRPCClient) BatchCall(b []gethRPC.BatchElem) error {
+	for _, elem := range b {
+		elem.Result.(*depositTransaction).From = c.from
+	}
+	return nil
+}
+
+func signedDepositData(t *testing.T, key *bls.SecretKey, withdrawalPrefix byte) *ethpb.Deposit_Data {
+	pubkey := key.PublicKey().Marshal()
+	creds := hashutil.Hash(pubkey)
+	creds[0] = withdrawalPrefix
+	data := &ethpb.Deposit_Data{
+		PublicKey:             pubkey,
+		WithdrawalCredentials: creds[:],
+		Amount:                params.BeaconConfig().MaxEffectiveBalance,
+	}
+	root, err := ssz.SigningRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data.Signature = key.Sign(root[:], bls.ComputeDomain(params.BeaconConfig().DomainDeposit)).Marshal()
+	return data
+}
+
+func TestClassifyDeposit(t *testing.T) {
+	keys, _, err := interop.DeterministicallyGenerateKeys(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := params.BeaconConfig().BLSWithdrawalPrefixByte
+	valid := signedDepositData(t, keys[0], prefix)
+	badSignature := proto.Clone(valid).(*ethpb.Deposit_Data)
+	badSignature.Signature = signedDepositData(t, keys[1], prefix).Signature
+
+	tests := []struct {
+		name         string
+		data         *ethpb.Deposit_Data
+		hasValidator bool
+		want         protodb.DepositAudit_Classification
+	}{
+		{name: "new validator", data: valid, want: protodb.DepositAudit_NEW_VALIDATOR},
+		{name: "top-up", data: valid, hasValidator: true, want: protodb.DepositAudit_TOP_UP},
+		{name: "top-up with invalid signature", data: badSignature, hasValidator: true, want: protodb.DepositAudit_TOP_UP},
+		{name: "invalid signature", data: badSignature, want: protodb.DepositAudit_INVALID_SIGNATURE},
+		{
+			name: "invalid withdrawal credentials",
+			data: signedDepositData(t, keys[0], prefix+1),
+			want: protodb.DepositAudit_INVALID_WITHDRAWAL_CREDENTIALS,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyDeposit(tt.data, tt.hasValidator); got != tt.want {
+				t.Errorf("Expected %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProcessDepositLog_AuditsDeposits(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		ETH1Endpoint:    endpoint,
+		DepositContract: testAcc.ContractAddr,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service = setDefaultMocks(web3Service)
+
+	keys, _, err := interop.DeterministicallyGenerateKeys(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := params.BeaconConfig().BLSWithdrawalPrefixByte
+	badSignature := signedDepositData(t, keys[1], prefix)
+	badSignature.Signature = signedDepositData(t, keys[0], prefix).Signature
+	deposits := []*ethpb.Deposit_Data{
+		signedDepositData(t, keys[0], prefix),
+		signedDepositData(t, keys[0], prefix),
+		badSignature,
+	}
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	for _, data := range deposits {
+		root, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, root); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+	}
+	testAcc.Backend.Commit()
+
+	logs, err := testAcc.Backend.FilterLogs(web3Service.ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{web3Service.depositContractAddress},
+	})
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+	for _, depositLog := range logs {
+		if err := web3Service.ProcessLog(context.Background(), depositLog); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	audits, err := beaconDB.DepositAudits(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []protodb.DepositAudit_Classification{
+		protodb.DepositAudit_NEW_VALIDATOR,
+		protodb.DepositAudit_TOP_UP,
+		protodb.DepositAudit_INVALID_SIGNATURE,
+	}
+	if len(audits) != len(want) {
+		t.Fatalf("Expected %d audits, received %d", len(want), len(audits))
+	}
+	for i, audit := range audits {
+		if audit.Classification != want[i] {
+			t.Errorf("Expected deposit %d to be %v, received %v", i, want[i], audit.Classification)
+		}
+		if !bytes.Equal(audit.Eth1TxHash, logs[i].TxHash.Bytes()) {
+			t.Errorf("Expected deposit %d in transaction %#x, received %#x", i, logs[i].TxHash, audit.Eth1TxHash)
+		}
+	}
+
+	web3Service.rpcClient = &senderRPCClient{from: testAcc.Addr}
+	if err := web3Service.resolveDepositSenders(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	bySender, err := beaconDB.DepositAuditsBySender(context.Background(), testAcc.Addr.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bySender) != 3 {
+		t.Errorf("Expected 3 deposits from sender, received %d", len(bySender))
+	}
+	if len(web3Service.unresolvedAudits) != 0 {
+		t.Errorf("Expected all senders to be resolved, %d remain", len(web3Service.unresolvedAudits))
+	}
+}
+
+func TestInitDepositAudits_AuditsCachedDeposits(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	depositCache := depositcache.NewDepositCache()
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		ETH1Endpoint:    endpoint,
+		DepositContract: testAcc.ContractAddr,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositCache,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service = setDefaultMocks(web3Service)
+	web3Service.httpLogger = &goodLogger{backend: testAcc.Backend}
+
+	keys, _, err := interop.DeterministicallyGenerateKeys(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := signedDepositData(t, keys[0], params.BeaconConfig().BLSWithdrawalPrefixByte)
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	// Deposits processed before auditing are only in the deposit cache.
+	for i := 0; i < 2; i++ {
+		if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, root); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+		testAcc.Backend.Commit()
+		blockNumber := testAcc.Backend.Blockchain().CurrentBlock().NumberU64()
+		depositCache.InsertDeposit(context.Background(), &ethpb.Deposit{Data: data}, blockNumber, int64(i), [32]byte{})
+	}
+
+	if err := web3Service.initDepositAudits(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	audits, err := beaconDB.DepositAuditsByPublicKey(context.Background(), data.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(audits) != 2 {
+		t.Fatalf("Expected 2 audits, received %d", len(audits))
+	}
+	if audits[0].Classification != protodb.DepositAudit_NEW_VALIDATOR || audits[1].Classification != protodb.DepositAudit_TOP_UP {
+		t.Errorf("Unexpected classifications %v and %v", audits[0].Classification, audits[1].Classification)
+	}
+	// Processing the first deposit again does not turn it into a top-up.
+	if audit := web3Service.newDepositAudit(0, data); audit.Classification != protodb.DepositAudit_NEW_VALIDATOR {
+		t.Errorf("Expected reprocessed deposit to be a new validator, received %v", audit.Classification)
+	}
+
+	web3Service.rpcClient = &senderRPCClient{from: testAcc.Addr}
+	if err := web3Service.resolveDepositSenders(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	audits, err = beaconDB.DepositAuditsBySender(context.Background(), testAcc.Addr.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(audits) != 2 {
+		t.Fatalf("Expected 2 audits by sender, received %d", len(audits))
+	}
+	for _, audit := range audits {
+		if len(audit.Eth1TxHash) == 0 || len(audit.Eth1BlockHash) == 0 {
+			t.Errorf("Expected transaction of deposit %d to be resolved from its log", audit.Index)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	endpointHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_healthy",
+		Help: "Whether the eth1 endpoint passed its last health check (0 or 1)",
+	}, []string{"endpoint"})
+	endpointActive = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_active",
+		Help: "Whether the eth1 endpoint is the one the beacon node follows (0 or 1)",
+	}, []string{"endpoint"})
+	endpointHeadBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_head_block",
+		Help: "The latest block number reported by the eth1 endpoint",
+	}, []string{"endpoint"})
+	endpointErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "powchain_endpoint_errors_total",
+		Help: "The number of failed health checks and requests of the eth1 endpoint",
+	}, []string{"endpoint"})
+)
+
+// time between health checks of the eth1 endpoints.
+var endpointHealthCheckPeriod = time.Minute
+
+// time after which an unanswered health check fails.
+var endpointHealthCheckTimeout = 10 * time.Second
+
+// maxHeadAge is the age of the latest block of an eth1 endpoint after which it is considered
+// to no longer follow the chain. The max mining time is 278 sec (block 7208027).
+var maxHeadAge = 5 * time.Minute
+
+// Endpoint of an eth1 node. The WebSocket or IPC endpoint is used to subscribe to new
+// headers, the HTTP endpoint for all other requests.
+type Endpoint struct {
+	ETH1Endpoint string
+	HTTPEndpoint string
+}
+
+// healthClient defines the eth1 requests used to check the health of an endpoint.
+type healthClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error)
+}
+
+// checkHealth returns an error if the eth1 node is syncing, is on another chain than the
+// expected chain ID, or has not seen a block for a while. The latest header is returned for
+// healthy nodes.
+func checkHealth(ctx context.Context, client healthClient, chainID *big.Int, now time.Time) (*gethTypes.Header, error) {
+	progress, err := client.SyncProgress(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	if progress != nil {
+		return nil, fmt.Errorf("eth1 node is syncing, at block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+	}
+	id, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain ID")
+	}
+	if chainID != nil && id.Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("eth1 node is on chain ID %d, expected %d", id, chainID)
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest header")
+	}
+	if age := now.Sub(time.Unix(int64(header.Time), 0)); age > maxHeadAge {
+		return nil, fmt.Errorf("latest eth1 block %d is %v old", header.Number, age.Round(time.Second))
+	}
+	return header, nil
+}
+
+// endpointClient returns the HTTP client of the endpoint used for health checks and quorum
+// requests, dialing it if needed.
+func (s *Service) endpointClient(i int) (*ethclient.Client, error) {
+	s.endpointClientsLock.Lock()
+	defer s.endpointClientsLock.Unlock()
+	if s.endpointClients[i] == nil {
+		client, err := ethclient.Dial(s.endpoints[i].HTTPEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		s.endpointClients[i] = client
+	}
+	return s.endpointClients[i], nil
+}
+
+// checkEndpoint checks the health of the endpoint and updates its metrics. The chain ID of
+// the first healthy endpoint is the chain ID every other endpoint must be on.
+func (s *Service) checkEndpoint(ctx context.Context, i int) error {
+	label := endpointLabel(s.endpoints[i].HTTPEndpoint)
+	ctx, cancel := context.WithTimeout(ctx, endpointHealthCheckTimeout)
+	defer cancel()
+	err := func() error {
+		client, err := s.endpointClient(i)
+		if err != nil {
+			return err
+		}
+		s.connectionLock.RLock()
+		chainID := s.eth1ChainID
+		s.connectionLock.RUnlock()
+		header, err := checkHealth(ctx, client, chainID, time.Now())
+		if err != nil {
+			return err
+		}
+		endpointHeadBlock.WithLabelValues(label).Set(float64(header.Number.Uint64()))
+		if chainID == nil {
+			id, err := client.ChainID(ctx)
+			if err != nil {
+				return errors.Wrap(err, "could not get chain ID")
+			}
+			s.connectionLock.Lock()
+			if s.eth1ChainID == nil {
+				s.eth1ChainID = id
+			}
+			s.connectionLock.Unlock()
+		}
+		return nil
+	}()
+	if err != nil {
+		endpointHealthy.WithLabelValues(label).Set(0)
+		endpointErrors.WithLabelValues(label).Inc()
+		return err
+	}
+	endpointHealthy.WithLabelValues(label).Set(1)
+	return nil
+}
+
+// firstHealthyEndpoint returns the index of the first healthy endpoint in order of
+// preference, or -1 if none are healthy.
+func (s *Service) firstHealthyEndpoint(ctx context.Context) int {
+	for i := range s.endpoints {
+		if err := s.checkEndpoint(ctx, i); err != nil {
+			log.WithError(err).WithField("endpoint", endpointLabel(s.endpoints[i].HTTPEndpoint)).Warn("Eth1 endpoint is unhealthy")
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// connectToEndpoint dials the endpoint and makes it the one the service follows.
+func (s *Service) connectToEndpoint(i int) error {
+	endpoint := s.endpoints[i]
+	s.eth1Endpoint = endpoint.ETH1Endpoint
+	s.httpEndpoint = endpoint.HTTPEndpoint
+	if err := s.connectToPowChain(); err != nil {
+		endpointErrors.WithLabelValues(endpointLabel(endpoint.HTTPEndpoint)).Inc()
+		return err
+	}
+	if len(s.endpoints) > 1 {
+		log.WithFields(logrus.Fields{
+			"endpoint": endpointLabel(endpoint.HTTPEndpoint),
+			"priority": i,
+		}).Info("Following eth1 endpoint")
+	}
+	for j, e := range s.endpoints {
+		active := float64(0)
+		if j == i {
+			active = 1
+		}
+		endpointActive.WithLabelValues(endpointLabel(e.HTTPEndpoint)).Set(active)
+	}
+	s.currentEndpoint = i
+	return nil
+}
+
+// connectToBestEndpoint connects to the first healthy endpoint in order of preference. When
+// no endpoint passes its health check, the endpoints are dialed in order so the service can
+// still follow an endpoint which is, for example, still syncing.
+func (s *Service) connectToBestEndpoint() error {
+	if i := s.firstHealthyEndpoint(s.ctx); i >= 0 {
+		if err := s.connectToEndpoint(i); err == nil {
+			return nil
+		}
+	}
+	var err error
+	for i := range s.endpoints {
+		if err = s.connectToEndpoint(i); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// switchToBestEndpoint checks the health of the endpoints and fails over to the first healthy
+// one, or back to a preferred endpoint once it has recovered. It reports whether the service
+// now follows another endpoint.
+func (s *Service) switchToBestEndpoint() bool {
+	if len(s.endpoints) == 1 {
+		if err := s.checkEndpoint(s.ctx, 0); err != nil {
+			log.WithError(err).Warn("Eth1 endpoint is unhealthy")
+		}
+		return false
+	}
+	i := s.firstHealthyEndpoint(s.ctx)
+	if i < 0 {
+		log.Error("No eth1 endpoint is healthy")
+		return false
+	}
+	if i == s.currentEndpoint {
+		return false
+	}
+	previous := s.currentEndpoint
+	if err := s.connectToEndpoint(i); err != nil {
+		log.WithError(err).WithField("endpoint", endpointLabel(s.endpoints[i].HTTPEndpoint)).Error("Could not switch eth1 endpoint")
+		return false
+	}
+	log.WithFields(logrus.Fields{
+		"from": endpointLabel(s.endpoints[previous].HTTPEndpoint),
+		"to":   endpointLabel(s.endpoints[i].HTTPEndpoint),
+	}).Warn("Switched eth1 endpoint")
+	return true
+}
+
+// endpointLabel identifies an endpoint in logs and metrics without the path and query of its
+// URL, which often contain API keys.
+func endpointLabel(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return filepath.Base(endpoint)
+	}
+	return u.Host
+}
@@ -0,0 +1,137 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+)
+
+type fakeHealthClient struct {
+	chainID  *big.Int
+	progress *ethereum.SyncProgress
+	head     *gethTypes.Header
+	err      error
+}
+
+func (f *fakeHealthClient) ChainID(_ context.Context) (*big.Int, error) {
+	return f.chainID, f.err
+}
+
+func (f *fakeHealthClient) SyncProgress(_ context.Context) (*ethereum.SyncProgress, error) {
+	return f.progress, f.err
+}
+
+func (f *fakeHealthClient) HeaderByNumber(_ context.Context, _ *big.Int) (*gethTypes.Header, error) {
+	return f.head, f.err
+}
+
+func TestCheckHealth(t *testing.T) {
+	now := time.Now()
+	head := &gethTypes.Header{Number: big.NewInt(100), Time: uint64(now.Add(-time.Minute).Unix())}
+	staleHead := &gethTypes.Header{Number: big.NewInt(100), Time: uint64(now.Add(-time.Hour).Unix())}
+	tests := []struct {
+		name    string
+		client  *fakeHealthClient
+		chainID *big.Int
+		wantErr string
+	}{
+		{
+			name:    "healthy",
+			client:  &fakeHealthClient{chainID: big.NewInt(5), head: head},
+			chainID: big.NewInt(5),
+		},
+		{
+			name:   "healthy without known chain ID",
+			client: &fakeHealthClient{chainID: big.NewInt(5), head: head},
+		},
+		{
+			name:    "request fails",
+			client:  &fakeHealthClient{err: errors.New("connection refused")},
+			wantErr: "connection refused",
+		},
+		{
+			name:    "syncing",
+			client:  &fakeHealthClient{chainID: big.NewInt(5), head: head, progress: &ethereum.SyncProgress{CurrentBlock: 10, HighestBlock: 100}},
+			wantErr: "syncing",
+		},
+		{
+			name:    "wrong chain",
+			client:  &fakeHealthClient{chainID: big.NewInt(1), head: head},
+			chainID: big.NewInt(5),
+			wantErr: "chain ID 1",
+		},
+		{
+			name:    "stale head",
+			client:  &fakeHealthClient{chainID: big.NewInt(5), head: staleHead},
+			chainID: big.NewInt(5),
+			wantErr: "old",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, err := checkHealth(context.Background(), tt.client, tt.chainID, now)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if header != tt.client.head {
+					t.Error("Expected the latest header to be returned")
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEndpointLabel(t *testing.T) {
+	tests := map[string]string{
+		"https://mainnet.infura.io/v3/secret-key": "mainnet.infura.io",
+		"wss://goerli.prylabs.net/websocket":      "goerli.prylabs.net",
+		"http://127.0.0.1:8545":                   "127.0.0.1:8545",
+		"/home/user/.ethereum/geth.ipc":           "geth.ipc",
+	}
+	for endpoint, want := range tests {
+		if got := endpointLabel(endpoint); got != want {
+			t.Errorf("endpointLabel(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
+
+func TestInitializeConnection_ClosesPreviousClients(t *testing.T) {
+	dial := func() (*ethclient.Client, *gethRPC.Client) {
+		rpcClient := gethRPC.DialInProc(gethRPC.NewServer())
+		return ethclient.NewClient(rpcClient), rpcClient
+	}
+	s := &Service{}
+	oldPow, oldPowRPC := dial()
+	oldHTTP, oldHTTPRPC := dial()
+	s.initializeConnection(oldPow, oldHTTP, oldHTTPRPC, nil)
+	newPow, newPowRPC := dial()
+	newHTTP, newHTTPRPC := dial()
+	s.initializeConnection(newPow, newHTTP, newHTTPRPC, nil)
+
+	for _, c := range []*gethRPC.Client{oldPowRPC, oldHTTPRPC} {
+		if err := c.Call(nil, "eth_chainId"); err != gethRPC.ErrClientQuit {
+			t.Errorf("Expected the client of the previous endpoint to be closed, received %v", err)
+		}
+	}
+	for _, c := range []*gethRPC.Client{newPowRPC, newHTTPRPC} {
+		if err := c.Call(nil, "eth_chainId"); err == gethRPC.ErrClientQuit {
+			t.Error("Expected the client of the followed endpoint to remain open")
+		}
+	}
+	if s.fetcher() != newHTTP || s.headReader() != newPow {
+		t.Error("Expected the service to use the clients of the followed endpoint")
+	}
+}
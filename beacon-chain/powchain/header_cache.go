@@ -0,0 +1,246 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	headerCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_header_cache_size",
+		Help: "The number of eth1 headers in the eth1 data voting window cache",
+	})
+	headerCacheReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_header_cache_reorged_headers_total",
+		Help: "The number of cached eth1 headers replaced by an eth1 chain reorg",
+	})
+)
+
+// headerCacheWindow is the number of blocks before the follow distance block which are kept,
+// enough for any candidate block of the current eth1 voting period.
+func headerCacheWindow() uint64 {
+	cfg := params.BeaconConfig()
+	periodBlocks := cfg.SlotsPerEth1VotingPeriod * cfg.SecondsPerSlot / cfg.SecondsPerETH1Block
+	return cfg.Eth1FollowDistance + periodBlocks
+}
+
+// headerCache holds a contiguous chain of eth1 headers, in ascending order of block number,
+// with the deposit count and root at each header.
+type headerCache struct {
+	lock    sync.RWMutex
+	headers []*protodb.ETH1Header
+}
+
+// extends returns true if the header is the child of the latest cached header, or the cache
+// is empty.
+func (c *headerCache) extends(h *protodb.ETH1Header) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if len(c.headers) == 0 {
+		return true
+	}
+	latest := c.headers[len(c.headers)-1]
+	return latest.Number+1 == h.Number && bytes.Equal(latest.BlockHash, h.ParentHash)
+}
+
+// add appends the header. The caller checks that the header extends the cache.
+func (c *headerCache) add(h *protodb.ETH1Header) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.headers = append(c.headers, h)
+	headerCacheSize.Set(float64(len(c.headers)))
+}
+
+// latest returns the latest cached header, or nil if the cache is empty.
+func (c *headerCache) latest() *protodb.ETH1Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if len(c.headers) == 0 {
+		return nil
+	}
+	return c.headers[len(c.headers)-1]
+}
+
+// removeLatest removes and returns the latest cached header.
+func (c *headerCache) removeLatest() *protodb.ETH1Header {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.headers) == 0 {
+		return nil
+	}
+	h := c.headers[len(c.headers)-1]
+	c.headers = c.headers[:len(c.headers)-1]
+	headerCacheSize.Set(float64(len(c.headers)))
+	return h
+}
+
+// prune removes the headers below the block number and returns their numbers.
+func (c *headerCache) prune(number uint64) []uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	i := sort.Search(len(c.headers), func(i int) bool {
+		return c.headers[i].Number >= number
+	})
+	pruned := make([]uint64, i)
+	for j := 0; j < i; j++ {
+		pruned[j] = c.headers[j].Number
+	}
+	c.headers = c.headers[i:]
+	headerCacheSize.Set(float64(len(c.headers)))
+	return pruned
+}
+
+// byHash returns the cached header with the block hash, or nil.
+func (c *headerCache) byHash(hash common.Hash) *protodb.ETH1Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for i := len(c.headers) - 1; i >= 0; i-- {
+		if bytes.Equal(c.headers[i].BlockHash, hash[:]) {
+			return c.headers[i]
+		}
+	}
+	return nil
+}
+
+// inTimeRange returns the cached headers with a timestamp in the inclusive range.
+func (c *headerCache) inTimeRange(start uint64, end uint64) []*protodb.ETH1Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	i := sort.Search(len(c.headers), func(i int) bool {
+		return c.headers[i].Timestamp >= start
+	})
+	var headers []*protodb.ETH1Header
+	for ; i < len(c.headers) && c.headers[i].Timestamp <= end; i++ {
+		headers = append(headers, c.headers[i])
+	}
+	return headers
+}
+
+// Eth1DataCandidates returns the eth1 data of the cached eth1 blocks with a timestamp in the
+// inclusive range, in ascending order of block number. No requests are made to the eth1 node.
+func (s *Service) Eth1DataCandidates(start uint64, end uint64) []*ethpb.Eth1Data {
+	headers := s.headerCache.inTimeRange(start, end)
+	candidates := make([]*ethpb.Eth1Data, len(headers))
+	for i, h := range headers {
+		candidates[i] = &ethpb.Eth1Data{
+			DepositRoot:  h.DepositRoot,
+			DepositCount: h.DepositCount,
+			BlockHash:    h.BlockHash,
+		}
+	}
+	return candidates
+}
+
+// initHeaderCache loads the saved headers, keeping the longest contiguous chain ending with the
+// latest saved header.
+func (s *Service) initHeaderCache(ctx context.Context) error {
+	headers, err := s.beaconDB.Eth1Headers(ctx)
+	if err != nil {
+		return err
+	}
+	var stale []uint64
+	for _, h := range headers {
+		if !s.headerCache.extends(h) {
+			stale = append(stale, s.headerCache.prune(h.Number)...)
+		}
+		s.headerCache.add(h)
+	}
+	if len(stale) > 0 {
+		return s.beaconDB.DeleteEth1Headers(ctx, stale)
+	}
+	return nil
+}
+
+// updateHeaderCache adds the headers up to the follow distance block whose deposit logs have
+// been processed. When the parent of a new header does not match the latest cached header, the
+// eth1 chain has reorganized and cached headers are replaced until the chains join again.
+func (s *Service) updateHeaderCache(ctx context.Context) error {
+	distance := params.BeaconConfig().Eth1FollowDistance
+	if s.latestEth1Data.BlockHeight < distance {
+		return nil
+	}
+	target := s.latestEth1Data.BlockHeight - distance
+	if target > s.latestEth1Data.LastRequestedBlock {
+		target = s.latestEth1Data.LastRequestedBlock
+	}
+	start := uint64(0)
+	if window := headerCacheWindow(); target > window {
+		start = target - window
+	}
+	// Headers which fell out of the window are removed first, so the cache is either empty or
+	// continues right before the headers requested below.
+	if pruned := s.headerCache.prune(start); len(pruned) > 0 {
+		if err := s.beaconDB.DeleteEth1Headers(ctx, pruned); err != nil {
+			return errors.Wrap(err, "could not delete eth1 headers")
+		}
+	}
+	if latest := s.headerCache.latest(); latest != nil && latest.Number >= start {
+		start = latest.Number + 1
+	}
+	if start > target {
+		return nil
+	}
+	end := target
+	if end-start >= eth1HeaderReqLimit {
+		end = start + eth1HeaderReqLimit - 1
+	}
+	gethHeaders, err := s.batchRequestHeaders(start, end)
+	if err != nil {
+		return errors.Wrap(err, "could not request eth1 headers")
+	}
+	headers := make([]*protodb.ETH1Header, 0, len(gethHeaders))
+	for _, h := range gethHeaders {
+		headers = append(headers, s.eth1Header(ctx, h))
+	}
+
+	var reorged []uint64
+	for len(headers) > 0 && !s.headerCache.extends(headers[0]) {
+		removed := s.headerCache.removeLatest()
+		reorged = append(reorged, removed.Number)
+		h, err := s.fetcher().HeaderByNumber(ctx, big.NewInt(int64(removed.Number)))
+		if err != nil {
+			return errors.Wrap(err, "could not request reorged eth1 header")
+		}
+		headers = append([]*protodb.ETH1Header{s.eth1Header(ctx, h)}, headers...)
+	}
+	if len(reorged) > 0 {
+		headerCacheReorgs.Add(float64(len(reorged)))
+		log.WithFields(logrus.Fields{
+			"depth":       len(reorged),
+			"blockNumber": reorged[len(reorged)-1],
+		}).Warn("Eth1 chain reorg replaced cached eth1 headers")
+	}
+	for _, h := range headers {
+		if !s.headerCache.extends(h) {
+			return errors.Errorf("eth1 header %d does not extend the cached eth1 chain", h.Number)
+		}
+		s.headerCache.add(h)
+	}
+	return s.beaconDB.SaveEth1Headers(ctx, headers)
+}
+
+// eth1Header converts the header, adding the deposit count and root at its block.
+func (s *Service) eth1Header(ctx context.Context, h *gethTypes.Header) *protodb.ETH1Header {
+	count, root := s.depositCache.DepositsNumberAndRootAtHeight(ctx, h.Number)
+	return &protodb.ETH1Header{
+		BlockHash:    h.Hash().Bytes(),
+		ParentHash:   h.ParentHash.Bytes(),
+		Number:       h.Number.Uint64(),
+		Timestamp:    h.Time,
+		DepositCount: count,
+		DepositRoot:  root[:],
+	}
+}
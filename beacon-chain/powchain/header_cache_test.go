@@ -0,0 +1,141 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// testChain serves the headers of a fake eth1 chain, which can be reorganized.
+type testChain struct {
+	headers map[uint64]*gethTypes.Header
+}
+
+func newTestChain(length uint64, fork byte) *testChain {
+	c := &testChain{headers: make(map[uint64]*gethTypes.Header)}
+	c.extend(0, length, fork)
+	return c
+}
+
+// extend replaces the headers from the block number, marking them with the fork byte.
+func (c *testChain) extend(from uint64, to uint64, fork byte) {
+	for i := from; i < to; i++ {
+		h := &gethTypes.Header{Number: new(big.Int).SetUint64(i), Time: 1000 + 14*i, Extra: []byte{fork}}
+		if i > 0 {
+			h.ParentHash = c.headers[i-1].Hash()
+		}
+		c.headers[i] = h
+	}
+}
+
+func (c *testChain) BatchCall(b []gethRPC.BatchElem) error {
+	for _, e := range b {
+		num, err := hexutil.DecodeBig(e.Args[0].(string))
+		if err != nil {
+			return err
+		}
+		*e.Result.(*gethTypes.Header) = *c.headers[num.Uint64()]
+	}
+	return nil
+}
+
+func (c *testChain) HeaderByNumber(_ context.Context, number *big.Int) (*gethTypes.Header, error) {
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *testChain) BlockByNumber(_ context.Context, number *big.Int) (*gethTypes.Block, error) {
+	return gethTypes.NewBlockWithHeader(c.headers[number.Uint64()]), nil
+}
+
+func (c *testChain) BlockByHash(_ context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	for _, h := range c.headers {
+		if h.Hash() == hash {
+			return gethTypes.NewBlockWithHeader(h), nil
+		}
+	}
+	return nil, nil
+}
+
+func TestUpdateHeaderCache(t *testing.T) {
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	web3Service, err := NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositcache.NewDepositCache(),
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	distance := params.BeaconConfig().Eth1FollowDistance
+	length := distance + 50
+	chain := newTestChain(length, 0)
+	web3Service.rpcClient = chain
+	web3Service.blockFetcher = chain
+	web3Service.latestEth1Data.BlockHeight = length - 1
+	web3Service.latestEth1Data.LastRequestedBlock = length - 1
+
+	if err := web3Service.updateHeaderCache(ctx); err != nil {
+		t.Fatal(err)
+	}
+	latest := web3Service.headerCache.latest()
+	if latest == nil || latest.Number != length-1-distance {
+		t.Fatalf("Expected the follow distance block %d to be cached, received %v", length-1-distance, latest)
+	}
+	target := chain.headers[latest.Number]
+	if exists, number, err := web3Service.BlockExists(ctx, target.Hash()); err != nil || !exists || number.Uint64() != latest.Number {
+		t.Errorf("Expected cached block %d to exist, received %v %v %v", latest.Number, exists, number, err)
+	}
+	candidates := web3Service.Eth1DataCandidates(target.Time, target.Time)
+	if len(candidates) != 1 || common.BytesToHash(candidates[0].BlockHash) != target.Hash() {
+		t.Errorf("Expected the follow distance block as the only candidate, received %v", candidates)
+	}
+
+	// Reorg the chain below the latest cached header and advance the head by one block.
+	forkPoint := latest.Number - 2
+	chain.extend(forkPoint, length+1, 1)
+	web3Service.latestEth1Data.BlockHeight = length
+	web3Service.latestEth1Data.LastRequestedBlock = length
+	if err := web3Service.updateHeaderCache(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for n := forkPoint; n <= length-distance; n++ {
+		if web3Service.headerCache.byHash(chain.headers[n].Hash()) == nil {
+			t.Errorf("Expected block %d of the new chain to be cached", n)
+		}
+	}
+
+	// The cache is restored from the database.
+	restarted, err := NewService(ctx, &Web3ServiceConfig{
+		ETH1Endpoint: endpoint,
+		BeaconDB:     beaconDB,
+		DepositCache: depositcache.NewDepositCache(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest = restarted.headerCache.latest()
+	if latest == nil || common.BytesToHash(latest.BlockHash) != chain.headers[length-distance].Hash() {
+		t.Errorf("Expected the saved headers to be loaded, received latest %v", latest)
+	}
+}
+
+func TestHeaderCacheWindow_UsesConfiguredBlockTime(t *testing.T) {
+	cfg := params.MinimalSpecConfig()
+	cfg.SecondsPerETH1Block = 3
+	params.OverrideBeaconConfig(cfg)
+	defer params.UseMainnetConfig()
+
+	if window := headerCacheWindow(); window != 16+32 {
+		t.Errorf("Wanted a window of %d blocks, received %d", 16+32, window)
+	}
+}
@@ -52,7 +52,7 @@ func (s *Service) ProcessETH1Block(ctx context.Context, blkNum *big.Int) error {
 		FromBlock: blkNum,
 		ToBlock:   blkNum,
 	}
-	logs, err := s.httpLogger.FilterLogs(ctx, query)
+	logs, err := s.filterer().FilterLogs(ctx, query)
 	if err != nil {
 		return err
 	}
@@ -167,6 +167,9 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 
 	// We always store all historical deposits in the DB.
 	s.depositCache.InsertDeposit(ctx, deposit, depositLog.BlockNumber, int64(index), s.depositTrie.Root())
+	if err := s.auditDeposit(ctx, depositLog, index, depositData); err != nil {
+		log.WithError(err).Error("Could not save deposit audit")
+	}
 	validData := true
 	if !s.chainStartData.Chainstarted {
 		s.chainStartData.ChainstartDeposits = append(s.chainStartData.ChainstartDeposits, deposit)
@@ -258,7 +261,7 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 	}
 	// To store all blocks.
 	headersMap := make(map[uint64]*gethTypes.Header)
-	rawLogCount, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	rawLogCount, err := s.contractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return err
 	}
@@ -301,7 +304,7 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 			query.ToBlock = s.LatestBlockHeight()
 			end = s.LatestBlockHeight().Uint64()
 		}
-		logs, err := s.httpLogger.FilterLogs(ctx, query)
+		logs, err := s.filterer().FilterLogs(ctx, query)
 		if err != nil {
 			return err
 		}
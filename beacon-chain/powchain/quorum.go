@@ -0,0 +1,192 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+var quorumFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "powchain_quorum_failures_total",
+	Help: "The number of eth1 requests for which a majority of the endpoints did not agree",
+}, []string{"method"})
+
+// quorumClient defines the eth1 requests which can be checked for agreement across endpoints.
+type quorumClient interface {
+	RPCBlockFetcher
+	bind.ContractFilterer
+}
+
+// quorumFetcher sends block and deposit log requests to every eth1 endpoint and only returns
+// a result which a majority of the configured endpoints agree on. Requests for the latest
+// block, whose answer legitimately differs between endpoints, and log subscriptions are
+// served by the active endpoint alone.
+type quorumFetcher struct {
+	active  quorumClient
+	clients []quorumClient
+}
+
+// quorumResult is the answer of a single endpoint, keyed by a digest of the fields the
+// endpoints need to agree on.
+type quorumResult struct {
+	key   [32]byte
+	value interface{}
+	err   error
+}
+
+// newQuorumFetcher returns a fetcher which requires a majority of the endpoints to agree, and uses
+// the client of the active endpoint for requests which are not checked.
+func (s *Service) newQuorumFetcher(active quorumClient) (*quorumFetcher, error) {
+	clients := make([]quorumClient, len(s.endpoints))
+	for i := range s.endpoints {
+		client, err := s.endpointClient(i)
+		if err != nil {
+			return nil, err
+		}
+		clients[i] = client
+	}
+	return &quorumFetcher{active: active, clients: clients}, nil
+}
+
+// HeaderByNumber returns the header a majority of the endpoints agree on.
+func (q *quorumFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number == nil {
+		return q.active.HeaderByNumber(ctx, nil)
+	}
+	v, err := q.agree(ctx, "HeaderByNumber", func(ctx context.Context, c quorumClient) ([32]byte, interface{}, error) {
+		header, err := c.HeaderByNumber(ctx, number)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+		return header.Hash(), header, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*gethTypes.Header), nil
+}
+
+// BlockByNumber returns the block a majority of the endpoints agree on.
+func (q *quorumFetcher) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	if number == nil {
+		return q.active.BlockByNumber(ctx, nil)
+	}
+	v, err := q.agree(ctx, "BlockByNumber", func(ctx context.Context, c quorumClient) ([32]byte, interface{}, error) {
+		block, err := c.BlockByNumber(ctx, number)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+		return block.Hash(), block, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*gethTypes.Block), nil
+}
+
+// BlockByHash returns the block a majority of the endpoints agree on.
+func (q *quorumFetcher) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	v, err := q.agree(ctx, "BlockByHash", func(ctx context.Context, c quorumClient) ([32]byte, interface{}, error) {
+		block, err := c.BlockByHash(ctx, hash)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+		return block.Hash(), block, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*gethTypes.Block), nil
+}
+
+// FilterLogs returns the logs a majority of the endpoints agree on.
+func (q *quorumFetcher) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	v, err := q.agree(ctx, "FilterLogs", func(ctx context.Context, c quorumClient) ([32]byte, interface{}, error) {
+		logs, err := c.FilterLogs(ctx, query)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+		return logsKey(logs), logs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]gethTypes.Log), nil
+}
+
+// SubscribeFilterLogs subscribes to the logs of the active endpoint.
+func (q *quorumFetcher) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return q.active.SubscribeFilterLogs(ctx, query, ch)
+}
+
+// agree sends the request to all endpoints concurrently and returns the answer of more than
+// half of the configured endpoints. Endpoints which fail count against the quorum, so that a
+// single endpoint which answered can not speak for an unreachable majority.
+func (q *quorumFetcher) agree(
+	ctx context.Context,
+	method string,
+	request func(context.Context, quorumClient) ([32]byte, interface{}, error),
+) (interface{}, error) {
+	results := make([]quorumResult, len(q.clients))
+	var wg sync.WaitGroup
+	for i, c := range q.clients {
+		wg.Add(1)
+		go func(i int, c quorumClient) {
+			defer wg.Done()
+			key, value, err := request(ctx, c)
+			results[i] = quorumResult{key: key, value: value, err: err}
+		}(i, c)
+	}
+	wg.Wait()
+
+	votes := make(map[[32]byte]int)
+	reachable := 0
+	var lastErr error
+	for _, r := range results {
+		if r.err != nil {
+			lastErr = r.err
+			continue
+		}
+		votes[r.key]++
+		reachable++
+	}
+	for _, r := range results {
+		if r.err == nil && votes[r.key]*2 > len(q.clients) {
+			return r.value, nil
+		}
+	}
+	quorumFailures.WithLabelValues(method).Inc()
+	if reachable == 0 {
+		return nil, fmt.Errorf("none of %d eth1 endpoints answered %s, last error: %v", len(q.clients), method, lastErr)
+	}
+	return nil, fmt.Errorf("no majority of %d eth1 endpoints agree on %s, %d answered", len(q.clients), method, reachable)
+}
+
+// logsKey is a digest of the logs which is independent of the order they are returned in.
+func logsKey(logs []gethTypes.Log) [32]byte {
+	keys := make([][32]byte, len(logs))
+	for i, l := range logs {
+		data := append(l.BlockHash.Bytes(), l.TxHash.Bytes()...)
+		data = append(data, bytesutil.Bytes8(uint64(l.Index))...)
+		keys[i] = hashutil.Hash(append(data, l.Data...))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return string(keys[i][:]) < string(keys[j][:])
+	})
+	buf := make([]byte, 0, 32*len(keys))
+	for _, k := range keys {
+		buf = append(buf, k[:]...)
+	}
+	return hashutil.Hash(buf)
+}
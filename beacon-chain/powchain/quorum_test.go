@@ -0,0 +1,153 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+type fakeQuorumClient struct {
+	header *gethTypes.Header
+	logs   []gethTypes.Log
+	err    error
+}
+
+func (f *fakeQuorumClient) HeaderByNumber(_ context.Context, _ *big.Int) (*gethTypes.Header, error) {
+	return f.header, f.err
+}
+
+func (f *fakeQuorumClient) BlockByNumber(_ context.Context, _ *big.Int) (*gethTypes.Block, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return gethTypes.NewBlockWithHeader(f.header), nil
+}
+
+func (f *fakeQuorumClient) BlockByHash(ctx context.Context, _ common.Hash) (*gethTypes.Block, error) {
+	return f.BlockByNumber(ctx, nil)
+}
+
+func (f *fakeQuorumClient) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return f.logs, f.err
+}
+
+func (f *fakeQuorumClient) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, _ chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return nil, f.err
+}
+
+func TestQuorumFetcher_HeaderByNumber(t *testing.T) {
+	good := &gethTypes.Header{Number: big.NewInt(10)}
+	bad := &gethTypes.Header{Number: big.NewInt(10), Extra: []byte("fork")}
+
+	fetcher := &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{header: good},
+		&fakeQuorumClient{header: bad},
+		&fakeQuorumClient{header: good},
+	}}
+	header, err := fetcher.HeaderByNumber(context.Background(), big.NewInt(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Hash() != good.Hash() {
+		t.Error("Expected the header of the majority")
+	}
+
+	fetcher = &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{header: good},
+		&fakeQuorumClient{header: bad},
+		&fakeQuorumClient{err: errors.New("timeout")},
+	}}
+	if _, err := fetcher.HeaderByNumber(context.Background(), big.NewInt(10)); err == nil {
+		t.Error("Expected an error without a majority")
+	}
+}
+
+func TestQuorumFetcher_LatestUsesActive(t *testing.T) {
+	active := &fakeQuorumClient{header: &gethTypes.Header{Number: big.NewInt(11)}}
+	fetcher := &quorumFetcher{active: active, clients: []quorumClient{
+		&fakeQuorumClient{err: errors.New("timeout")},
+		&fakeQuorumClient{err: errors.New("timeout")},
+	}}
+	header, err := fetcher.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Number.Uint64() != 11 {
+		t.Errorf("Expected the latest header of the active endpoint, received block %d", header.Number)
+	}
+}
+
+func TestQuorumFetcher_FilterLogs(t *testing.T) {
+	logA := gethTypes.Log{BlockHash: common.Hash{1}, TxHash: common.Hash{2}, Index: 0, Data: []byte("deposit a")}
+	logB := gethTypes.Log{BlockHash: common.Hash{1}, TxHash: common.Hash{3}, Index: 1, Data: []byte("deposit b")}
+	forged := gethTypes.Log{BlockHash: common.Hash{1}, TxHash: common.Hash{3}, Index: 1, Data: []byte("forged")}
+
+	fetcher := &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{logs: []gethTypes.Log{logA, logB}},
+		&fakeQuorumClient{logs: []gethTypes.Log{logB, logA}},
+		&fakeQuorumClient{logs: []gethTypes.Log{logA, forged}},
+	}}
+	logs, err := fetcher.FilterLogs(context.Background(), ethereum.FilterQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 2 {
+		t.Errorf("Expected 2 logs, received %d", len(logs))
+	}
+
+	fetcher = &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{logs: []gethTypes.Log{logA, logB}},
+		&fakeQuorumClient{logs: []gethTypes.Log{logA, forged}},
+	}}
+	if _, err := fetcher.FilterLogs(context.Background(), ethereum.FilterQuery{}); err == nil {
+		t.Error("Expected an error when half of the endpoints disagree")
+	}
+}
+
+func TestQuorumFetcher_RequiresMajorityOfConfiguredEndpoints(t *testing.T) {
+	good := &gethTypes.Header{Number: big.NewInt(10)}
+	bad := &gethTypes.Header{Number: big.NewInt(10), Extra: []byte("fork")}
+
+	fetcher := &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{err: errors.New("connection refused")},
+		&fakeQuorumClient{header: good},
+	}}
+	if _, err := fetcher.HeaderByNumber(context.Background(), big.NewInt(10)); err == nil {
+		t.Error("Expected an error when only one of two endpoints answered")
+	}
+
+	fetcher = &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{err: errors.New("connection refused")},
+		&fakeQuorumClient{header: good},
+		&fakeQuorumClient{header: good},
+	}}
+	header, err := fetcher.HeaderByNumber(context.Background(), big.NewInt(10))
+	if err != nil {
+		t.Fatalf("Expected two of three endpoints to form a quorum, received %v", err)
+	}
+	if header.Hash() != good.Hash() {
+		t.Error("Expected the header of the agreeing endpoints")
+	}
+
+	fetcher = &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{err: errors.New("connection refused")},
+		&fakeQuorumClient{err: errors.New("connection refused")},
+	}}
+	if _, err := fetcher.HeaderByNumber(context.Background(), big.NewInt(10)); err == nil {
+		t.Error("Expected an error when no endpoint is reachable")
+	}
+
+	fetcher = &quorumFetcher{clients: []quorumClient{
+		&fakeQuorumClient{err: errors.New("connection refused")},
+		&fakeQuorumClient{header: good},
+		&fakeQuorumClient{header: bad},
+	}}
+	if _, err := fetcher.HeaderByNumber(context.Background(), big.NewInt(10)); err == nil {
+		t.Error("Expected an error when the reachable endpoints disagree")
+	}
+}
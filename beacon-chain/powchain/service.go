@@ -81,6 +81,7 @@ type POWBlockFetcher interface {
 	BlockNumberByTimestamp(ctx context.Context, time uint64) (*big.Int, error)
 	BlockHashByHeight(ctx context.Context, height *big.Int) (common.Hash, error)
 	BlockExists(ctx context.Context, hash common.Hash) (bool, *big.Int, error)
+	Eth1DataCandidates(start uint64, end uint64) []*ethpb.Eth1Data
 }
 
 // Chain defines a standard interface for the powchain service in Prysm.
@@ -132,7 +133,8 @@ type Service struct {
 	httpLogger              bind.ContractFilterer
 	blockFetcher            RPCBlockFetcher
 	rpcClient               RPCClient
-	blockCache              *blockCache // cache to store block hash/block height.
+	blockCache              *blockCache  // cache to store block hash/block height.
+	headerCache             *headerCache // persisted eth1 headers of the eth1 data voting window.
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
@@ -147,6 +149,17 @@ type Service struct {
 	processingLock          sync.RWMutex
 	requestingOldLogs       bool
 	connectedETH1           bool
+	endpoints               []Endpoint // The primary endpoint followed by the fallbacks in order of preference.
+	currentEndpoint         int
+	endpointClients         []*ethclient.Client
+	endpointClientsLock     sync.Mutex
+	connectionLock          sync.RWMutex        // guards the clients of the followed endpoint and eth1ChainID.
+	connectedClients        []*ethclient.Client // closed once the service connects to another endpoint.
+	eth1ChainID             *big.Int
+	eth1Quorum              bool
+	depositAuditLock        sync.Mutex
+	validatorDeposits       map[[48]byte]uint64 // Index of the deposit which created the validator of each public key.
+	unresolvedAudits        []*protodb.DepositAudit
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -157,16 +170,23 @@ type Web3ServiceConfig struct {
 	BeaconDB        db.HeadAccessDatabase
 	DepositCache    *depositcache.DepositCache
 	StateNotifier   statefeed.Notifier
+	// FallbackEndpoints are used in order when the primary endpoint is unhealthy.
+	FallbackEndpoints []Endpoint
+	// Eth1Quorum requires a majority of the configured endpoints to agree on deposit logs and blocks.
+	Eth1Quorum bool
 }
 
 // NewService sets up a new instance with an ethclient when
 // given a web3 endpoint as a string in the config.
 func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error) {
-	if !strings.HasPrefix(config.ETH1Endpoint, "ws") && !strings.HasPrefix(config.ETH1Endpoint, "ipc") {
-		return nil, fmt.Errorf(
-			"powchain service requires either an IPC or WebSocket endpoint, provided %s",
-			config.ETH1Endpoint,
-		)
+	endpoints := append([]Endpoint{{ETH1Endpoint: config.ETH1Endpoint, HTTPEndpoint: config.HTTPEndPoint}}, config.FallbackEndpoints...)
+	for _, e := range endpoints {
+		if !strings.HasPrefix(e.ETH1Endpoint, "ws") && !strings.HasPrefix(e.ETH1Endpoint, "ipc") {
+			return nil, fmt.Errorf(
+				"powchain service requires either an IPC or WebSocket endpoint, provided %s",
+				e.ETH1Endpoint,
+			)
+		}
 	}
 	ctx, cancel := context.WithCancel(ctx)
 	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
@@ -192,6 +212,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			LastRequestedBlock: 0,
 		},
 		blockCache:             newBlockCache(),
+		headerCache:            &headerCache{},
 		depositContractAddress: config.DepositContract,
 		stateNotifier:          config.StateNotifier,
 		depositTrie:            depositTrie,
@@ -203,6 +224,10 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		depositCache:            config.DepositCache,
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         genState,
+		endpoints:               endpoints,
+		endpointClients:         make([]*ethclient.Client, len(endpoints)),
+		eth1Quorum:              config.Eth1Quorum && len(endpoints) > 1,
+		validatorDeposits:       make(map[[48]byte]uint64),
 	}
 
 	eth1Data, err := config.BeaconDB.PowchainData(ctx)
@@ -224,6 +249,9 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			return nil, errors.Wrap(err, "could not initialize caches")
 		}
 	}
+	if err := s.initHeaderCache(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not initialize eth1 header cache")
+	}
 	return s, nil
 }
 
@@ -311,6 +339,8 @@ func (s *Service) LatestBlockHash() common.Hash {
 
 // Client for interacting with the ETH1.0 chain.
 func (s *Service) Client() Client {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
 	return s.client
 }
 
@@ -319,7 +349,7 @@ func (s *Service) Client() Client {
 func (s *Service) AreAllDepositsProcessed() (bool, error) {
 	s.processingLock.RLock()
 	defer s.processingLock.RUnlock()
-	countByte, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	countByte, err := s.contractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
@@ -366,17 +396,73 @@ func (s *Service) dialETH1Nodes() (*ethclient.Client, *ethclient.Client, *gethRP
 func (s *Service) initializeConnection(powClient *ethclient.Client,
 	httpClient *ethclient.Client, rpcClient *gethRPC.Client, contractCaller *contracts.DepositContractCaller) {
 
+	var logsAndBlocks interface {
+		bind.ContractFilterer
+		RPCBlockFetcher
+	} = httpClient
+	if s.eth1Quorum {
+		if fetcher, err := s.newQuorumFetcher(httpClient); err != nil {
+			log.WithError(err).Error("Could not dial eth1 endpoints, not requiring a quorum")
+		} else {
+			logsAndBlocks = fetcher
+		}
+	}
+
+	s.connectionLock.Lock()
+	previous := s.connectedClients
 	s.reader = powClient
 	s.logger = powClient
 	s.client = httpClient
-	s.httpLogger = httpClient
-	s.blockFetcher = httpClient
+	s.httpLogger = logsAndBlocks
+	s.blockFetcher = logsAndBlocks
 	s.depositContractCaller = contractCaller
 	s.rpcClient = rpcClient
+	s.connectedClients = []*ethclient.Client{powClient, httpClient}
+	s.connectionLock.Unlock()
+
+	// Requests still in flight on the previous endpoint fail once its clients are closed.
+	for _, c := range previous {
+		c.Close()
+	}
+}
+
+// headReader returns the client subscribing to the headers of the followed endpoint.
+func (s *Service) headReader() Reader {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.reader
+}
+
+// fetcher returns the client fetching the blocks of the followed endpoint.
+func (s *Service) fetcher() RPCBlockFetcher {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.blockFetcher
+}
+
+// filterer returns the client fetching the deposit logs of the followed endpoint.
+func (s *Service) filterer() bind.ContractFilterer {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.httpLogger
+}
+
+// batchCaller returns the client making batch requests to the followed endpoint.
+func (s *Service) batchCaller() RPCClient {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.rpcClient
+}
+
+// contractCaller returns the deposit contract caller of the followed endpoint.
+func (s *Service) contractCaller() *contracts.DepositContractCaller {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.depositContractCaller
 }
 
 func (s *Service) waitForConnection() {
-	err := s.connectToPowChain()
+	err := s.connectToBestEndpoint()
 	if err == nil {
 		s.connectedETH1 = true
 		log.WithFields(logrus.Fields{
@@ -389,7 +475,7 @@ func (s *Service) waitForConnection() {
 	for {
 		select {
 		case <-ticker.C:
-			err := s.connectToPowChain()
+			err := s.connectToBestEndpoint()
 			if err == nil {
 				s.connectedETH1 = true
 				log.WithFields(logrus.Fields{
@@ -410,7 +496,7 @@ func (s *Service) waitForConnection() {
 // initDataFromContract calls the deposit contract and finds the deposit count
 // and deposit root.
 func (s *Service) initDataFromContract() error {
-	root, err := s.depositContractCaller.GetDepositRoot(&bind.CallOpts{})
+	root, err := s.contractCaller().GetDepositRoot(&bind.CallOpts{})
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve deposit root")
 	}
@@ -487,7 +573,7 @@ func (s *Service) batchRequestHeaders(startBlock uint64, endBlock uint64) ([]*ge
 		headers = append(headers, header)
 		errors = append(errors, err)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.batchCaller().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -533,6 +619,12 @@ func (s *Service) handleDelayTicker() {
 			return
 		}
 	}
+	if err := s.updateHeaderCache(context.Background()); err != nil {
+		log.WithError(err).Error("Could not update eth1 header cache")
+	}
+	if err := s.resolveDepositSenders(context.Background()); err != nil {
+		log.WithError(err).Debug("Could not resolve deposit senders")
+	}
 	// If the last requested block has not changed,
 	// we do not request batched logs as this means there are no new
 	// logs for the powchain service to process.
@@ -559,14 +651,14 @@ func (s *Service) run(done <-chan struct{}) {
 		return
 	}
 
-	headSub, err := s.reader.SubscribeNewHead(s.ctx, s.headerChan)
+	headSub, err := s.headReader().SubscribeNewHead(s.ctx, s.headerChan)
 	if err != nil {
 		log.Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
 		s.runError = err
 		return
 	}
 
-	header, err := s.blockFetcher.HeaderByNumber(context.Background(), nil)
+	header, err := s.fetcher().HeaderByNumber(context.Background(), nil)
 	if err != nil {
 		log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 		s.runError = err
@@ -576,6 +668,10 @@ func (s *Service) run(done <-chan struct{}) {
 	s.latestEth1Data.BlockHeight = header.Number.Uint64()
 	s.latestEth1Data.BlockHash = header.Hash().Bytes()
 
+	if err := s.initDepositAudits(context.Background()); err != nil {
+		log.WithError(err).Error("Could not initialize deposit audits")
+	}
+
 	if err := s.processPastLogs(context.Background()); err != nil {
 		log.Errorf("Unable to process past logs %v", err)
 		s.runError = err
@@ -583,8 +679,13 @@ func (s *Service) run(done <-chan struct{}) {
 	}
 
 	ticker := time.NewTicker(1 * time.Second)
-	defer headSub.Unsubscribe()
+	healthTicker := time.NewTicker(endpointHealthCheckPeriod)
+	// The subscription is replaced when the service reconnects or switches endpoints.
+	defer func() {
+		headSub.Unsubscribe()
+	}()
 	defer ticker.Stop()
+	defer healthTicker.Stop()
 
 	for {
 		select {
@@ -598,12 +699,13 @@ func (s *Service) run(done <-chan struct{}) {
 			log.WithError(s.runError).Warn("Subscription to new head notifier failed")
 			s.connectedETH1 = false
 			s.waitForConnection()
-			headSub, err = s.reader.SubscribeNewHead(s.ctx, s.headerChan)
+			newSub, err := s.headReader().SubscribeNewHead(s.ctx, s.headerChan)
 			if err != nil {
 				log.WithError(err).Error("Unable to re-subscribe to incoming ETH1.0 chain headers")
 				s.runError = err
 				return
 			}
+			headSub = newSub
 			s.runError = nil
 		case header, ok := <-s.headerChan:
 			if ok {
@@ -611,6 +713,18 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 		case <-ticker.C:
 			s.handleDelayTicker()
+		case <-healthTicker.C:
+			if !s.switchToBestEndpoint() {
+				continue
+			}
+			headSub.Unsubscribe()
+			newSub, err := s.headReader().SubscribeNewHead(s.ctx, s.headerChan)
+			if err != nil {
+				log.WithError(err).Error("Unable to subscribe to incoming ETH1.0 chain headers of new endpoint")
+				s.runError = err
+				return
+			}
+			headSub = newSub
 		}
 	}
 }
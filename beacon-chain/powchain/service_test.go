@@ -15,12 +15,18 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/simulator"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	depositcontract "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -371,3 +377,73 @@ func TestHandlePanic_OK(t *testing.T) {
 	web3Service.processSubscribedHeaders(nil)
 	testutil.AssertLogsContain(t, hook, "Panicked when handling data from ETH 1.0 Chain!")
 }
+
+func TestService_ChainStartFromSimulatedEth1Chain(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{CustomGenesisDelay: 0})
+	bConfig := params.MinimalSpecConfig()
+	bConfig.MinGenesisTime = 0
+	params.OverrideBeaconConfig(bConfig)
+
+	sim, err := simulator.New(&simulator.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sim.Start("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := sim.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	deposits, _, _ := testutil.DeterministicDepositsAndKeys(uint64(depositsReqForChainStart))
+	for _, d := range deposits {
+		if err := sim.Deposit(d.Data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sim.Mine(1)
+
+	beaconDB := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		ETH1Endpoint:    sim.WSEndpoint(),
+		HTTPEndPoint:    sim.HTTPEndpoint(),
+		DepositContract: sim.DepositContractAddress(),
+		BeaconDB:        beaconDB,
+		DepositCache:    depositcache.NewDepositCache(),
+		StateNotifier:   &goodNotifier{},
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := web3Service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	web3Service.Start()
+	defer func() {
+		if err := web3Service.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	timeout := time.After(20 * time.Second)
+	for started := false; !started; {
+		select {
+		case event := <-stateChannel:
+			started = event.Type == statefeed.ChainStarted
+		case <-timeout:
+			t.Fatal("Timed out waiting for the chain to start")
+		}
+	}
+	if got := len(web3Service.ChainStartDeposits()); got != depositsReqForChainStart {
+		t.Errorf("Wanted %d chain start deposits, received %d", depositsReqForChainStart, got)
+	}
+	if got := len(web3Service.depositCache.AllDeposits(context.Background(), nil)); got != depositsReqForChainStart {
+		t.Errorf("Wanted %d cached deposits, received %d", depositsReqForChainStart, got)
+	}
+	if web3Service.ChainStartEth1Data().DepositCount != uint64(depositsReqForChainStart) {
+		t.Errorf("Wanted chain start deposit count %d, received %d", depositsReqForChainStart, web3Service.ChainStartEth1Data().DepositCount)
+	}
+}
@@ -0,0 +1,269 @@
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+)
+
+// ethAPI serves the eth namespace: block and log queries, contract calls, transactions and
+// subscriptions to new heads and logs.
+type ethAPI struct {
+	sim *Simulator
+}
+
+// callArgs are the arguments of eth_call and eth_estimateGas.
+type callArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+func (args callArgs) msg() ethereum.CallMsg {
+	msg := ethereum.CallMsg{From: args.From, To: args.To, Data: args.Data}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	return msg
+}
+
+// ChainId of the simulated chain.
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.sim.backend.Blockchain().Config().ChainID)
+}
+
+// Syncing is always false, the simulated chain is never behind.
+func (api *ethAPI) Syncing() bool {
+	return false
+}
+
+// BlockNumber of the head block.
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.sim.HeadNumber())
+}
+
+// GetBlockByNumber returns the block, or nil if it does not exist.
+func (api *ethAPI) GetBlockByNumber(number gethRPC.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	block := api.sim.blockByNumber(number)
+	if block == nil {
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx)
+}
+
+// GetBlockByHash returns the block, or nil if it does not exist.
+func (api *ethAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	block := api.sim.backend.Blockchain().GetBlockByHash(hash)
+	if block == nil {
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx)
+}
+
+// GetLogs returns the logs matching the filter.
+func (api *ethAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]gethTypes.Log, error) {
+	logs, err := api.sim.backend.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if err != nil {
+		return nil, err
+	}
+	if logs == nil {
+		logs = []gethTypes.Log{}
+	}
+	return logs, nil
+}
+
+// Call executes a contract call against the head state.
+func (api *ethAPI) Call(ctx context.Context, args callArgs, number gethRPC.BlockNumber) (hexutil.Bytes, error) {
+	return api.sim.backend.CallContract(ctx, args.msg(), blockNumberArg(number))
+}
+
+// EstimateGas of a transaction.
+func (api *ethAPI) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := api.sim.backend.EstimateGas(ctx, args.msg())
+	return hexutil.Uint64(gas), err
+}
+
+// GetCode of the account.
+func (api *ethAPI) GetCode(ctx context.Context, address common.Address, number gethRPC.BlockNumber) (hexutil.Bytes, error) {
+	return api.sim.backend.CodeAt(ctx, address, blockNumberArg(number))
+}
+
+// GetBalance of the account.
+func (api *ethAPI) GetBalance(ctx context.Context, address common.Address, number gethRPC.BlockNumber) (*hexutil.Big, error) {
+	balance, err := api.sim.backend.BalanceAt(ctx, address, blockNumberArg(number))
+	return (*hexutil.Big)(balance), err
+}
+
+// GetTransactionCount of the account, including pending transactions.
+func (api *ethAPI) GetTransactionCount(ctx context.Context, address common.Address, number gethRPC.BlockNumber) (hexutil.Uint64, error) {
+	if number == gethRPC.PendingBlockNumber {
+		nonce, err := api.sim.backend.PendingNonceAt(ctx, address)
+		return hexutil.Uint64(nonce), err
+	}
+	nonce, err := api.sim.backend.NonceAt(ctx, address, blockNumberArg(number))
+	return hexutil.Uint64(nonce), err
+}
+
+// GasPrice suggested for transactions.
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.sim.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+// SendRawTransaction adds the signed transaction to the pending block.
+func (api *ethAPI) SendRawTransaction(ctx context.Context, encoded hexutil.Bytes) (common.Hash, error) {
+	tx := new(gethTypes.Transaction)
+	if err := rlp.DecodeBytes(encoded, tx); err != nil {
+		return common.Hash{}, err
+	}
+	api.sim.lock.Lock()
+	defer api.sim.lock.Unlock()
+	return tx.Hash(), api.sim.backend.SendTransaction(ctx, tx)
+}
+
+// GetTransactionReceipt returns the receipt of a mined transaction, or nil.
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*gethTypes.Receipt, error) {
+	receipt, err := api.sim.backend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, nil
+	}
+	return receipt, nil
+}
+
+// NewHeads notifies the subscriber of every new head block, including the heads of reorgs.
+func (api *ethAPI) NewHeads(ctx context.Context) (*gethRPC.Subscription, error) {
+	notifier, supported := gethRPC.NotifierFromContext(ctx)
+	if !supported {
+		return &gethRPC.Subscription{}, gethRPC.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+	headers := make(chan *gethTypes.Header)
+	sub, err := api.sim.backend.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case h := <-headers:
+				if err := notifier.Notify(rpcSub.ID, h); err != nil {
+					return
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// Logs notifies the subscriber of new logs matching the filter.
+func (api *ethAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*gethRPC.Subscription, error) {
+	notifier, supported := gethRPC.NotifierFromContext(ctx)
+	if !supported {
+		return &gethRPC.Subscription{}, gethRPC.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+	logs := make(chan gethTypes.Log)
+	sub, err := api.sim.backend.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery(crit), logs)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				if err := notifier.Notify(rpcSub.ID, &l); err != nil {
+					return
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// netAPI serves the net namespace.
+type netAPI struct {
+	sim *Simulator
+}
+
+// Version is the network ID, which equals the chain ID.
+func (api *netAPI) Version() string {
+	return api.sim.backend.Blockchain().Config().ChainID.String()
+}
+
+// blockNumberArg converts latest and pending to nil, which the simulated backend resolves to
+// the head block.
+func blockNumberArg(number gethRPC.BlockNumber) *big.Int {
+	if number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
+
+// marshalBlock encodes the block the way eth1 nodes do: the header fields, the transaction
+// hashes or full transactions, and the uncle hashes.
+func marshalBlock(block *gethTypes.Block, fullTx bool) (map[string]interface{}, error) {
+	enc, err := json.Marshal(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	signer := gethTypes.HomesteadSigner{}
+	txs := make([]interface{}, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		enc, err := json.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+		txFields := make(map[string]interface{})
+		if err := json.Unmarshal(enc, &txFields); err != nil {
+			return nil, err
+		}
+		from, _ := gethTypes.Sender(gethTypes.NewEIP155Signer(tx.ChainId()), tx)
+		if !tx.Protected() {
+			from, _ = gethTypes.Sender(signer, tx)
+		}
+		txFields["blockHash"] = block.Hash()
+		txFields["blockNumber"] = (*hexutil.Big)(block.Number())
+		txFields["transactionIndex"] = hexutil.Uint64(i)
+		txFields["from"] = from
+		txs[i] = txFields
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []common.Hash{}
+	fields["size"] = hexutil.Uint64(block.Size())
+	return fields, nil
+}
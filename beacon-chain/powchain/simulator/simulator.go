@@ -0,0 +1,291 @@
+// Package simulator runs an in-process eth1 chain with the deposit contract deployed, served
+// over the JSON-RPC and websocket APIs used by the powchain service. Tests script deposits,
+// reorgs and slow responses, so deposit processing and chain start can be tested offline and
+// deterministically.
+package simulator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "eth1-simulator")
+
+// blockGasLimit of the simulated chain, enough for hundreds of deposits per block.
+const blockGasLimit = 210000000000
+
+// defaultBlockInterval is the time between blocks of the simulated backend.
+const defaultBlockInterval = 10
+
+// Config of the simulator.
+type Config struct {
+	// BlockTime between automatically produced blocks. When zero, blocks are only produced by
+	// calls to Mine.
+	BlockTime time.Duration
+}
+
+// Simulator is an eth1 chain with the deposit contract deployed.
+type Simulator struct {
+	cfg          *Config
+	lock         sync.Mutex
+	db           ethdb.Database
+	backend      *backends.SimulatedBackend
+	key          *ecdsa.PrivateKey
+	txOpts       *bind.TransactOpts
+	contract     *contracts.DepositContract
+	contractAddr common.Address
+	rpcServer    *gethRPC.Server
+	httpServer   *http.Server
+	listener     net.Listener
+	delay        time.Duration
+	reorgs       byte
+	cancel       context.CancelFunc
+}
+
+// New creates the simulated chain, funds an account and deploys the deposit contract from it.
+// The timestamp of the head block is moved to the current time.
+func New(cfg *Config) (*Simulator, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	balance, _ := new(big.Int).SetString("100000000000000000000000000000000000000", 10)
+	db := rawdb.NewMemoryDatabase()
+	backend := backends.NewSimulatedBackendWithDatabase(db, core.GenesisAlloc{addr: {Balance: balance}}, blockGasLimit)
+
+	s := &Simulator{
+		cfg:     cfg,
+		db:      db,
+		backend: backend,
+		key:     key,
+		txOpts:  bind.NewKeyedTransactor(key),
+	}
+	s.contractAddr, _, s.contract, err = contracts.DeployDepositContract(s.txOpts, backend, addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deploy deposit contract")
+	}
+	s.commit()
+
+	s.rpcServer = gethRPC.NewServer()
+	if err := s.rpcServer.RegisterName("eth", &ethAPI{sim: s}); err != nil {
+		return nil, err
+	}
+	if err := s.rpcServer.RegisterName("net", &netAPI{sim: s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start serves the JSON-RPC API over HTTP and websockets on the address, such as
+// "127.0.0.1:0", and starts producing blocks if a block time is configured.
+func (s *Simulator) Start(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	ws := s.rpcServer.WebsocketHandler([]string{"*"})
+	s.httpServer = &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		delay := s.delay
+		s.lock.Unlock()
+		time.Sleep(delay)
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			ws.ServeHTTP(w, r)
+			return
+		}
+		s.rpcServer.ServeHTTP(w, r)
+	})}
+	go func() {
+		if err := s.httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve eth1 simulator")
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	if s.cfg.BlockTime > 0 {
+		go s.produceBlocks(ctx)
+	}
+	log.WithFields(logrus.Fields{
+		"http":            s.HTTPEndpoint(),
+		"websocket":       s.WSEndpoint(),
+		"depositContract": s.contractAddr.Hex(),
+	}).Info("Serving simulated eth1 chain")
+	return nil
+}
+
+// Stop the server and block production.
+func (s *Simulator) Stop() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.rpcServer.Stop()
+	var err error
+	if s.httpServer != nil {
+		err = s.httpServer.Close()
+	}
+	if closeErr := s.backend.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// HTTPEndpoint of the started simulator.
+func (s *Simulator) HTTPEndpoint() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// WSEndpoint of the started simulator.
+func (s *Simulator) WSEndpoint() string {
+	return "ws://" + s.listener.Addr().String()
+}
+
+// DepositContractAddress is the address of the deployed deposit contract.
+func (s *Simulator) DepositContractAddress() common.Address {
+	return s.contractAddr
+}
+
+// Backend gives access to the simulated chain.
+func (s *Simulator) Backend() *backends.SimulatedBackend {
+	return s.backend
+}
+
+// SetDelay delays every request, including websocket connections, to simulate a slow eth1 node.
+func (s *Simulator) SetDelay(delay time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.delay = delay
+}
+
+// Deposit sends a deposit transaction for the deposit data. It is included in the next block.
+func (s *Simulator) Deposit(data *ethpb.Deposit_Data) error {
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return errors.Wrap(err, "could not hash deposit data")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	opts := *s.txOpts
+	opts.Value = new(big.Int).Mul(new(big.Int).SetUint64(data.Amount), big.NewInt(1e9))
+	opts.GasLimit = 1000000
+	if _, err := s.contract.Deposit(&opts, data.PublicKey, data.WithdrawalCredentials, data.Signature, root); err != nil {
+		return errors.Wrap(err, "could not send deposit transaction")
+	}
+	return nil
+}
+
+// Mine produces blocks, including any pending deposits in the first one.
+func (s *Simulator) Mine(blocks int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i := 0; i < blocks; i++ {
+		s.commit()
+	}
+}
+
+// Reorg replaces the latest blocks with a fork of depth+1 blocks without transactions, which
+// becomes the canonical chain. Deposits in the replaced blocks and pending deposits are dropped.
+func (s *Simulator) Reorg(depth uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	chain := s.backend.Blockchain()
+	head := chain.CurrentBlock()
+	// Block 1 deploys the deposit contract and is never replaced.
+	if depth == 0 || depth >= head.NumberU64() {
+		return fmt.Errorf("cannot reorg %d blocks of a chain at block %d", depth, head.NumberU64())
+	}
+	ancestor := chain.GetBlockByNumber(head.NumberU64() - depth)
+	s.reorgs++
+	parentTime := int64(ancestor.Time())
+	fork, _ := core.GenerateChain(chain.Config(), ancestor, ethash.NewFaker(), s.db, int(depth)+1, func(_ int, b *core.BlockGen) {
+		// Differ from the replaced blocks even if those were empty.
+		b.SetExtra([]byte{'r', 'e', 'o', 'r', 'g', s.reorgs})
+		// Blocks are generated defaultBlockInterval apart, which would put the fork in the future.
+		blockTime := time.Now().Unix()
+		if blockTime <= parentTime {
+			blockTime = parentTime + 1
+		}
+		if offset := blockTime - parentTime - defaultBlockInterval; offset != 0 {
+			b.OffsetTime(offset)
+		}
+		parentTime = blockTime
+	})
+	if _, err := chain.InsertChain(fork); err != nil {
+		return errors.Wrap(err, "could not insert fork")
+	}
+	s.backend.Rollback()
+	log.WithFields(logrus.Fields{
+		"depth":    depth,
+		"newHead":  chain.CurrentBlock().NumberU64(),
+		"ancestor": ancestor.NumberU64(),
+	}).Info("Reorganized simulated eth1 chain")
+	return nil
+}
+
+// HeadNumber is the number of the latest block.
+func (s *Simulator) HeadNumber() uint64 {
+	return s.backend.Blockchain().CurrentBlock().NumberU64()
+}
+
+func (s *Simulator) produceBlocks(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.BlockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.Mine(1)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// commit produces a block with the pending transactions. Its timestamp follows the wall clock
+// while staying after its parent. The caller holds the lock, except during New.
+func (s *Simulator) commit() {
+	head := s.backend.Blockchain().CurrentBlock()
+	offset := time.Now().Unix() - int64(head.Time()) - defaultBlockInterval
+	if offset < 1-defaultBlockInterval {
+		offset = 1 - defaultBlockInterval
+	}
+	if offset != 0 {
+		if err := s.backend.AdjustTime(time.Duration(offset) * time.Second); err != nil {
+			log.WithError(err).Error("Could not adjust block time")
+		}
+	}
+	s.backend.Commit()
+}
+
+// blockByNumber resolves latest and pending to the head block, and returns nil for unknown blocks.
+func (s *Simulator) blockByNumber(number gethRPC.BlockNumber) *gethTypes.Block {
+	chain := s.backend.Blockchain()
+	if number == gethRPC.LatestBlockNumber || number == gethRPC.PendingBlockNumber {
+		return chain.CurrentBlock()
+	}
+	return chain.GetBlockByNumber(uint64(number))
+}
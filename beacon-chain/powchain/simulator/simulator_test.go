@@ -0,0 +1,223 @@
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+)
+
+func startSimulator(t *testing.T) *Simulator {
+	sim, err := New(&Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sim.Start("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	return sim
+}
+
+func depositData(i byte) *ethpb.Deposit_Data {
+	return &ethpb.Deposit_Data{
+		PublicKey:             bytes.Repeat([]byte{i}, 48),
+		WithdrawalCredentials: bytes.Repeat([]byte{i}, 32),
+		Amount:                32e9,
+		Signature:             bytes.Repeat([]byte{i}, 96),
+	}
+}
+
+func TestSimulator_Deposits(t *testing.T) {
+	sim := startSimulator(t)
+	defer func() {
+		if err := sim.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	client, err := ethclient.Dial(sim.HTTPEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	for i := byte(0); i < 3; i++ {
+		if err := sim.Deposit(depositData(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sim.Mine(1)
+
+	ctx := context.Background()
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(0),
+		Addresses: []common.Address{sim.DepositContractAddress()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 3 {
+		t.Fatalf("Expected 3 deposit logs, received %d", len(logs))
+	}
+	pubkey, _, _, _, _, err := contracts.UnpackDepositLogData(logs[1].Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubkey, depositData(1).PublicKey) {
+		t.Errorf("Wrong public key in deposit log, received %#x", pubkey)
+	}
+
+	caller, err := contracts.NewDepositContractCaller(sim.DepositContractAddress(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := caller.GetDepositCount(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if binary.LittleEndian.Uint64(count) != 3 {
+		t.Errorf("Expected deposit count 3, received %d", binary.LittleEndian.Uint64(count))
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Number.Uint64() != sim.HeadNumber() {
+		t.Errorf("Expected head %d, received %d", sim.HeadNumber(), header.Number.Uint64())
+	}
+	if time.Since(time.Unix(int64(header.Time), 0)) > time.Minute {
+		t.Errorf("Head block time %d does not follow the wall clock", header.Time)
+	}
+	block, err := client.BlockByHash(ctx, header.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(block.Transactions()) != 3 {
+		t.Errorf("Expected 3 transactions in head block, received %d", len(block.Transactions()))
+	}
+}
+
+func TestSimulator_NewHeadsOverWebsocket(t *testing.T) {
+	sim := startSimulator(t)
+	defer func() {
+		if err := sim.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	client, err := ethclient.Dial(sim.WSEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	headers := make(chan *gethTypes.Header, 1)
+	sub, err := client.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+	sim.Mine(1)
+	select {
+	case header := <-headers:
+		if header.Number.Uint64() != sim.HeadNumber() {
+			t.Errorf("Expected head %d, received %d", sim.HeadNumber(), header.Number.Uint64())
+		}
+	case err := <-sub.Err():
+		t.Fatal(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive new head")
+	}
+}
+
+func TestSimulator_Reorg(t *testing.T) {
+	sim := startSimulator(t)
+	defer func() {
+		if err := sim.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	client, err := ethclient.Dial(sim.HTTPEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	if err := sim.Deposit(depositData(0)); err != nil {
+		t.Fatal(err)
+	}
+	sim.Mine(3)
+	head := sim.HeadNumber()
+	replaced, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(head-1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kept, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(head-2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sim.Reorg(head); err == nil {
+		t.Error("Expected error when reorging the block of the deposit contract")
+	}
+	if err := sim.Reorg(2); err != nil {
+		t.Fatal(err)
+	}
+	if sim.HeadNumber() != head+1 {
+		t.Errorf("Expected head %d after reorg, received %d", head+1, sim.HeadNumber())
+	}
+	header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(head-1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Hash() == replaced.Hash() {
+		t.Error("Expected block to be replaced by the reorg")
+	}
+	if header.ParentHash != kept.Hash() {
+		t.Error("Expected fork to build on the common ancestor")
+	}
+	sim.Mine(1)
+	if sim.HeadNumber() != head+2 {
+		t.Errorf("Expected head %d after mining on the fork, received %d", head+2, sim.HeadNumber())
+	}
+}
+
+func TestSimulator_Delay(t *testing.T) {
+	sim := startSimulator(t)
+	defer func() {
+		if err := sim.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	client, err := ethclient.Dial(sim.HTTPEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	delay := 200 * time.Millisecond
+	sim.SetDelay(delay)
+	start := time.Now()
+	if _, err := client.HeaderByNumber(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) < delay {
+		t.Errorf("Expected request to take at least %v, took %v", delay, time.Since(start))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), delay/2)
+	defer cancel()
+	if _, err := client.HeaderByNumber(ctx, nil); err == nil {
+		t.Error("Expected request to time out")
+	}
+}
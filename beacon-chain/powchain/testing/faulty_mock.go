@@ -52,6 +52,11 @@ func (f *FaultyMockPOWChain) BlockNumberByTimestamp(_ context.Context, _ uint64)
 	return big.NewInt(0), nil
 }
 
+// Eth1DataCandidates --
+func (f *FaultyMockPOWChain) Eth1DataCandidates(_ uint64, _ uint64) []*ethpb.Eth1Data {
+	return nil
+}
+
 // DepositRoot --
 func (f *FaultyMockPOWChain) DepositRoot() [32]byte {
 	return [32]byte{}
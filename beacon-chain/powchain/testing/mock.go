@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
@@ -27,6 +28,7 @@ type POWChain struct {
 	BlockNumberByHeight map[uint64]*big.Int
 	Eth1Data            *ethpb.Eth1Data
 	GenesisEth1Block    *big.Int
+	Eth1DataByTimestamp map[uint64]*ethpb.Eth1Data
 }
 
 // Eth2GenesisPowchainInfo --
@@ -79,6 +81,24 @@ func (m *POWChain) BlockNumberByTimestamp(_ context.Context, time uint64) (*big.
 	return m.BlockNumberByHeight[time], nil
 }
 
+// Eth1DataCandidates --
+func (m *POWChain) Eth1DataCandidates(start uint64, end uint64) []*ethpb.Eth1Data {
+	var times []uint64
+	for t := range m.Eth1DataByTimestamp {
+		if t >= start && t <= end {
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i] < times[j]
+	})
+	candidates := make([]*ethpb.Eth1Data, len(times))
+	for i, t := range times {
+		candidates[i] = m.Eth1DataByTimestamp[t]
+	}
+	return candidates
+}
+
 // DepositRoot --
 func (m *POWChain) DepositRoot() [32]byte {
 	root := []byte("depositroot")
@@ -0,0 +1,234 @@
+// Package auth authenticates callers of the beacon node gRPC server and authorizes
+// them per method, based on a policy loaded from a YAML file such as:
+//
+//	public:
+//	  - /ethereum.eth.v1alpha1.Node/*
+//	identities:
+//	  - name: explorer
+//	    tokens: ["3b1f6c..."]
+//	    allow: ["/ethereum.eth.v1alpha1.BeaconChain/*"]
+//	  - name: validator
+//	    common_names: ["validator-1"]
+//	    allow: ["*"]
+//
+// Callers authenticate with an `authorization: Bearer <token>` header, or with a TLS
+// client certificate whose subject common name is listed for an identity. Rules match a
+// full gRPC method name, every method of a service with `/package.Service/*`, or any
+// method with `*`. Methods listed as public may be called without credentials. HTTP
+// endpoints which are not proxied to gRPC are matched by their path.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+const bearerPrefix = "Bearer "
+
+type identityKey struct{}
+
+// Identity is a named set of credentials and the methods they may call.
+type Identity struct {
+	Name        string   `yaml:"name"`
+	Tokens      []string `yaml:"tokens"`
+	CommonNames []string `yaml:"common_names"`
+	Allow       []string `yaml:"allow"`
+	// RateLimit overrides the RPC rate limit budget of the identity, in cost units per
+	// second, even if rate limiting is disabled for other clients. Zero disables rate
+	// limiting for the identity.
+	RateLimit *float64 `yaml:"rate_limit"`
+	// RateLimitBurst overrides the RPC rate limit burst of the identity, in cost units.
+	RateLimitBurst *float64 `yaml:"rate_limit_burst"`
+}
+
+// NewContext returns a copy of the context which carries the identity of the caller.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns the identity which authenticated the request, or nil for
+// requests without credentials or servers without an auth policy.
+func IdentityFromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+// Policy maps the credentials of callers to the methods they are allowed to call.
+type Policy struct {
+	Public     []string    `yaml:"public"`
+	Identities []*Identity `yaml:"identities"`
+}
+
+// LoadPolicy reads and validates the policy at the given path.
+func LoadPolicy(path string) (*Policy, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read auth policy")
+	}
+	p := &Policy{}
+	if err := yaml.UnmarshalStrict(enc, p); err != nil {
+		return nil, errors.Wrap(err, "could not parse auth policy")
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Policy) validate() error {
+	names := make(map[string]bool)
+	tokens := make(map[string]bool)
+	for _, id := range p.Identities {
+		if id.Name == "" {
+			return errors.New("identity without a name in auth policy")
+		}
+		if names[id.Name] {
+			return errors.Errorf("identity %q is defined twice in auth policy", id.Name)
+		}
+		names[id.Name] = true
+		if len(id.Tokens) == 0 && len(id.CommonNames) == 0 {
+			return errors.Errorf("identity %q has no tokens or common names", id.Name)
+		}
+		if (id.RateLimit != nil && *id.RateLimit < 0) || (id.RateLimitBurst != nil && *id.RateLimitBurst < 0) {
+			return errors.Errorf("identity %q has a negative rate limit", id.Name)
+		}
+		for _, token := range id.Tokens {
+			if token == "" {
+				return errors.Errorf("identity %q has an empty token", id.Name)
+			}
+			if tokens[token] {
+				return errors.Errorf("token of identity %q is used by another identity", id.Name)
+			}
+			tokens[token] = true
+		}
+	}
+	return nil
+}
+
+// RateLimited returns whether an identity of the policy has its own, non-zero, RPC rate limit.
+func (p *Policy) RateLimited() bool {
+	for _, id := range p.Identities {
+		if id.RateLimit != nil && *id.RateLimit > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate returns the identity of a caller presenting the given bearer token or TLS
+// client certificate common names. A nil identity is returned for callers without credentials.
+// An unknown token is an error, rather than an anonymous caller.
+func (p *Policy) Authenticate(token string, commonNames []string) (*Identity, error) {
+	if token != "" {
+		for _, id := range p.Identities {
+			for _, t := range id.Tokens {
+				if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+					return id, nil
+				}
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	for _, cn := range commonNames {
+		for _, id := range p.Identities {
+			for _, name := range id.CommonNames {
+				if name == cn {
+					return id, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Authorize returns an error if the identity may not call the method. A nil identity is an
+// unauthenticated caller, which may only call public methods.
+func (p *Policy) Authorize(id *Identity, method string) error {
+	if matchesAny(p.Public, method) {
+		return nil
+	}
+	if id == nil {
+		return status.Errorf(codes.Unauthenticated, "credentials are required to call %s", method)
+	}
+	if !matchesAny(id.Allow, method) {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id.Name, method)
+	}
+	return nil
+}
+
+// check authenticates the caller of a gRPC request and authorizes the method. The returned
+// context carries the identity of the caller.
+func (p *Policy) check(ctx context.Context, method string) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			if !strings.HasPrefix(values[0], bearerPrefix) {
+				return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+			}
+			token = strings.TrimPrefix(values[0], bearerPrefix)
+		}
+	}
+	id, err := p.Authenticate(token, peerCommonNames(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Authorize(id, method); err != nil {
+		log.WithField("method", method).WithError(err).Debug("Rejected RPC call")
+		return nil, err
+	}
+	if id == nil {
+		return ctx, nil
+	}
+	return NewContext(ctx, id), nil
+}
+
+// peerCommonNames returns the subject common names of the verified client certificates of
+// the caller.
+func peerCommonNames(ctx context.Context) []string {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, chain := range tlsInfo.State.VerifiedChains {
+		if len(chain) > 0 {
+			names = append(names, chain[0].Subject.CommonName)
+		}
+	}
+	return names
+}
+
+func matchesAny(rules []string, method string) bool {
+	for _, rule := range rules {
+		if matches(rule, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true if the rule is the method itself, `*`, or a prefix of the method
+// ending in `/*`.
+func matches(rule string, method string) bool {
+	if rule == "*" || rule == method {
+		return true
+	}
+	if strings.HasSuffix(rule, "/*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(rule, "*"))
+	}
+	return false
+}
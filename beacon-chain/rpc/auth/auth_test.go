@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testPolicy = `
+public:
+  - /ethereum.eth.v1alpha1.Node/*
+  - /eth/v1alpha1/events
+identities:
+  - name: explorer
+    tokens: ["explorer-token"]
+    allow:
+      - /ethereum.eth.v1alpha1.BeaconChain/*
+  - name: validator
+    tokens: ["validator-token"]
+    common_names: ["validator-1"]
+    allow:
+      - "*"
+`
+
+func loadTestPolicy(t *testing.T, policy string) (*Policy, error) {
+	dir, err := ioutil.TempDir("", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "policy.yaml")
+	if err := ioutil.WriteFile(path, []byte(policy), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return LoadPolicy(path)
+}
+
+func TestLoadPolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{name: "unknown field", policy: "identities:\n  - name: a\n    token: [x]\n"},
+		{name: "no name", policy: "identities:\n  - tokens: [x]\n"},
+		{name: "duplicate name", policy: "identities:\n  - name: a\n    tokens: [x]\n  - name: a\n    tokens: [y]\n"},
+		{name: "no credentials", policy: "identities:\n  - name: a\n"},
+		{name: "negative rate limit", policy: "identities:\n  - name: a\n    tokens: [x]\n    rate_limit: -1\n"},
+		{name: "shared token", policy: "identities:\n  - name: a\n    tokens: [x]\n  - name: b\n    tokens: [x]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadTestPolicy(t, tt.policy); err == nil {
+				t.Error("Expected policy to be rejected")
+			}
+		})
+	}
+}
+
+func TestPolicy_Check(t *testing.T) {
+	p, err := loadTestPolicy(t, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		header string
+		method string
+		code   codes.Code
+	}{
+		{name: "public method", method: "/ethereum.eth.v1alpha1.Node/GetSyncStatus", code: codes.OK},
+		{name: "anonymous", method: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", code: codes.Unauthenticated},
+		{name: "read only", header: "Bearer explorer-token", method: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", code: codes.OK},
+		{name: "read only proposing", header: "Bearer explorer-token", method: "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock", code: codes.PermissionDenied},
+		{name: "validator proposing", header: "Bearer validator-token", method: "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock", code: codes.OK},
+		{name: "unknown token", header: "Bearer other-token", method: "/ethereum.eth.v1alpha1.Node/GetSyncStatus", code: codes.Unauthenticated},
+		{name: "not a bearer token", header: "Basic dXNlcg==", method: "/ethereum.eth.v1alpha1.Node/GetSyncStatus", code: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+			_, err := p.check(ctx, tt.method)
+			if code := status.Code(err); code != tt.code {
+				t.Errorf("Wanted code %v, received %v", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestPolicy_AuthenticateCommonName(t *testing.T) {
+	p, err := loadTestPolicy(t, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := p.Authenticate("", []string{"unknown", "validator-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id == nil || id.Name != "validator" {
+		t.Errorf("Wanted validator identity, received %v", id)
+	}
+	id, err = p.Authenticate("", []string{"unknown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != nil {
+		t.Errorf("Wanted no identity, received %v", id)
+	}
+}
+
+func TestPolicy_RateLimited(t *testing.T) {
+	p, err := loadTestPolicy(t, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.RateLimited() {
+		t.Error("Expected policy without rate limits not to be rate limited")
+	}
+	p, err = loadTestPolicy(t, "identities:\n  - name: a\n    tokens: [x]\n    rate_limit: 0\n  - name: b\n    tokens: [y]\n    rate_limit: 10\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.RateLimited() {
+		t.Error("Expected policy with a rate limited identity to be rate limited")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	p, err := loadTestPolicy(t, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := UnaryServerInterceptor(p)
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		if id := IdentityFromContext(ctx); id == nil || id.Name != "validator" {
+			t.Errorf("Wanted validator identity in context, received %v", id)
+		}
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock"}
+	if _, err := interceptor(context.Background(), nil, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Wanted unauthenticated error, received %v", err)
+	}
+	if called {
+		t.Error("Handler called for rejected request")
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer validator-token"))
+	if _, err := interceptor(ctx, nil, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if !called {
+		t.Error("Handler not called for allowed request")
+	}
+}
+
+func TestHTTPHandler(t *testing.T) {
+	p, err := loadTestPolicy(t, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := HTTPHandler(p, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	tests := []struct {
+		path   string
+		header string
+		code   int
+	}{
+		{path: "/eth/v1alpha1/events", code: http.StatusOK},
+		{path: "/swagger/services.swagger.json", code: http.StatusUnauthorized},
+		{path: "/swagger/services.swagger.json", header: "Bearer explorer-token", code: http.StatusForbidden},
+		{path: "/swagger/services.swagger.json", header: "Bearer validator-token", code: http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.header != "" {
+			req.Header.Set("Authorization", tt.header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.code {
+			t.Errorf("%s with %q: wanted status %d, received %d", tt.path, tt.header, tt.code, rec.Code)
+		}
+	}
+}
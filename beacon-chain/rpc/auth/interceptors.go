@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor rejects unary calls which the policy does not allow.
+func UnaryServerInterceptor(p *Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := p.check(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams which the policy does not allow.
+func StreamServerInterceptor(p *Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := p.check(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// HTTPHandler rejects requests to an HTTP endpoint which is not proxied to the gRPC server,
+// and so is not covered by the interceptors. The request path is authorized as the method.
+func HTTPHandler(p *Policy, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		if header := r.Header.Get("Authorization"); header != "" {
+			if !strings.HasPrefix(header, bearerPrefix) {
+				http.Error(w, "authorization must be a bearer token", http.StatusUnauthorized)
+				return
+			}
+			token = strings.TrimPrefix(header, bearerPrefix)
+		}
+		var commonNames []string
+		if r.TLS != nil {
+			for _, chain := range r.TLS.VerifiedChains {
+				if len(chain) > 0 {
+					commonNames = append(commonNames, chain[0].Subject.CommonName)
+				}
+			}
+		}
+		id, err := p.Authenticate(token, commonNames)
+		if err == nil {
+			err = p.Authorize(id, r.URL.Path)
+		}
+		if err == nil && id != nil {
+			r = r.WithContext(NewContext(r.Context(), id))
+		}
+		if err != nil {
+			code := http.StatusForbidden
+			if status.Code(err) == codes.Unauthenticated {
+				code = http.StatusUnauthorized
+			}
+			http.Error(w, status.Convert(err).Message(), code)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,58 @@
+// Package chain defines a gRPC server which streams changes of the canonical chain
+// which are not covered by the eth beacon chain API.
+package chain
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC chain service.
+type Server struct {
+	Ctx           context.Context
+	StateNotifier statefeed.Notifier
+}
+
+// StreamChainReorgs to clients every time the new head of the chain does not descend from the
+// previous head, which orphans the blocks between the common ancestor and the previous head.
+func (cs *Server) StreamChainReorgs(_ *ptypes.Empty, stream pb.ChainService_StreamChainReorgsServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := cs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.Reorg {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.ReorgData)
+			if !ok {
+				return status.Error(codes.Internal, "Received incorrect data type over reorg feed")
+			}
+			res := &pb.ChainReorg{
+				OldHeadRoot:        data.OldHeadRoot[:],
+				OldHeadSlot:        data.OldHeadSlot,
+				NewHeadRoot:        data.NewHeadRoot[:],
+				NewHeadSlot:        data.NewHeadSlot,
+				CommonAncestorRoot: data.CommonAncestorRoot[:],
+				CommonAncestorSlot: data.CommonAncestorSlot,
+				Depth:              data.Depth,
+			}
+			if err := stream.Send(res); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-cs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+type reorgStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.ChainReorg
+}
+
+func (s *reorgStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *reorgStream) Send(res *pb.ChainReorg) error {
+	s.sent <- res
+	return nil
+}
+
+func TestServer_StreamChainReorgs_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		StateNotifier: chainService.StateNotifier(),
+	}
+	stream := &reorgStream{ctx: context.Background(), sent: make(chan *pb.ChainReorg)}
+
+	errs := make(chan error)
+	go func() {
+		errs <- server.StreamChainReorgs(&ptypes.Empty{}, stream)
+	}()
+	cancel()
+	if err := <-errs; err == nil || !strings.Contains(err.Error(), "Context canceled") {
+		t.Errorf("Wanted context canceled error, received %v", err)
+	}
+}
+
+func TestServer_StreamChainReorgs_OnReorg(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		StateNotifier: chainService.StateNotifier(),
+	}
+	stateFeed := server.StateNotifier.StateFeed()
+	stream := &reorgStream{ctx: ctx, sent: make(chan *pb.ChainReorg, 1)}
+	go func() {
+		server.StreamChainReorgs(&ptypes.Empty{}, stream)
+	}()
+
+	data := &statefeed.ReorgData{
+		OldHeadRoot:        [32]byte{'A'},
+		OldHeadSlot:        10,
+		NewHeadRoot:        [32]byte{'B'},
+		NewHeadSlot:        11,
+		CommonAncestorRoot: [32]byte{'C'},
+		CommonAncestorSlot: 8,
+		Depth:              2,
+	}
+	// Wait for the stream to subscribe to the state feed.
+	for i := 0; i < 100; i++ {
+		if stateFeed.Send(&feed.Event{Type: statefeed.BlockProcessed}) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	stateFeed.Send(&feed.Event{Type: statefeed.Reorg, Data: data})
+
+	select {
+	case res := <-stream.sent:
+		if !bytes.Equal(res.OldHeadRoot, data.OldHeadRoot[:]) || !bytes.Equal(res.NewHeadRoot, data.NewHeadRoot[:]) {
+			t.Errorf("Unexpected head roots %#x and %#x", res.OldHeadRoot, res.NewHeadRoot)
+		}
+		if !bytes.Equal(res.CommonAncestorRoot, data.CommonAncestorRoot[:]) || res.CommonAncestorSlot != 8 {
+			t.Errorf("Unexpected common ancestor %#x at slot %d", res.CommonAncestorRoot, res.CommonAncestorSlot)
+		}
+		if res.OldHeadSlot != 10 || res.NewHeadSlot != 11 || res.Depth != 2 {
+			t.Errorf("Unexpected reorg %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive reorg over stream")
+	}
+}
@@ -0,0 +1,97 @@
+// Package deposit defines a gRPC server which reports what became of the deposits
+// made to the deposit contract.
+package deposit
+
+import (
+	"bytes"
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC deposit service.
+type Server struct {
+	BeaconDB    db.ReadOnlyDatabase
+	HeadFetcher blockchain.HeadFetcher
+}
+
+// ListDeposits made for a validator public key or sent from an eth1 address, in the order of the
+// deposit contract. Each deposit is reported with its classification and whether the beacon
+// chain has processed it yet.
+func (ds *Server) ListDeposits(ctx context.Context, req *pb.ListDepositsRequest) (*pb.ListDepositsResponse, error) {
+	var audits []*dbpb.DepositAudit
+	var err error
+	switch {
+	case len(req.PublicKey) != 0:
+		audits, err = ds.BeaconDB.DepositAuditsByPublicKey(ctx, req.PublicKey)
+	case len(req.Eth1Address) != 0:
+		audits, err = ds.BeaconDB.DepositAuditsBySender(ctx, req.Eth1Address)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a public key or an eth1 address")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve deposits: %v", err)
+	}
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	deposits := make([]*pb.DepositInfo, 0, len(audits))
+	for _, audit := range audits {
+		// A deposit for a public key is not necessarily sent from the requested address.
+		if len(req.Eth1Address) != 0 && !bytes.Equal(audit.Eth1Sender, req.Eth1Address) {
+			continue
+		}
+		info := &pb.DepositInfo{
+			Index:                 audit.Index,
+			PublicKey:             audit.PublicKey,
+			WithdrawalCredentials: audit.WithdrawalCredentials,
+			Amount:                audit.Amount,
+			Classification:        pb.DepositInfo_Classification(audit.Classification),
+			Eth1BlockNumber:       audit.Eth1BlockNumber,
+			Eth1TxHash:            audit.Eth1TxHash,
+			Eth1Address:           audit.Eth1Sender,
+		}
+		if headState != nil {
+			if audit.Index < headState.Eth1DepositIndex() {
+				info.Inclusion = pb.DepositInfo_INCLUDED
+			}
+			if idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(audit.PublicKey)); ok {
+				info.ValidatorIndex = idx
+				info.ValidatorStatus = validatorStatus(headState, idx)
+			}
+		}
+		deposits = append(deposits, info)
+	}
+	return &pb.ListDepositsResponse{Deposits: deposits}, nil
+}
+
+// validatorStatus converts the assignment status of the validator to its deposit report status.
+func validatorStatus(headState *stateTrie.BeaconState, idx uint64) pb.ValidatorStatus {
+	switch validator.AssignmentStatus(idx, headState) {
+	case ethpb.ValidatorStatus_DEPOSITED:
+		return pb.ValidatorStatus_DEPOSIT_RECEIVED
+	case ethpb.ValidatorStatus_PENDING:
+		return pb.ValidatorStatus_PENDING_ACTIVE
+	case ethpb.ValidatorStatus_ACTIVE:
+		return pb.ValidatorStatus_ACTIVE
+	case ethpb.ValidatorStatus_EXITING:
+		return pb.ValidatorStatus_INITIATED_EXIT
+	case ethpb.ValidatorStatus_SLASHING:
+		return pb.ValidatorStatus_EXITED_SLASHED
+	case ethpb.ValidatorStatus_EXITED:
+		return pb.ValidatorStatus_EXITED
+	default:
+		return pb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
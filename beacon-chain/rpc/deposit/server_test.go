@@ -0,0 +1,98 @@
+package deposit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_ListDeposits_RequiresFilter(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	ds := &Server{BeaconDB: db, HeadFetcher: &mock.ChainService{}}
+	wanted := "Must specify a public key or an eth1 address"
+	if _, err := ds.ListDeposits(context.Background(), &pb.ListDepositsRequest{}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
+}
+
+func TestServer_ListDeposits(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubkey := make([]byte, 48)
+	pubkey[0] = 'a'
+	pending := make([]byte, 48)
+	pending[0] = 'b'
+	sender := []byte("sender-address-00000")
+	other := []byte("other-address-000000")
+	audits := []*dbpb.DepositAudit{
+		{Index: 0, PublicKey: pubkey, Eth1Sender: sender, Classification: dbpb.DepositAudit_NEW_VALIDATOR},
+		{Index: 1, PublicKey: pubkey, Eth1Sender: other, Classification: dbpb.DepositAudit_TOP_UP},
+		{Index: 2, PublicKey: pending, Eth1Sender: sender, Classification: dbpb.DepositAudit_INVALID_SIGNATURE},
+	}
+	if err := db.SaveDepositAudits(ctx, audits); err != nil {
+		t.Fatal(err)
+	}
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	st, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		Eth1DepositIndex: 2,
+		Validators: []*ethpb.Validator{
+			{
+				PublicKey:         pubkey,
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: farFuture,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds := &Server{BeaconDB: db, HeadFetcher: &mock.ChainService{State: st}}
+
+	res, err := ds.ListDeposits(ctx, &pb.ListDepositsRequest{PublicKey: pubkey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Deposits) != 2 {
+		t.Fatalf("Expected 2 deposits, received %d", len(res.Deposits))
+	}
+	if res.Deposits[1].Classification != pb.DepositInfo_TOP_UP {
+		t.Errorf("Expected top-up, received %v", res.Deposits[1].Classification)
+	}
+	for _, d := range res.Deposits {
+		if d.Inclusion != pb.DepositInfo_INCLUDED || d.ValidatorStatus != pb.ValidatorStatus_ACTIVE {
+			t.Errorf("Expected deposit %d to be included for an active validator, received %v %v", d.Index, d.Inclusion, d.ValidatorStatus)
+		}
+	}
+
+	res, err = ds.ListDeposits(ctx, &pb.ListDepositsRequest{Eth1Address: sender})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Deposits) != 2 {
+		t.Fatalf("Expected 2 deposits, received %d", len(res.Deposits))
+	}
+	if d := res.Deposits[1]; d.Inclusion != pb.DepositInfo_PENDING || d.ValidatorStatus != pb.ValidatorStatus_UNKNOWN_STATUS {
+		t.Errorf("Expected deposit 2 to be pending, received %v %v", d.Inclusion, d.ValidatorStatus)
+	}
+
+	res, err = ds.ListDeposits(ctx, &pb.ListDepositsRequest{PublicKey: pubkey, Eth1Address: sender})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Deposits) != 1 || res.Deposits[0].Index != 0 {
+		t.Errorf("Expected only deposit 0, received %v", res.Deposits)
+	}
+}
@@ -0,0 +1,126 @@
+// Package lifecycle defines a gRPC server which reports the lifecycle of validators, from their
+// deposits on eth1 to becoming withdrawable.
+package lifecycle
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "rpc/lifecycle")
+
+// Server defines a server implementation of the gRPC lifecycle service.
+type Server struct {
+	BeaconDB     db.ReadOnlyDatabase
+	HeadFetcher  blockchain.HeadFetcher
+	BlockFetcher powchain.POWBlockFetcher
+}
+
+// ValidatorTimeline returns the milestones of a validator public key in the order they happened:
+// its deposits from the deposit audits, followed by the lifecycle archived by the beacon node.
+// Without an archived lifecycle, the milestones scheduled in the head state are returned.
+func (ls *Server) ValidatorTimeline(ctx context.Context, req *pb.ValidatorTimelineRequest) (*pb.ValidatorTimelineResponse, error) {
+	if len(req.PublicKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must specify a validator public key")
+	}
+	audits, err := ls.BeaconDB.DepositAuditsByPublicKey(ctx, req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve deposits: %v", err)
+	}
+	res := &pb.ValidatorTimelineResponse{}
+	for _, audit := range audits {
+		m := &pb.ValidatorMilestone{
+			Type:            pb.ValidatorMilestone_DEPOSITED,
+			DepositIndex:    audit.Index,
+			Eth1BlockNumber: audit.Eth1BlockNumber,
+			Eth1TxHash:      audit.Eth1TxHash,
+		}
+		blockTime, err := ls.BlockFetcher.BlockTimeByHeight(ctx, new(big.Int).SetUint64(audit.Eth1BlockNumber))
+		if err != nil {
+			log.WithError(err).Debug("Could not get time of deposit block")
+		} else {
+			m.Timestamp = blockTime
+		}
+		res.Milestones = append(res.Milestones, m)
+	}
+
+	headState, err := ls.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return res, nil
+	}
+	idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(req.PublicKey))
+	if !ok {
+		return res, nil
+	}
+	res.ValidatorIndex = idx
+	res.HasValidator = true
+	lifecycle, err := ls.BeaconDB.ArchivedValidatorLifecycle(ctx, idx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validator lifecycle: %v", err)
+	}
+	if lifecycle == nil {
+		if lifecycle, err = scheduledLifecycle(headState, idx); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+	}
+	for _, m := range lifecycle.Milestones {
+		res.Milestones = append(res.Milestones, &pb.ValidatorMilestone{
+			Type:          pb.ValidatorMilestone_Type(m.Type),
+			Epoch:         m.Epoch,
+			Slot:          m.Slot,
+			Timestamp:     m.Timestamp,
+			QueuePosition: m.QueuePosition,
+			Approximate:   m.Approximate,
+		})
+	}
+	// Milestones of a lifecycle the archiver caught up with are not archived in order.
+	sort.SliceStable(res.Milestones, func(i, j int) bool {
+		return res.Milestones[i].Timestamp < res.Milestones[j].Timestamp
+	})
+	return res, nil
+}
+
+// scheduledLifecycle of a validator is made of the milestones with epochs in its validator record,
+// for beacon nodes which do not archive lifecycles.
+func scheduledLifecycle(headState *stateTrie.BeaconState, idx uint64) (*pbp2p.ArchivedValidatorLifecycle, error) {
+	val, err := headState.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return nil, err
+	}
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	lifecycle := &pbp2p.ArchivedValidatorLifecycle{}
+	add := func(t pbp2p.ArchivedValidatorMilestone_Type, epoch uint64) {
+		if epoch == farFutureEpoch {
+			return
+		}
+		slot := helpers.StartSlot(epoch)
+		lifecycle.Milestones = append(lifecycle.Milestones, &pbp2p.ArchivedValidatorMilestone{
+			Type:      t,
+			Epoch:     epoch,
+			Slot:      slot,
+			Timestamp: headState.GenesisTime() + slot*params.BeaconConfig().SecondsPerSlot,
+		})
+	}
+	add(pbp2p.ArchivedValidatorMilestone_ELIGIBLE, val.ActivationEligibilityEpoch())
+	add(pbp2p.ArchivedValidatorMilestone_ACTIVATED, val.ActivationEpoch())
+	add(pbp2p.ArchivedValidatorMilestone_EXITED, val.ExitEpoch())
+	add(pbp2p.ArchivedValidatorMilestone_WITHDRAWABLE, val.WithdrawableEpoch())
+	return lifecycle, nil
+}
@@ -0,0 +1,117 @@
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestServer_ValidatorTimeline_RequiresPublicKey(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+
+	ls := &Server{BeaconDB: db, HeadFetcher: &mock.ChainService{}, BlockFetcher: &mockPOW.POWChain{}}
+	wanted := "Must specify a validator public key"
+	if _, err := ls.ValidatorTimeline(context.Background(), &pb.ValidatorTimelineRequest{}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
+}
+
+func TestServer_ValidatorTimeline(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	archived := make([]byte, 48)
+	archived[0] = 'a'
+	scheduled := make([]byte, 48)
+	scheduled[0] = 'b'
+	if err := db.SaveDepositAudits(ctx, []*dbpb.DepositAudit{
+		{Index: 0, PublicKey: archived, Eth1BlockNumber: 10},
+		{Index: 1, PublicKey: scheduled, Eth1BlockNumber: 11},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedValidatorMilestones(ctx, map[uint64][]*pbp2p.ArchivedValidatorMilestone{
+		0: {
+			{Type: pbp2p.ArchivedValidatorMilestone_INCLUDED, Epoch: 2, Timestamp: 1200},
+			{Type: pbp2p.ArchivedValidatorMilestone_ELIGIBLE, Epoch: 1, Timestamp: 1100},
+			{Type: pbp2p.ArchivedValidatorMilestone_QUEUED, Epoch: 2, Timestamp: 1200, QueuePosition: 4},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	st, err := stateTrie.InitializeFromProto(&pbp2p.BeaconState{
+		GenesisTime: 1000,
+		Validators: []*ethpb.Validator{
+			{PublicKey: archived, ActivationEpoch: farFuture, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+			{PublicKey: scheduled, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ls := &Server{
+		BeaconDB:     db,
+		HeadFetcher:  &mock.ChainService{State: st},
+		BlockFetcher: &mockPOW.POWChain{TimesByHeight: map[int]uint64{10: 900, 11: 950}},
+	}
+
+	res, err := ls.ValidatorTimeline(ctx, &pb.ValidatorTimelineRequest{PublicKey: archived})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.HasValidator || res.ValidatorIndex != 0 {
+		t.Errorf("Expected validator 0, received %d", res.ValidatorIndex)
+	}
+	want := []pb.ValidatorMilestone_Type{
+		pb.ValidatorMilestone_DEPOSITED,
+		pb.ValidatorMilestone_ELIGIBLE,
+		pb.ValidatorMilestone_INCLUDED,
+		pb.ValidatorMilestone_QUEUED,
+	}
+	if len(res.Milestones) != len(want) {
+		t.Fatalf("Expected %d milestones, received %v", len(want), res.Milestones)
+	}
+	for i, m := range res.Milestones {
+		if m.Type != want[i] {
+			t.Errorf("Expected milestone %d to be %v, received %v", i, want[i], m.Type)
+		}
+	}
+	if m := res.Milestones[0]; m.Timestamp != 900 || m.Eth1BlockNumber != 10 {
+		t.Errorf("Unexpected deposit milestone %v", m)
+	}
+	if res.Milestones[3].QueuePosition != 4 {
+		t.Errorf("Expected queue position 4, received %d", res.Milestones[3].QueuePosition)
+	}
+
+	// Without an archived lifecycle the milestones scheduled in the head state are returned.
+	res, err = ls.ValidatorTimeline(ctx, &pb.ValidatorTimelineRequest{PublicKey: scheduled})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []pb.ValidatorMilestone_Type{
+		pb.ValidatorMilestone_DEPOSITED,
+		pb.ValidatorMilestone_ELIGIBLE,
+		pb.ValidatorMilestone_ACTIVATED,
+	}
+	if len(res.Milestones) != len(want) {
+		t.Fatalf("Expected %d milestones, received %v", len(want), res.Milestones)
+	}
+	for i, m := range res.Milestones {
+		if m.Type != want[i] {
+			t.Errorf("Expected milestone %d to be %v, received %v", i, want[i], m.Type)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package ratelimit
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpc/ratelimit")
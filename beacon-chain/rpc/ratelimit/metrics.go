@@ -0,0 +1,23 @@
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	throttledCalls = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_throttled_calls_total",
+			Help: "Count of RPC calls rejected because the client exceeded its rate limit budget.",
+		},
+		[]string{"method"},
+	)
+	requestCost = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_request_cost_total",
+			Help: "Total cost units charged to RPC clients.",
+		},
+		[]string{"method"},
+	)
+)
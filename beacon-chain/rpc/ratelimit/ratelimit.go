@@ -0,0 +1,219 @@
+// Package ratelimit limits the cost of the calls each client makes to the beacon node
+// gRPC server. Every client has a budget of cost units which refills at a constant rate.
+// A call costs one unit, unless it is listed in the cost table: expensive methods have a
+// higher base cost, plus a cost per item of the requested page. Clients on the same host as the
+// beacon node, such as its validator client, are not limited.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/auth"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// maxClients is the number of clients whose budgets are tracked. The budget of the least
+// recently seen client is forgotten, which refills it.
+const maxClients = 4096
+
+// Cost of a method, in budget units.
+type Cost struct {
+	// Base is charged for every call.
+	Base float64
+	// PerItem is charged for every item of the requested page.
+	PerItem float64
+}
+
+// DefaultCost is the cost of methods which are not in the cost table.
+var DefaultCost = Cost{Base: 1}
+
+// Costs of methods which scan the database or load historical states.
+var Costs = map[string]Cost{
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBlocks":                   {Base: 5, PerItem: 0.05},
+	"/ethereum.eth.v1alpha1.BeaconChain/ListAttestations":             {Base: 5, PerItem: 0.05},
+	"/ethereum.eth.v1alpha1.BeaconChain/AttestationPool":              {Base: 5, PerItem: 0.05},
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidators":               {Base: 10, PerItem: 0.02},
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances":        {Base: 20, PerItem: 0.02},
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments":     {Base: 20, PerItem: 0.05},
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees":         {Base: 20},
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges": {Base: 20},
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation":    {Base: 20},
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue":            {Base: 10},
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorPerformance":      {Base: 10},
+	"/ethereum.beacon.rpc.v1.DepositService/ListDeposits":             {Base: 5},
+	"/ethereum.beacon.rpc.v1.LifecycleService/ValidatorTimeline":      {Base: 5},
+}
+
+// paginated requests have a page size.
+type paginated interface {
+	GetPageSize() int32
+}
+
+// bucket is the remaining budget of a client.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// Limiter charges the cost of calls to the budget of the calling client.
+type Limiter struct {
+	rate    float64
+	burst   float64
+	buckets *lru.Cache
+	lock    sync.Mutex
+	now     func() time.Time
+}
+
+// New returns a limiter which refills the budget of every client by rate units per second,
+// up to burst units. Budgets of identities with a rate limit in the auth policy are refilled
+// at their own rate.
+func New(rate float64, burst float64) (*Limiter, error) {
+	buckets, err := lru.New(maxClients)
+	if err != nil {
+		return nil, err
+	}
+	return &Limiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: buckets,
+		now:     time.Now,
+	}, nil
+}
+
+// UnaryServerInterceptor rejects unary calls which exceed the budget of the client.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if wait, ok := l.take(ctx, info.FullMethod, req); !ok {
+			if err := grpc.SetHeader(ctx, retryAfter(wait)); err != nil {
+				log.WithError(err).Debug("Could not set retry-after header")
+			}
+			return nil, throttled(info.FullMethod, wait)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams which exceed the budget of the client. The cost of
+// a stream is charged once, when it is opened.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if wait, ok := l.take(ss.Context(), info.FullMethod, nil); !ok {
+			if err := ss.SetHeader(retryAfter(wait)); err != nil {
+				log.WithError(err).Debug("Could not set retry-after header")
+			}
+			return throttled(info.FullMethod, wait)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// take charges the cost of the call to the budget of the client. It returns false, and how
+// long the client should wait before retrying, if the budget is exhausted.
+func (l *Limiter) take(ctx context.Context, method string, req interface{}) (time.Duration, bool) {
+	rate, burst := l.rate, l.burst
+	key := clientKey(ctx)
+	if key == "" {
+		return 0, true
+	}
+	if id := auth.IdentityFromContext(ctx); id != nil {
+		if id.RateLimit != nil {
+			rate = *id.RateLimit
+		}
+		if id.RateLimitBurst != nil {
+			burst = *id.RateLimitBurst
+		}
+	}
+	if rate == 0 {
+		return 0, true
+	}
+	cost := callCost(method, req)
+	requestCost.WithLabelValues(method).Add(cost)
+	// A call which costs more than the whole budget is allowed once the budget is full.
+	cost = math.Min(cost, burst)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := l.now()
+	b := &bucket{tokens: burst, updated: now}
+	if v, ok := l.buckets.Get(key); ok {
+		b = v.(*bucket)
+		b.tokens = math.Min(burst, b.tokens+now.Sub(b.updated).Seconds()*rate)
+		b.updated = now
+	} else {
+		l.buckets.Add(key, b)
+	}
+	if b.tokens < cost {
+		throttledCalls.WithLabelValues(method).Inc()
+		return time.Duration((cost - b.tokens) / rate * float64(time.Second)), false
+	}
+	b.tokens -= cost
+	return 0, true
+}
+
+// callCost returns the cost of a call, weighting paginated requests by their page size.
+func callCost(method string, req interface{}) float64 {
+	cost, ok := Costs[method]
+	if !ok {
+		cost = DefaultCost
+	}
+	if cost.PerItem == 0 {
+		return cost.Base
+	}
+	pageSize := params.BeaconConfig().DefaultPageSize
+	if r, ok := req.(paginated); ok && r.GetPageSize() > 0 {
+		pageSize = int(r.GetPageSize())
+	}
+	return cost.Base + cost.PerItem*float64(pageSize)
+}
+
+// clientKey identifies the budget of a client: the identity it authenticated as, or else its
+// IP address. Requests proxied by a local gRPC gateway are keyed by the address of the peer of
+// the gateway, which the gateway appends to the forwarded addresses: the addresses set by the
+// client itself are not trusted. Local clients which are not proxied, such as a validator client
+// on the same host, are not limited, and have an empty key.
+func clientKey(ctx context.Context) string {
+	if id := auth.IdentityFromContext(ctx); id != nil {
+		return "identity:" + id.Name
+	}
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(pr.Addr.String())
+	if err != nil {
+		host = pr.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return "ip:" + host
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			if hop := strings.TrimSpace(hops[len(hops)-1]); hop != "" {
+				return "ip:" + hop
+			}
+		}
+	}
+	return ""
+}
+
+func throttled(method string, wait time.Duration) error {
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s, retry in %v", method, wait.Round(time.Millisecond))
+}
+
+// retryAfter is the response header with the number of seconds to wait before retrying.
+func retryAfter(wait time.Duration) metadata.MD {
+	return metadata.Pairs("retry-after", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+}
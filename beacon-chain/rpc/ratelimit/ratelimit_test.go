@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/auth"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const listBlocks = "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks"
+
+type pageRequest struct {
+	pageSize int32
+}
+
+func (r *pageRequest) GetPageSize() int32 {
+	return r.pageSize
+}
+
+func peerContext(addr string) context.Context {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		panic(err)
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+}
+
+func newTestLimiter(t *testing.T, rate float64, burst float64) (*Limiter, *time.Time) {
+	l, err := New(rate, burst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestCallCost(t *testing.T) {
+	defaultPage := float64(params.BeaconConfig().DefaultPageSize)
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		want   float64
+	}{
+		{name: "unlisted method", method: "/ethereum.eth.v1alpha1.Node/GetSyncStatus", want: 1},
+		{name: "default page size", method: listBlocks, req: &pageRequest{}, want: 5 + 0.05*defaultPage},
+		{name: "requested page size", method: listBlocks, req: &pageRequest{pageSize: 1000}, want: 55},
+		{name: "no request", method: listBlocks, want: 5 + 0.05*defaultPage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := callCost(tt.method, tt.req); got != tt.want {
+				t.Errorf("Wanted cost %v, received %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLimiter_ThrottlesAndRefills(t *testing.T) {
+	l, now := newTestLimiter(t, 10, 100)
+	ctx := peerContext("10.0.0.1:4000")
+	req := &pageRequest{pageSize: 900}
+
+	// Each call costs 50 units, so the burst allows two calls.
+	for i := 0; i < 2; i++ {
+		if _, ok := l.take(ctx, listBlocks, req); !ok {
+			t.Fatalf("Call %d was throttled", i)
+		}
+	}
+	wait, ok := l.take(ctx, listBlocks, req)
+	if ok {
+		t.Fatal("Expected call to be throttled")
+	}
+	if wait != 5*time.Second {
+		t.Errorf("Wanted retry in 5s, received %v", wait)
+	}
+
+	// Other clients have their own budget.
+	if _, ok := l.take(peerContext("10.0.0.2:4000"), listBlocks, req); !ok {
+		t.Error("Call from another client was throttled")
+	}
+
+	*now = now.Add(wait)
+	if _, ok := l.take(ctx, listBlocks, req); !ok {
+		t.Error("Call was throttled after the budget refilled")
+	}
+}
+
+func TestLimiter_IdentityBudget(t *testing.T) {
+	l, _ := newTestLimiter(t, 1, 1)
+	unlimited := float64(0)
+	id := &auth.Identity{Name: "validator", RateLimit: &unlimited}
+	ctx := auth.NewContext(peerContext("10.0.0.1:4000"), id)
+	for i := 0; i < 10; i++ {
+		if _, ok := l.take(ctx, listBlocks, nil); !ok {
+			t.Fatalf("Call %d of unlimited identity was throttled", i)
+		}
+	}
+}
+
+func TestClientKey_ForwardedByGateway(t *testing.T) {
+	tests := []struct {
+		name      string
+		addr      string
+		forwarded []string
+		want      string
+	}{
+		{name: "forwarded by local gateway", addr: "127.0.0.1:5000", forwarded: []string{"192.168.1.10"}, want: "ip:192.168.1.10"},
+		// The gRPC gateway appends the address of its peer to the addresses sent by the client.
+		{name: "forwarded address set by client", addr: "127.0.0.1:5000", forwarded: []string{"10.9.9.9, 192.168.1.10"}, want: "ip:192.168.1.10"},
+		{name: "forwarded metadata set by client", addr: "127.0.0.1:5000", forwarded: []string{"10.9.9.9", "192.168.1.10"}, want: "ip:192.168.1.10"},
+		// Only a local gateway is trusted to forward addresses.
+		{name: "forwarded by remote peer", addr: "10.0.0.1:5000", forwarded: []string{"192.168.1.10"}, want: "ip:10.0.0.1"},
+		{name: "local client", addr: "127.0.0.1:5000", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			for _, f := range tt.forwarded {
+				md.Append("x-forwarded-for", f)
+			}
+			ctx := metadata.NewIncomingContext(peerContext(tt.addr), md)
+			if key := clientKey(ctx); key != tt.want {
+				t.Errorf("Wanted key %q, received %q", tt.want, key)
+			}
+		})
+	}
+}
+
+func TestLimiter_LocalClientNotLimited(t *testing.T) {
+	l, _ := newTestLimiter(t, 1, 1)
+	ctx := peerContext("127.0.0.1:4000")
+	for i := 0; i < 10; i++ {
+		if _, ok := l.take(ctx, listBlocks, nil); !ok {
+			t.Fatalf("Call %d of local client was throttled", i)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor_ResourceExhausted(t *testing.T) {
+	l, _ := newTestLimiter(t, 1, 1)
+	interceptor := l.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.Node/GetSyncStatus"}
+	ctx := peerContext("10.0.0.1:4000")
+	if _, err := interceptor(ctx, nil, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := interceptor(ctx, nil, info, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Wanted resource exhausted error, received %v", err)
+	}
+}
@@ -3,7 +3,10 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"net"
 	"os"
@@ -26,8 +29,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/aggregator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/auth"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/chain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/deposit"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/lifecycle"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/ratelimit"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -75,6 +83,10 @@ type Service struct {
 	listener               net.Listener
 	withCert               string
 	withKey                string
+	withClientCA           string
+	authPolicy             *auth.Policy
+	rateLimit              float64
+	rateLimitBurst         float64
 	grpcServer             *grpc.Server
 	canonicalStateChan     chan *pbp2p.BeaconState
 	incomingAttestation    chan *ethpb.Attestation
@@ -99,6 +111,10 @@ type Config struct {
 	Port                  string
 	CertFlag              string
 	KeyFlag               string
+	ClientCAFlag          string
+	AuthPolicy            *auth.Policy
+	RateLimit             float64
+	RateLimitBurst        float64
 	BeaconDB              db.HeadAccessDatabase
 	HeadFetcher           blockchain.HeadFetcher
 	ForkFetcher           blockchain.ForkFetcher
@@ -153,6 +169,10 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		port:                  cfg.Port,
 		withCert:              cfg.CertFlag,
 		withKey:               cfg.KeyFlag,
+		withClientCA:          cfg.ClientCAFlag,
+		authPolicy:            cfg.AuthPolicy,
+		rateLimit:             cfg.RateLimit,
+		rateLimitBurst:        cfg.RateLimitBurst,
 		depositFetcher:        cfg.DepositFetcher,
 		pendingDepositFetcher: cfg.PendingDepositFetcher,
 		canonicalStateChan:    make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
@@ -175,28 +195,47 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("address", address).Info("RPC-API listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_opentracing.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_opentracing.UnaryServerInterceptor(),
+	}
+	if s.authPolicy != nil {
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(s.authPolicy))
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(s.authPolicy))
+	} else {
+		log.Warn("No RPC auth policy provided, every method of the RPC server can be called by anyone who can reach it")
+	}
+	// Rate limits are applied after authentication, so budgets can be kept per identity. The
+	// limiter is also needed when only some identities of the auth policy are rate limited.
+	if s.rateLimit > 0 || (s.authPolicy != nil && s.authPolicy.RateLimited()) {
+		limiter, err := ratelimit.New(s.rateLimit, s.rateLimitBurst)
+		if err != nil {
+			log.Errorf("Could not create RPC rate limiter: %v", err)
+		} else {
+			streamInterceptors = append(streamInterceptors, limiter.StreamServerInterceptor())
+			unaryInterceptors = append(unaryInterceptors, limiter.UnaryServerInterceptor())
+		}
+	}
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_opentracing.StreamServerInterceptor(),
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_opentracing.UnaryServerInterceptor(),
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	// TODO(#791): Utilize a certificate for secure connections
 	// between beacon nodes and validator clients.
 	if s.withCert != "" && s.withKey != "" {
-		creds, err := credentials.NewServerTLSFromFile(s.withCert, s.withKey)
+		creds, err := s.serverCredentials()
 		if err != nil {
 			log.Errorf("Could not load TLS keys: %s", err)
 			s.credentialError = err
@@ -264,7 +303,23 @@ func (s *Service) Start() {
 		AttPool:     s.attestationsPool,
 		P2p:         s.p2p,
 	}
+	chainServer := &chain.Server{
+		Ctx:           s.ctx,
+		StateNotifier: s.stateNotifier,
+	}
+	depositServer := &deposit.Server{
+		BeaconDB:    s.beaconDB,
+		HeadFetcher: s.headFetcher,
+	}
+	lifecycleServer := &lifecycle.Server{
+		BeaconDB:     s.beaconDB,
+		HeadFetcher:  s.headFetcher,
+		BlockFetcher: s.powChainService,
+	}
 	pb.RegisterAggregatorServiceServer(s.grpcServer, aggregatorServer)
+	pb.RegisterChainServiceServer(s.grpcServer, chainServer)
+	pb.RegisterDepositServiceServer(s.grpcServer, depositServer)
+	pb.RegisterLifecycleServiceServer(s.grpcServer, lifecycleServer)
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
@@ -319,6 +374,32 @@ func (s *Service) startSlasherClient() {
 	s.slasherClient = slashpb.NewSlasherClient(s.slasherConn)
 }
 
+// serverCredentials loads the TLS certificate of the server. When a client certificate
+// authority is configured, client certificates signed by it are verified, so that the auth
+// policy can identify callers by certificate.
+func (s *Service) serverCredentials() (credentials.TransportCredentials, error) {
+	if s.withClientCA == "" {
+		return credentials.NewServerTLSFromFile(s.withCert, s.withKey)
+	}
+	cert, err := tls.LoadX509KeyPair(s.withCert, s.withKey)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := ioutil.ReadFile(s.withClientCA)
+	if err != nil {
+		return nil, err
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", s.withClientCA)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    clientCAs,
+	}), nil
+}
+
 // Stop the service.
 func (s *Service) Stop() error {
 	s.cancel()
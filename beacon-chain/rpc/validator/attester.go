@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -132,6 +133,14 @@ func (vs *Server) ProposeAttestation(ctx context.Context, att *ethpb.Attestation
 		return nil, status.Errorf(codes.Internal, "Could not broadcast attestation: %v", err)
 	}
 
+	// Send the attestation to the operation feed.
+	vs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{
+			Attestation: att,
+		},
+	})
+
 	go func() {
 		ctx = trace.NewContext(context.Background(), trace.FromContext(ctx))
 		attCopy := stateTrie.CopyAttestation(att)
@@ -157,16 +166,20 @@ func (vs *Server) waitToOneThird(ctx context.Context, slot uint64) {
 		return
 	}
 
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	// Check the head slot again, as the block of the slot may have been processed before subscribing.
+	if slot == vs.HeadFetcher.HeadSlot() {
+		return
+	}
+
 	// Set time out to be at start slot time + one-third of slot duration.
 	slotStartTime := slotutil.SlotStartTime(uint64(vs.GenesisTimeFetcher.GenesisTime().Unix()), slot)
 	slotOneThirdTime := slotStartTime.Unix() + int64(params.BeaconConfig().SecondsPerSlot/3)
 	waitDuration := slotOneThirdTime - roughtime.Now().Unix()
 	timeOut := time.After(time.Duration(waitDuration) * time.Second)
 
-	stateChannel := make(chan *feed.Event, 1)
-	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
-	defer stateSub.Unsubscribe()
-
 	for {
 		select {
 		case event := <-stateChannel:
@@ -180,6 +193,8 @@ func (vs *Server) waitToOneThird(ctx context.Context, slot uint64) {
 
 		case <-timeOut:
 			return
+		case <-ctx.Done():
+			return
 		}
 	}
 }
@@ -34,11 +34,12 @@ func TestProposeAttestation_OK(t *testing.T) {
 	ctx := context.Background()
 
 	attesterServer := &Server{
-		HeadFetcher:      &mock.ChainService{},
-		P2P:              &mockp2p.MockBroadcaster{},
-		BeaconDB:         db,
-		AttestationCache: cache.NewAttestationCache(),
-		AttPool:          attestations.NewPool(),
+		HeadFetcher:       &mock.ChainService{},
+		P2P:               &mockp2p.MockBroadcaster{},
+		BeaconDB:          db,
+		AttestationCache:  cache.NewAttestationCache(),
+		AttPool:           attestations.NewPool(),
+		OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 	head := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
@@ -391,6 +392,33 @@ func TestWaitForSlotOneThird_WaitedCorrectly(t *testing.T) {
 	}
 }
 
+func TestWaitForSlotOneThird_ReturnsOnCanceledContext(t *testing.T) {
+	currentTime := uint64(time.Now().Unix())
+	numOfSlots := uint64(4)
+	genesisTime := currentTime - (numOfSlots * params.BeaconConfig().SecondsPerSlot)
+
+	chainService := &mock.ChainService{
+		Genesis: time.Now(),
+	}
+	server := &Server{
+		AttestationCache:   cache.NewAttestationCache(),
+		HeadFetcher:        &mock.ChainService{},
+		SyncChecker:        &mockSync.Sync{IsSyncing: false},
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Unix(int64(genesisTime), 0)},
+		StateNotifier:      chainService.StateNotifier(),
+	}
+
+	// The client gave up on the request.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	server.waitToOneThird(ctx, numOfSlots)
+
+	if time.Since(start) >= time.Second {
+		t.Errorf("Expected to stop waiting once the request is canceled, waited %v", time.Since(start))
+	}
+}
+
 func TestWaitForSlotOneThird_HeadIsHereNoWait(t *testing.T) {
 	currentTime := uint64(time.Now().Unix())
 	numOfSlots := uint64(4)
@@ -137,9 +137,14 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 // eth1Data determines the appropriate eth1data for a block proposal. The algorithm for this method
 // is as follows:
 //  - Determine the timestamp for the start slot for the eth1 voting period.
-//  - Determine the most recent eth1 block before that timestamp.
-//  - Subtract that eth1block.number by ETH1_FOLLOW_DISTANCE.
-//  - This is the eth1block to use for the block proposal.
+//  - The candidates are the eth1 blocks with a timestamp between 2 * ETH1_FOLLOW_DISTANCE and
+//    ETH1_FOLLOW_DISTANCE blocks before that timestamp, which do not lower the deposit count of
+//    the state.
+//  - Vote for the candidate with the most votes in the voting period, breaking ties by the
+//    earliest vote, or for the latest candidate if no votes are for a candidate.
+//  - Without candidates, vote for the eth1data of the state.
+// The candidates are served from the eth1 header cache of the powchain service, so a slow eth1
+// node does not delay the proposal.
 func (vs *Server) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
 	if vs.MockEth1Votes {
 		return vs.mockETH1DataVote(ctx, slot)
@@ -149,16 +154,75 @@ func (vs *Server) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, e
 		return vs.randomETH1DataVote(ctx)
 	}
 
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+
+	slotsPerPeriod := params.BeaconConfig().SlotsPerEth1VotingPeriod
 	eth1VotingPeriodStartTime, _ := vs.Eth1InfoFetcher.Eth2GenesisPowchainInfo()
-	eth1VotingPeriodStartTime += (slot - (slot % params.BeaconConfig().SlotsPerEth1VotingPeriod)) * params.BeaconConfig().SecondsPerSlot
+	eth1VotingPeriodStartTime += (slot - (slot % slotsPerPeriod)) * params.BeaconConfig().SecondsPerSlot
 
-	// Look up most recent block up to timestamp
-	blockNumber, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, eth1VotingPeriodStartTime)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get block number from timestamp")
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().SecondsPerETH1Block
+	if eth1VotingPeriodStartTime < followTime {
+		return headState.Eth1Data(), nil
+	}
+	end := eth1VotingPeriodStartTime - followTime
+	start := uint64(0)
+	if end > followTime {
+		start = end - followTime
+	}
+	candidates := vs.Eth1BlockFetcher.Eth1DataCandidates(start, end)
+
+	// Votes of the previous voting period are only cleared when the head state crosses into the
+	// voting period of the slot.
+	var votes []*ethpb.Eth1Data
+	if headState.Slot()/slotsPerPeriod == slot/slotsPerPeriod {
+		votes = headState.Eth1DataVotes()
+	}
+	return chooseEth1DataVote(headState.Eth1Data(), votes, candidates), nil
+}
+
+// chooseEth1DataVote returns the candidate with the most votes, breaking ties by the earliest
+// vote. Candidates with a lower deposit count than the current eth1data are not valid.
+func chooseEth1DataVote(current *ethpb.Eth1Data, votes []*ethpb.Eth1Data, candidates []*ethpb.Eth1Data) *ethpb.Eth1Data {
+	valid := make(map[string]*ethpb.Eth1Data)
+	var latest *ethpb.Eth1Data
+	for _, c := range candidates {
+		if c.DepositCount < current.DepositCount {
+			continue
+		}
+		valid[eth1DataKey(c)] = c
+		latest = c
+	}
+	if latest == nil {
+		return current
+	}
+
+	counts := make(map[string]int)
+	for _, v := range votes {
+		counts[eth1DataKey(v)]++
+	}
+	var best *ethpb.Eth1Data
+	bestCount := 0
+	for _, v := range votes {
+		key := eth1DataKey(v)
+		candidate, ok := valid[key]
+		// Votes are visited in order and a later vote needs strictly more votes, so ties go
+		// to the earliest vote.
+		if ok && counts[key] > bestCount {
+			best = candidate
+			bestCount = counts[key]
+		}
+	}
+	if best != nil {
+		return best
 	}
+	return latest
+}
 
-	return vs.defaultEth1DataResponse(ctx, blockNumber)
+func eth1DataKey(data *ethpb.Eth1Data) string {
+	return string(data.BlockHash) + string(data.DepositRoot) + string(bytesutil.Bytes8(data.DepositCount))
 }
 
 func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
@@ -324,29 +388,6 @@ func (vs *Server) canonicalEth1Data(ctx context.Context, beaconState *stateTrie.
 	return canonicalEth1Data, latestEth1DataHeight, nil
 }
 
-// in case no vote for new eth1data vote considered best vote we
-// default into returning the latest deposit root and the block
-// hash of eth1 block hash that is FOLLOW_DISTANCE back from its
-// latest block.
-func (vs *Server) defaultEth1DataResponse(ctx context.Context, currentHeight *big.Int) (*ethpb.Eth1Data, error) {
-	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
-	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
-	blockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, ancestorHeight)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not fetch ETH1_FOLLOW_DISTANCE ancestor")
-	}
-	// Fetch all historical deposits up to an ancestor height.
-	depositsTillHeight, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, ancestorHeight)
-	if depositsTillHeight == 0 {
-		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
-	}
-	return &ethpb.Eth1Data{
-		DepositRoot:  depositRoot[:],
-		BlockHash:    blockHash[:],
-		DepositCount: depositsTillHeight,
-	}, nil
-}
-
 // This filters the input attestations to return a list of valid attestations to be packaged inside a beacon block.
 func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, slot uint64, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
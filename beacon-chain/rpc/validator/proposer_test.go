@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"math/big"
-	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -1032,117 +1031,58 @@ func TestPendingDeposits_CantReturnMoreDepositCount(t *testing.T) {
 	}
 }
 
-func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
-	beaconState, _ := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
-		Eth1Data: &ethpb.Eth1Data{
-			BlockHash: []byte{'a'},
-		},
-		Eth1DataVotes: []*ethpb.Eth1Data{},
-	})
-	p := &mockPOW.FaultyMockPOWChain{
-		HashesByHeight: make(map[int][]byte),
+func TestEth1Data_NoCandidates(t *testing.T) {
+	stateEth1Data := &ethpb.Eth1Data{
+		BlockHash:    []byte("state"),
+		DepositCount: 55,
 	}
+	beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{Eth1Data: stateEth1Data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &mockPOW.FaultyMockPOWChain{}
 	proposerServer := &Server{
 		ChainStartFetcher: p,
 		Eth1InfoFetcher:   p,
 		Eth1BlockFetcher:  p,
-		BlockReceiver:     &mock.ChainService{State: beaconState},
 		HeadFetcher:       &mock.ChainService{State: beaconState},
 	}
-	want := "could not fetch ETH1_FOLLOW_DISTANCE ancestor"
-	if _, err := proposerServer.eth1Data(context.Background(), beaconState.Slot()+1); !strings.Contains(err.Error(), want) {
-		t.Errorf("Expected error %v, received %v", want, err)
-	}
-}
-
-func TestDefaultEth1Data_NoBlockExists(t *testing.T) {
-	ctx := context.Background()
-
-	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
-	deps := []*dbpb.DepositContainer{
-		{
-			Index:           0,
-			Eth1BlockHeight: 8,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("a"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-		{
-			Index:           1,
-			Eth1BlockHeight: 14,
-			Deposit: &ethpb.Deposit{
-				Data: &ethpb.Deposit_Data{
-					PublicKey:             []byte("b"),
-					Signature:             make([]byte, 96),
-					WithdrawalCredentials: make([]byte, 32),
-				}},
-		},
-	}
-	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
-	if err != nil {
-		t.Fatalf("could not setup deposit trie: %v", err)
-	}
-	depositCache := depositcache.NewDepositCache()
-	for _, dp := range deps {
-		depositCache.InsertDeposit(context.Background(), dp.Deposit, dp.Eth1BlockHeight, dp.Index, depositTrie.Root())
-	}
-
-	p := &mockPOW.POWChain{
-		LatestBlockNumber: height,
-		HashesByHeight: map[int][]byte{
-			0:  []byte("hash0"),
-			12: []byte("hash12"),
-		},
-	}
-	proposerServer := &Server{
-		ChainStartFetcher:      p,
-		Eth1InfoFetcher:        p,
-		Eth1BlockFetcher:       p,
-		DepositFetcher:         depositCache,
-		PendingDepositsFetcher: depositCache,
-	}
-
-	defEth1Data := &ethpb.Eth1Data{
-		DepositCount: 10,
-		BlockHash:    []byte{'t', 'e', 's', 't'},
-		DepositRoot:  []byte{'r', 'o', 'o', 't'},
-	}
-
-	p.Eth1Data = defEth1Data
-
-	result, err := proposerServer.defaultEth1DataResponse(ctx, big.NewInt(16))
+	eth1Data, err := proposerServer.eth1Data(context.Background(), 10000)
 	if err != nil {
 		t.Fatal(err)
 	}
-
-	if !proto.Equal(result, defEth1Data) {
-		t.Errorf("Did not receive default eth1data. Wanted %v but Got %v", defEth1Data, result)
+	if !proto.Equal(eth1Data, stateEth1Data) {
+		t.Errorf("Wanted the eth1data of the state %v, received %v", stateEth1Data, eth1Data)
 	}
 }
 
-// TODO(2312): Add more tests for edge cases and better coverage.
 func TestEth1Data(t *testing.T) {
 	slot := uint64(10000)
+	periodStart := (slot - slot%params.BeaconConfig().SlotsPerEth1VotingPeriod) * params.BeaconConfig().SecondsPerSlot
+	followTime := params.BeaconConfig().Eth1FollowDistance * params.BeaconConfig().SecondsPerETH1Block
 
+	earliest := &ethpb.Eth1Data{BlockHash: []byte("earliest"), DepositCount: 55}
+	latest := &ethpb.Eth1Data{BlockHash: []byte("latest"), DepositCount: 56}
 	p := &mockPOW.POWChain{
-		BlockNumberByHeight: map[uint64]*big.Int{
-			slot * params.BeaconConfig().SecondsPerSlot: big.NewInt(4096),
-		},
-		HashesByHeight: map[int][]byte{
-			4080: []byte("4080"),
-		},
-		Eth1Data: &ethpb.Eth1Data{
-			DepositCount: 55,
+		Eth1DataByTimestamp: map[uint64]*ethpb.Eth1Data{
+			periodStart - 3*followTime: {BlockHash: []byte("too old"), DepositCount: 60},
+			periodStart - 2*followTime: earliest,
+			periodStart - followTime:   latest,
+			periodStart:                {BlockHash: []byte("too new"), DepositCount: 60},
 		},
 	}
+	beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+		Slot:     slot,
+		Eth1Data: &ethpb.Eth1Data{DepositCount: 50},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
 	ps := &Server{
 		ChainStartFetcher: p,
 		Eth1InfoFetcher:   p,
 		Eth1BlockFetcher:  p,
-		DepositFetcher:    depositcache.NewDepositCache(),
+		HeadFetcher:       &mock.ChainService{State: beaconState},
 	}
 
 	ctx := context.Background()
@@ -1150,9 +1090,71 @@ func TestEth1Data(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if !proto.Equal(eth1Data, latest) {
+		t.Errorf("Expected the latest candidate %v without votes, received %v", latest, eth1Data)
+	}
 
-	if eth1Data.DepositCount != 55 {
-		t.Error("Expected deposit count to be 55")
+	if err := beaconState.SetEth1DataVotes([]*ethpb.Eth1Data{earliest}); err != nil {
+		t.Fatal(err)
+	}
+	eth1Data, err = ps.eth1Data(ctx, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(eth1Data, earliest) {
+		t.Errorf("Expected the voted candidate %v, received %v", earliest, eth1Data)
+	}
+}
+
+func TestChooseEth1DataVote(t *testing.T) {
+	current := &ethpb.Eth1Data{BlockHash: []byte("current"), DepositCount: 10}
+	a := &ethpb.Eth1Data{BlockHash: []byte("a"), DepositCount: 10}
+	b := &ethpb.Eth1Data{BlockHash: []byte("b"), DepositCount: 11}
+	c := &ethpb.Eth1Data{BlockHash: []byte("c"), DepositCount: 12}
+	lower := &ethpb.Eth1Data{BlockHash: []byte("lower"), DepositCount: 9}
+	unknown := &ethpb.Eth1Data{BlockHash: []byte("unknown"), DepositCount: 20}
+
+	tests := []struct {
+		name       string
+		votes      []*ethpb.Eth1Data
+		candidates []*ethpb.Eth1Data
+		want       *ethpb.Eth1Data
+	}{
+		{
+			name: "no candidates",
+			want: current,
+		},
+		{
+			name:       "only candidates lowering the deposit count",
+			candidates: []*ethpb.Eth1Data{lower},
+			votes:      []*ethpb.Eth1Data{lower, lower},
+			want:       current,
+		},
+		{
+			name:       "no valid votes",
+			candidates: []*ethpb.Eth1Data{a, b, c},
+			votes:      []*ethpb.Eth1Data{unknown, lower},
+			want:       c,
+		},
+		{
+			name:       "majority",
+			candidates: []*ethpb.Eth1Data{a, b, c},
+			votes:      []*ethpb.Eth1Data{a, b, b, unknown, unknown, unknown},
+			want:       b,
+		},
+		{
+			name:       "tie goes to the earliest vote",
+			candidates: []*ethpb.Eth1Data{a, b, c},
+			votes:      []*ethpb.Eth1Data{c, b, b, c},
+			want:       c,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chooseEth1DataVote(current, tt.votes, tt.candidates); !proto.Equal(got, tt.want) {
+				t.Errorf("Wanted %v, received %v", tt.want, got)
+			}
+		})
 	}
 }
 
@@ -0,0 +1,112 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// signatureVerificationInterval is the maximum time a signature set waits in the queue before
+// the pending batch is verified.
+const signatureVerificationInterval = 50 * time.Millisecond
+
+// verifierLimit is the number of pending signature sets which triggers an immediate batch verification.
+const verifierLimit = 50
+
+var errBatchSignatureInvalid = errors.New("signature in batch is invalid")
+
+// signatureVerifier is a pending signature set along with the channel the verification result is
+// reported on.
+type signatureVerifier struct {
+	set     *bls.SignatureSet
+	resChan chan error
+}
+
+// A routine that runs in the background to collect signature sets from gossip validation and verify
+// them in batches, either once the verifier limit is reached or the verification interval elapses.
+func (r *Service) verifierRoutine() {
+	ticker := time.NewTicker(signatureVerificationInterval)
+	defer ticker.Stop()
+	verifierBatch := make([]*signatureVerifier, 0, verifierLimit)
+	for {
+		select {
+		case <-r.ctx.Done():
+			for _, v := range verifierBatch {
+				v.resChan <- r.ctx.Err()
+			}
+			return
+		case v := <-r.signatureChan:
+			verifierBatch = append(verifierBatch, v)
+			if len(verifierBatch) >= verifierLimit {
+				verifyBatch(verifierBatch)
+				verifierBatch = make([]*signatureVerifier, 0, verifierLimit)
+			}
+		case <-ticker.C:
+			if len(verifierBatch) > 0 {
+				verifyBatch(verifierBatch)
+				verifierBatch = make([]*signatureVerifier, 0, verifierLimit)
+			}
+		}
+		signatureQueueDepth.Set(float64(len(verifierBatch) + len(r.signatureChan)))
+	}
+}
+
+// validateWithBatchVerifier queues the signature set for batch verification and blocks until the
+// result is known or the context of the gossip validation expires.
+func (r *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureSet) bool {
+	ctx, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
+	defer span.End()
+
+	resChan := make(chan error, 1)
+	select {
+	case r.signatureChan <- &signatureVerifier{set: set, resChan: resChan}:
+	case <-ctx.Done():
+		traceutil.AnnotateError(span, ctx.Err())
+		return false
+	}
+	select {
+	case err := <-resChan:
+		if err != nil {
+			log.WithError(err).Debugf("Could not verify %s", message)
+			traceutil.AnnotateError(span, err)
+			return false
+		}
+		return true
+	case <-ctx.Done():
+		traceutil.AnnotateError(span, ctx.Err())
+		return false
+	}
+}
+
+// verifyBatch verifies all the pending signature sets with a single randomized multi-pairing. If the
+// batch fails, each set is verified on its own so that only the invalid ones are rejected.
+func verifyBatch(verifierBatch []*signatureVerifier) {
+	if len(verifierBatch) == 0 {
+		return
+	}
+	aggSet := bls.NewSet()
+	for _, v := range verifierBatch {
+		aggSet.Join(v.set)
+	}
+	signatureBatchSize.Observe(float64(aggSet.Len()))
+	verified, err := aggSet.Verify()
+	if err == nil && verified {
+		for _, v := range verifierBatch {
+			v.resChan <- nil
+		}
+		return
+	}
+
+	signatureBatchFailures.Inc()
+	for _, v := range verifierBatch {
+		verified, err := v.set.Verify()
+		if err == nil && !verified {
+			err = errBatchSignatureInvalid
+		}
+		v.resChan <- err
+	}
+}
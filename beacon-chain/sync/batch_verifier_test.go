@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func signedSet(t *testing.T, msg [32]byte, valid bool) *bls.SignatureSet {
+	priv := bls.RandKey()
+	sig := priv.Sign(msg[:], 0)
+	if !valid {
+		sig = bls.RandKey().Sign(msg[:], 0)
+	}
+	return bls.NewSet().Add(sig, priv.PublicKey(), msg, 0)
+}
+
+func TestVerifyBatch_AllValid(t *testing.T) {
+	batch := make([]*signatureVerifier, 0, 5)
+	for i := 0; i < 5; i++ {
+		batch = append(batch, &signatureVerifier{
+			set:     signedSet(t, [32]byte{byte(i)}, true),
+			resChan: make(chan error, 1),
+		})
+	}
+	verifyBatch(batch)
+	for i, v := range batch {
+		if err := <-v.resChan; err != nil {
+			t.Errorf("Set %d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestVerifyBatch_FallsBackToFindInvalidSet(t *testing.T) {
+	batch := make([]*signatureVerifier, 0, 5)
+	for i := 0; i < 5; i++ {
+		batch = append(batch, &signatureVerifier{
+			set:     signedSet(t, [32]byte{byte(i)}, i != 3),
+			resChan: make(chan error, 1),
+		})
+	}
+	verifyBatch(batch)
+	for i, v := range batch {
+		err := <-v.resChan
+		if i == 3 && err != errBatchSignatureInvalid {
+			t.Errorf("Set %d: wanted %v, received %v", i, errBatchSignatureInvalid, err)
+		}
+		if i != 3 && err != nil {
+			t.Errorf("Set %d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestValidateWithBatchVerifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := &Service{
+		ctx:           ctx,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	go r.verifierRoutine()
+
+	valCtx, valCancel := context.WithTimeout(ctx, time.Second)
+	defer valCancel()
+	if !r.validateWithBatchVerifier(valCtx, "test", signedSet(t, [32]byte{'a'}, true)) {
+		t.Error("Expected valid signature set to pass")
+	}
+	if r.validateWithBatchVerifier(valCtx, "test", signedSet(t, [32]byte{'b'}, false)) {
+		t.Error("Expected invalid signature set to fail")
+	}
+}
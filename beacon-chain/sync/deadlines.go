@@ -1,14 +1,25 @@
 package sync
 
 import (
+	"strings"
 	"time"
 
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 const defaultReadDuration = ttfbTimeout
 const defaultWriteDuration = 10 * time.Second // RESP_TIMEOUT
 
+// slotDeadlineTopics are the topic prefixes of gossip objects which are only useful within their
+// slot, and which are therefore given a single slot in validation. Operations which remain
+// includable for a long time fall back to pubsubMessageTimeout.
+var slotDeadlineTopics = []string{
+	"/eth2/beacon_block",
+	"/eth2/beacon_aggregate_and_proof",
+	"/eth2/committee_index",
+}
+
 func setRPCStreamDeadlines(stream network.Stream) {
 	setStreamReadDeadline(stream, defaultReadDuration)
 	setStreamWriteDeadline(stream, defaultWriteDuration)
@@ -25,3 +36,14 @@ func setStreamWriteDeadline(stream network.Stream, duration time.Duration) {
 	// time.Now() instead of the synchronized roughtime.Now().
 	stream.SetWriteDeadline(time.Now().Add(duration))
 }
+
+// validationDeadline returns the maximum validation duration of a gossip message received on the
+// given topic.
+func validationDeadline(topic string) time.Duration {
+	for _, prefix := range slotDeadlineTopics {
+		if strings.HasPrefix(topic, prefix) {
+			return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		}
+	}
+	return pubsubMessageTimeout
+}
@@ -27,6 +27,47 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageDuplicateCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_duplicate_total",
+			Help: "Count of messages rejected because an equivalent object was already seen.",
+		},
+		[]string{"topic"},
+	)
+	messageValidationTimeoutCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_validation_timeout_total",
+			Help: "Count of messages that could not be validated before the topic validation deadline.",
+		},
+		[]string{"topic"},
+	)
+	messageValidationLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_message_validation_latency_seconds",
+			Help:    "Time spent validating a gossip message.",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		},
+		[]string{"topic"},
+	)
+	signatureQueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gossip_signature_verification_queue_depth",
+			Help: "Number of gossip signature sets waiting for batch verification.",
+		},
+	)
+	signatureBatchSize = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "gossip_signature_verification_batch_size",
+			Help:    "Number of signatures verified in a single batch.",
+			Buckets: []float64{1, 2, 5, 10, 25, 50, 100, 200},
+		},
+	)
+	signatureBatchFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gossip_signature_verification_batch_failures_total",
+			Help: "Count of signature batches that failed and were verified individually.",
+		},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -57,4 +98,16 @@ var (
 			Help: "Count the number of times attestation not recovered and pruned because of missing block",
 		},
 	)
+	pendingBlocksEvicted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "beacon_pending_blocks_evicted_total",
+			Help: "Count the number of pending blocks dropped because the pending queue was full.",
+		},
+	)
+	pendingBlocksUnbacked = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "beacon_pending_blocks_unbacked_total",
+			Help: "Count the number of times a peer could not serve the parent of a block it gossiped.",
+		},
+	)
 )
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -15,7 +16,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
-	"golang.org/x/exp/rand"
 )
 
 // This defines how often a node cleans up and processes pending attestations in the queue.
@@ -37,7 +37,7 @@ func (s *Service) processPendingAttsQueue() {
 // This defines how pending attestations are processed. It contains features:
 // 1. Clean up invalid pending attestations from the queue.
 // 2. Check if pending attestations can be processed when the block has arrived.
-// 3. Request block from a random peer if unable to proceed step 2.
+// 3. Request missing blocks from the peers most likely to have them if unable to proceed step 2.
 func (s *Service) processPendingAtts(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "processPendingAtts")
 	defer span.End()
@@ -56,6 +56,7 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 	}
 	s.pendingAttsLock.RUnlock()
 
+	requests := make(map[peer.ID][][32]byte)
 	for _, bRoot := range roots {
 		s.pendingAttsLock.RLock()
 		attestations := s.blkRootToPendingAtts[bRoot]
@@ -115,22 +116,20 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 				"blockRoot":   hex.EncodeToString(bytesutil.Trunc(bRoot[:])),
 			}).Debug("Requesting block for pending attestation")
 
-			// Start with a random peer to query, but choose the first peer in our unsorted list that claims to
-			// have a head slot newer or equal to the pending attestation's target boundary slot.
-			pid := pids[rand.Int()%len(pids)]
-			targetSlot := helpers.SlotToEpoch(attestations[0].Aggregate.Data.Target.Epoch)
-			for _, p := range pids {
-				if cs, _ := s.p2p.Peers().ChainState(p); cs != nil && cs.HeadSlot >= targetSlot {
-					pid = p
-					break
-				}
+			if len(pids) == 0 {
+				continue
 			}
+			// Missing blocks are requested in a single request per peer, from the peers whose chain
+			// state suggests they have the block the attestations voted for.
+			pid := s.peerForBlock(pids, bRoot, attestations[0].Aggregate.Data.Slot)
+			requests[pid] = append(requests[pid], bRoot)
+		}
+	}
 
-			req := [][32]byte{bRoot}
-			if err := s.sendRecentBeaconBlocksRequest(ctx, req, pid); err != nil {
-				traceutil.AnnotateError(span, err)
-				log.Errorf("Could not send recent block request: %v", err)
-			}
+	for pid, req := range requests {
+		if err := s.sendRecentBeaconBlocksRequest(ctx, req, pid); err != nil {
+			traceutil.AnnotateError(span, err)
+			log.Errorf("Could not send recent block request: %v", err)
 		}
 	}
 	return nil
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -22,6 +23,14 @@ import (
 
 var processPendingBlocksPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot/3) * time.Second
 
+// maxPendingBlocks is the maximum number of blocks held in the pending queue. When the queue is
+// full, the block with the highest slot is evicted as it is the furthest from being processed.
+const maxPendingBlocks = 128
+
+// maxBackfillDepth is the maximum number of ancestors walked back through in a single attempt to
+// resolve the missing parent of a pending block.
+const maxBackfillDepth = 8
+
 // processes pending blocks queue on every processPendingBlocksPeriod
 func (r *Service) processPendingBlocksQueue() {
 	ctx := context.Background()
@@ -49,6 +58,11 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 		trace.Int64Attribute("numPeers", int64(len(pids))),
 	)
 
+	// Missing parent roots to request, grouped by the peer they are requested from.
+	requests := make(map[peer.ID][][32]byte)
+	// Missing parent roots requested from the peer which gossiped their child, mapped to the child root.
+	gossipedChildren := make(map[[32]byte][32]byte)
+
 	for _, s := range slots {
 		ctx, span := trace.StartSpan(ctx, "processPendingBlocks.InnerLoop")
 		span.AddAttributes(trace.Int64Attribute("slot", int64(s)))
@@ -61,35 +75,45 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 			span.End()
 			continue
 		}
-		r.pendingQueueLock.RUnlock()
 		inPendingQueue := r.seenPendingBlocks[bytesutil.ToBytes32(b.Block.ParentRoot)]
+		r.pendingQueueLock.RUnlock()
 
 		inDB := r.db.HasBlock(ctx, bytesutil.ToBytes32(b.Block.ParentRoot))
 		hasPeer := len(pids) != 0
 
-		// Only request for missing parent block if it's not in DB, not in pending cache
-		// and has peer in the peer list.
+		blkRoot, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			traceutil.AnnotateError(span, err)
+			span.End()
+			return err
+		}
+
+		// Only request for missing parent block if it's not in DB, not in pending cache,
+		// not already being requested and has peer in the peer list.
 		if !inPendingQueue && !inDB && hasPeer {
+			parentRoot := bytesutil.ToBytes32(b.Block.ParentRoot)
+			if r.isBackfilling(parentRoot) {
+				span.End()
+				continue
+			}
 			log.WithFields(logrus.Fields{
 				"currentSlot": b.Block.Slot,
-				"parentRoot":  hex.EncodeToString(bytesutil.Trunc(b.Block.ParentRoot)),
+				"parentRoot":  hex.EncodeToString(bytesutil.Trunc(parentRoot[:])),
 			}).Info("Requesting parent block")
-			req := [][32]byte{bytesutil.ToBytes32(b.Block.ParentRoot)}
-
-			// Start with a random peer to query, but choose the first peer in our unsorted list that claims to
-			// have a head slot newer than the block slot we are requesting.
-			pid := pids[rand.Int()%len(pids)]
-			for _, p := range pids {
-				if cs, _ := r.p2p.Peers().ChainState(p); cs != nil && cs.HeadSlot >= uint64(s) {
-					pid = p
-					break
-				}
-			}
 
-			if err := r.sendRecentBeaconBlocksRequest(ctx, req, pid); err != nil {
-				traceutil.AnnotateError(span, err)
-				log.Errorf("Could not send recent block request: %v", err)
+			// The peer which gossiped the block is asked for its parent first, as it should be able to
+			// back it. Otherwise choose the peer whose chain state suggests it has the parent.
+			r.pendingQueueLock.RLock()
+			source, ok := r.pendingBlockSources[blkRoot]
+			r.pendingQueueLock.RUnlock()
+			var pid peer.ID
+			if ok && isConnected(pids, source) {
+				pid = source
+				gossipedChildren[parentRoot] = blkRoot
+			} else {
+				pid = r.peerForBlock(pids, parentRoot, uint64(s))
 			}
+			requests[pid] = append(requests[pid], parentRoot)
 			span.End()
 			continue
 		}
@@ -109,16 +133,10 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 			log.WithError(err).Error("Failed to broadcast block")
 		}
 
-		blkRoot, err := ssz.HashTreeRoot(b.Block)
-		if err != nil {
-			traceutil.AnnotateError(span, err)
-			span.End()
-			return err
-		}
-
 		r.pendingQueueLock.Lock()
 		delete(r.slotToPendingBlocks, uint64(s))
 		delete(r.seenPendingBlocks, blkRoot)
+		delete(r.pendingBlockSources, blkRoot)
 		r.pendingQueueLock.Unlock()
 
 		log.WithFields(logrus.Fields{
@@ -129,9 +147,209 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 		span.End()
 	}
 
+	// The ancestors are requested in the background, so that slow peers do not hold up the
+	// processing of the queue.
+	for pid, roots := range requests {
+		children := make(map[[32]byte][32]byte)
+		for _, root := range roots {
+			if childRoot, ok := gossipedChildren[root]; ok {
+				children[root] = childRoot
+			}
+		}
+		r.setBackfilling(roots, true)
+		r.pendingBackfills.Add(1)
+		go func(pid peer.ID, roots [][32]byte) {
+			defer r.pendingBackfills.Done()
+			defer r.setBackfilling(roots, false)
+			served, err := r.backfillAncestors(ctx, roots, pid)
+			if err != nil {
+				log.Errorf("Could not send recent block request: %v", err)
+			}
+			// A peer which did not answer may be slow or gone, rather than unable to back its gossip.
+			if served != nil {
+				r.penalizeUnbackedGossip(ctx, pid, roots, served, children)
+			}
+		}(pid, roots)
+	}
+
 	return nil
 }
 
+// backfillAncestors requests the given missing block roots from a peer, then keeps walking back
+// through the parents of the returned blocks which are still unknown, up to maxBackfillDepth
+// requests. All returned blocks are added to the pending queue. It returns the given roots of the
+// blocks the peer served, which is nil if the peer did not answer the first request.
+func (r *Service) backfillAncestors(ctx context.Context, roots [][32]byte, pid peer.ID) (map[[32]byte]bool, error) {
+	ctx, span := trace.StartSpan(ctx, "processPendingBlocks.backfillAncestors")
+	defer span.End()
+
+	var served map[[32]byte]bool
+	for depth := 0; depth < maxBackfillDepth && len(roots) > 0; depth++ {
+		blks, err := r.requestBlocksByRoot(ctx, roots, pid)
+		if err != nil {
+			traceutil.AnnotateError(span, err)
+			return served, err
+		}
+		if served == nil {
+			served = make(map[[32]byte]bool, len(blks))
+			for _, blk := range blks {
+				if root, err := ssz.HashTreeRoot(blk.Block); err == nil {
+					served[root] = true
+				}
+			}
+		}
+		roots = make([][32]byte, 0, len(blks))
+		for _, blk := range blks {
+			parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+			if r.isPendingBlockRoot(parentRoot) || r.db.HasBlock(ctx, parentRoot) {
+				continue
+			}
+			roots = append(roots, parentRoot)
+		}
+	}
+	return served, nil
+}
+
+// penalizeUnbackedGossip increments the bad responses of a peer for every requested parent root it
+// answered without, although it gossiped the child block. The gossip source of the child is
+// forgotten, so the parent is requested from another peer next time.
+func (r *Service) penalizeUnbackedGossip(ctx context.Context, pid peer.ID, roots [][32]byte, served map[[32]byte]bool, gossipedChildren map[[32]byte][32]byte) {
+	for _, root := range roots {
+		childRoot, ok := gossipedChildren[root]
+		if !ok || served[root] || r.isPendingBlockRoot(root) || r.db.HasBlock(ctx, root) {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"peer":       pid.Pretty(),
+			"parentRoot": hex.EncodeToString(bytesutil.Trunc(root[:])),
+		}).Debug("Peer could not serve the parent of a block it gossiped")
+		pendingBlocksUnbacked.Inc()
+		r.p2p.Peers().IncrementBadResponses(pid)
+
+		r.pendingQueueLock.Lock()
+		delete(r.pendingBlockSources, childRoot)
+		r.pendingQueueLock.Unlock()
+	}
+}
+
+// peerForBlock returns the peer to request the block with the given root and slot from. A peer
+// whose head is the block is preferred, then a random peer whose head slot is at or past the slot,
+// and finally a random connected peer.
+func (r *Service) peerForBlock(pids []peer.ID, root [32]byte, slot uint64) peer.ID {
+	candidates := make([]peer.ID, 0, len(pids))
+	for _, p := range pids {
+		cs, _ := r.p2p.Peers().ChainState(p)
+		if cs == nil {
+			continue
+		}
+		if bytesutil.ToBytes32(cs.HeadRoot) == root {
+			return p
+		}
+		if cs.HeadSlot >= slot {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) > 0 {
+		return candidates[rand.Int()%len(candidates)]
+	}
+	return pids[rand.Int()%len(pids)]
+}
+
+// insertPendingBlock adds a block whose parent is unknown to the pending queue. If the queue is
+// full, the block with the highest slot is evicted to make room, unless the new block has the
+// highest slot in which case it is dropped. The peer which gossiped the block is recorded when
+// known, so it can be asked for the missing parent.
+func (r *Service) insertPendingBlock(blk *ethpb.SignedBeaconBlock, root [32]byte, source peer.ID) {
+	r.pendingQueueLock.Lock()
+	defer r.pendingQueueLock.Unlock()
+
+	if _, ok := r.slotToPendingBlocks[blk.Block.Slot]; !ok && len(r.slotToPendingBlocks) >= maxPendingBlocks {
+		highestSlot := uint64(0)
+		for s := range r.slotToPendingBlocks {
+			if s > highestSlot {
+				highestSlot = s
+			}
+		}
+		if blk.Block.Slot >= highestSlot {
+			pendingBlocksEvicted.Inc()
+			return
+		}
+		r.deletePendingSlot(highestSlot)
+		pendingBlocksEvicted.Inc()
+	}
+	if existing, ok := r.slotToPendingBlocks[blk.Block.Slot]; ok {
+		r.deletePendingSlot(existing.Block.Slot)
+	}
+
+	r.slotToPendingBlocks[blk.Block.Slot] = blk
+	r.seenPendingBlocks[root] = true
+	if source != "" {
+		r.pendingBlockSources[root] = source
+	}
+}
+
+// deletePendingSlot removes the pending block at the given slot. The caller must hold the pending
+// queue lock.
+func (r *Service) deletePendingSlot(slot uint64) {
+	blk, ok := r.slotToPendingBlocks[slot]
+	if !ok {
+		return
+	}
+	delete(r.slotToPendingBlocks, slot)
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		return
+	}
+	delete(r.seenPendingBlocks, root)
+	delete(r.pendingBlockSources, root)
+}
+
+// isPendingBlockRoot returns true if the block with the given root is in the pending queue.
+func (r *Service) isPendingBlockRoot(root [32]byte) bool {
+	r.pendingQueueLock.RLock()
+	defer r.pendingQueueLock.RUnlock()
+	return r.seenPendingBlocks[root]
+}
+
+// isBackfilling returns true if the block with the given root is being requested from a peer.
+func (r *Service) isBackfilling(root [32]byte) bool {
+	r.pendingQueueLock.RLock()
+	defer r.pendingQueueLock.RUnlock()
+	return r.backfillingRoots[root]
+}
+
+// setBackfilling marks the blocks with the given roots as being requested from a peer, or not.
+func (r *Service) setBackfilling(roots [][32]byte, backfilling bool) {
+	r.pendingQueueLock.Lock()
+	defer r.pendingQueueLock.Unlock()
+	if r.backfillingRoots == nil {
+		r.backfillingRoots = make(map[[32]byte]bool)
+	}
+	for _, root := range roots {
+		if backfilling {
+			r.backfillingRoots[root] = true
+		} else {
+			delete(r.backfillingRoots, root)
+		}
+	}
+}
+
+// setPendingBlockSource records the peer which gossiped the block with the given root.
+func (r *Service) setPendingBlockSource(root [32]byte, pid peer.ID) {
+	r.pendingQueueLock.Lock()
+	defer r.pendingQueueLock.Unlock()
+	r.pendingBlockSources[root] = pid
+}
+
+func isConnected(pids []peer.ID, pid peer.ID) bool {
+	for _, p := range pids {
+		if p == pid {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Service) sortedPendingSlots() []int {
 	r.pendingQueueLock.RLock()
 	defer r.pendingQueueLock.RUnlock()
@@ -164,6 +382,7 @@ func (r *Service) validatePendingSlots() error {
 			oldBlockRoots[root] = true
 			delete(r.slotToPendingBlocks, s)
 			delete(r.seenPendingBlocks, root)
+			delete(r.pendingBlockSources, root)
 			continue
 		}
 		// don't process old blocks
@@ -175,6 +394,13 @@ func (r *Service) validatePendingSlots() error {
 			oldBlockRoots[blkRoot] = true
 			delete(r.slotToPendingBlocks, s)
 			delete(r.seenPendingBlocks, blkRoot)
+			delete(r.pendingBlockSources, blkRoot)
+		}
+	}
+	// forget the gossip source of blocks which are no longer pending
+	for root := range r.pendingBlockSources {
+		if !r.seenPendingBlocks[root] {
+			delete(r.pendingBlockSources, root)
 		}
 	}
 	return nil
@@ -185,4 +411,5 @@ func (r *Service) clearPendingSlots() {
 	defer r.pendingQueueLock.Unlock()
 	r.slotToPendingBlocks = make(map[uint64]*ethpb.SignedBeaconBlock)
 	r.seenPendingBlocks = make(map[[32]byte]bool)
+	r.pendingBlockSources = make(map[[32]byte]peer.ID)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
@@ -162,6 +163,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
+	r.pendingBackfills.Wait()
 	if len(r.slotToPendingBlocks) != 2 {
 		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
 	}
@@ -178,6 +180,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
+	r.pendingBackfills.Wait()
 	if len(r.slotToPendingBlocks) != 1 {
 		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
 	}
@@ -195,6 +198,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
+	r.pendingBackfills.Wait()
 	if len(r.slotToPendingBlocks) != 0 {
 		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
 	}
@@ -261,6 +265,7 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
+	r.pendingBackfills.Wait()
 	if len(r.slotToPendingBlocks) != 0 {
 		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
 	}
@@ -268,3 +273,97 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
 	}
 }
+
+func TestInsertPendingBlock_EvictsHighestSlot(t *testing.T) {
+	r := &Service{
+		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
+		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
+	}
+	for i := uint64(1); i <= maxPendingBlocks; i++ {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: i}}
+		root, _ := ssz.HashTreeRoot(b.Block)
+		r.insertPendingBlock(b, root, "")
+	}
+	if len(r.slotToPendingBlocks) != maxPendingBlocks {
+		t.Fatalf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
+	}
+
+	// A block with a lower slot than all pending blocks evicts the highest slot.
+	low := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 0}}
+	lowRoot, _ := ssz.HashTreeRoot(low.Block)
+	r.insertPendingBlock(low, lowRoot, "a")
+	if _, ok := r.slotToPendingBlocks[maxPendingBlocks]; ok {
+		t.Error("Expected block with the highest slot to be evicted")
+	}
+	if !r.seenPendingBlocks[lowRoot] || r.pendingBlockSources[lowRoot] != "a" {
+		t.Error("Expected block with the lowest slot to be inserted")
+	}
+
+	// A block with a higher slot than all pending blocks is dropped.
+	high := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1000}}
+	highRoot, _ := ssz.HashTreeRoot(high.Block)
+	r.insertPendingBlock(high, highRoot, "")
+	if r.seenPendingBlocks[highRoot] {
+		t.Error("Expected block with a higher slot than the full queue to be dropped")
+	}
+	if len(r.slotToPendingBlocks) != maxPendingBlocks || len(r.seenPendingBlocks) != maxPendingBlocks {
+		t.Errorf("Incorrect size for pending queue: got %d slots and %d roots", len(r.slotToPendingBlocks), len(r.seenPendingBlocks))
+	}
+}
+
+func TestPeerForBlock(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	r := &Service{p2p: p1}
+	root := [32]byte{'a'}
+	for _, p := range []peer.ID{"behind", "ahead", "head"} {
+		p1.Peers().Add(p, nil, network.DirOutbound)
+		p1.Peers().SetConnectionState(p, peers.PeerConnected)
+	}
+	p1.Peers().SetChainState("behind", &pb.Status{HeadSlot: 5, HeadRoot: make([]byte, 32)})
+	p1.Peers().SetChainState("ahead", &pb.Status{HeadSlot: 20, HeadRoot: make([]byte, 32)})
+	p1.Peers().SetChainState("head", &pb.Status{HeadSlot: 10, HeadRoot: root[:]})
+
+	pids := []peer.ID{"behind", "ahead", "head"}
+	if pid := r.peerForBlock(pids, root, 10); pid != "head" {
+		t.Errorf("Expected peer whose head is the block, received %s", pid)
+	}
+	if pid := r.peerForBlock(pids, [32]byte{'b'}, 15); pid != "ahead" {
+		t.Errorf("Expected peer whose head slot is past the block, received %s", pid)
+	}
+}
+
+func TestPenalizeUnbackedGossip(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	p1 := p2ptest.NewTestP2P(t)
+	source := peer.ID("source")
+	p1.Peers().Add(source, nil, network.DirInbound)
+
+	childRoot := [32]byte{'c'}
+	r := &Service{
+		p2p:                 p1,
+		db:                  db,
+		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
+		seenPendingBlocks:   map[[32]byte]bool{childRoot: true},
+		pendingBlockSources: map[[32]byte]peer.ID{childRoot: source},
+	}
+	parentRoot := [32]byte{'p'}
+	servedRoot := [32]byte{'s'}
+	gossipedChildren := map[[32]byte][32]byte{parentRoot: childRoot, servedRoot: {'d'}}
+	// The peer answered with the block of one of the roots only. The served block may have been
+	// evicted from the pending queue since.
+	served := map[[32]byte]bool{servedRoot: true}
+	r.penalizeUnbackedGossip(context.Background(), source, [][32]byte{parentRoot, servedRoot}, served, gossipedChildren)
+
+	badResponses, err := p1.Peers().BadResponses(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if badResponses != 1 {
+		t.Errorf("Wanted 1 bad response, received %d", badResponses)
+	}
+	if _, ok := r.pendingBlockSources[childRoot]; ok {
+		t.Error("Expected gossip source of the child block to be forgotten")
+	}
+}
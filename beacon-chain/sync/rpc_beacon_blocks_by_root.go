@@ -8,19 +8,33 @@ import (
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 )
 
 // sendRecentBeaconBlocksRequest sends a recent beacon blocks request to a peer to get
 // those corresponding blocks from that peer.
 func (r *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots [][32]byte, id peer.ID) error {
+	_, err := r.requestBlocksByRoot(ctx, blockRoots, id)
+	return err
+}
+
+// requestBlocksByRoot requests the blocks with the given roots from a peer, adds them to the
+// pending queue and returns them. Blocks which were not requested are ignored.
+func (r *Service) requestBlocksByRoot(ctx context.Context, blockRoots [][32]byte, id peer.ID) ([]*ethpb.SignedBeaconBlock, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	requested := make(map[[32]byte]bool, len(blockRoots))
+	for _, root := range blockRoots {
+		requested[root] = true
+	}
+
 	stream, err := r.p2p.Send(ctx, blockRoots, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	blks := make([]*ethpb.SignedBeaconBlock, 0, len(blockRoots))
 	for i := 0; i < len(blockRoots); i++ {
 		blk, err := ReadChunkedBlock(stream, r.p2p)
 		if err == io.EOF {
@@ -28,20 +42,21 @@ func (r *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 		}
 		if err != nil {
 			log.WithError(err).Error("Unable to retrieve block from stream")
-			return err
+			return nil, err
 		}
 
 		blkRoot, err := ssz.HashTreeRoot(blk.Block)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		r.pendingQueueLock.Lock()
-		r.slotToPendingBlocks[blk.Block.Slot] = blk
-		r.seenPendingBlocks[blkRoot] = true
-		r.pendingQueueLock.Unlock()
-
+		if !requested[blkRoot] {
+			log.WithField("peer", id.Pretty()).Debug("Ignoring block which was not requested")
+			continue
+		}
+		r.insertPendingBlock(blk, blkRoot, "")
+		blks = append(blks, blk)
 	}
-	return nil
+	return blks, nil
 }
 
 // beaconBlocksRootRPCHandler looks up the request blocks from the database from the given block roots.
@@ -4,11 +4,14 @@ import (
 	"context"
 	"sync"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -16,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 )
 
 var _ = shared.Service(&Service{})
@@ -23,16 +27,25 @@ var _ = shared.Service(&Service{})
 const allowedBlocksPerSecond = 32.0
 const allowedBlocksBurst = 10 * allowedBlocksPerSecond
 
+// Sizes of the per topic caches used to reject duplicate gossip objects before any
+// expensive verification is done.
+const seenBlockSize = 1000
+const seenAttSize = 10000
+const seenAggregatedAttSize = 1024
+const seenExitSize = 100
+const seenProposerSlashingSize = 100
+
 // Config to set up the regular sync service.
 type Config struct {
-	P2P           p2p.P2P
-	DB            db.NoHeadAccessDatabase
-	AttPool       attestations.Pool
-	ExitPool      *voluntaryexits.Pool
-	Chain         blockchainService
-	InitialSync   Checker
-	StateNotifier statefeed.Notifier
-	BlockNotifier blockfeed.Notifier
+	P2P               p2p.P2P
+	DB                db.NoHeadAccessDatabase
+	AttPool           attestations.Pool
+	ExitPool          *voluntaryexits.Pool
+	Chain             blockchainService
+	InitialSync       Checker
+	StateNotifier     statefeed.Notifier
+	BlockNotifier     blockfeed.Notifier
+	OperationNotifier opfeed.Notifier
 }
 
 // This defines the interface for interacting with block chain service
@@ -59,10 +72,19 @@ func NewRegularSync(cfg *Config) *Service {
 		initialSync:          cfg.InitialSync,
 		slotToPendingBlocks:  make(map[uint64]*ethpb.SignedBeaconBlock),
 		seenPendingBlocks:    make(map[[32]byte]bool),
+		pendingBlockSources:  make(map[[32]byte]peer.ID),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
 		stateNotifier:        cfg.StateNotifier,
 		blockNotifier:        cfg.BlockNotifier,
+		operationNotifier:    cfg.OperationNotifier,
 		blocksRateLimiter:    leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+
+		seenBlockCache:                 newSeenCache(seenBlockSize),
+		seenAttestationCache:           newSeenCache(seenAttSize),
+		seenAggregatedAttestationCache: newSeenCache(seenAggregatedAttSize),
+		seenExitCache:                  newSeenCache(seenExitSize),
+		seenProposerSlashingCache:      newSeenCache(seenProposerSlashingSize),
+		signatureChan:                  make(chan *signatureVerifier, verifierLimit),
 	}
 
 	r.registerRPCHandlers()
@@ -83,6 +105,9 @@ type Service struct {
 	chain                blockchainService
 	slotToPendingBlocks  map[uint64]*ethpb.SignedBeaconBlock
 	seenPendingBlocks    map[[32]byte]bool
+	pendingBlockSources  map[[32]byte]peer.ID
+	backfillingRoots     map[[32]byte]bool
+	pendingBackfills     sync.WaitGroup
 	blkRootToPendingAtts map[[32]byte][]*ethpb.AggregateAttestationAndProof
 	pendingAttsLock      sync.RWMutex
 	pendingQueueLock     sync.RWMutex
@@ -91,13 +116,24 @@ type Service struct {
 	validateBlockLock    sync.RWMutex
 	stateNotifier        statefeed.Notifier
 	blockNotifier        blockfeed.Notifier
+	operationNotifier    opfeed.Notifier
 	blocksRateLimiter    *leakybucket.Collector
+
+	seenBlockCache                 *lru.Cache
+	seenAttestationCache           *lru.Cache
+	seenAggregatedAttestationCache *lru.Cache
+	seenExitCache                  *lru.Cache
+	seenProposerSlashingCache      *lru.Cache
+	signatureChan                  chan *signatureVerifier
 }
 
 // Start the regular sync service.
 func (r *Service) Start() {
 	r.p2p.AddConnectionHandler(r.sendRPCStatusRequest)
 	r.p2p.AddDisconnectionHandler(r.removeDisconnectedPeerStatus)
+	if featureconfig.Get().EnableBatchVerification {
+		go r.verifierRoutine()
+	}
 	r.processPendingBlocksQueue()
 	r.processPendingAttsQueue()
 	r.maintainPeerStatuses()
@@ -126,6 +162,16 @@ func (r *Service) Status() error {
 	return nil
 }
 
+// newSeenCache initializes a LRU cache of the given size used to track gossip objects that have
+// already been validated.
+func newSeenCache(size int) *lru.Cache {
+	c, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
 // Checker defines a struct which can verify whether a node is currently
 // synchronizing a chain with the rest of peers in the network.
 type Checker interface {
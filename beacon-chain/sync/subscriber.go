@@ -175,15 +175,23 @@ func (r *Service) subscribeWithBase(base proto.Message, topic string, validator
 }
 
 // Wrap the pubsub validator with a metric monitoring function. This function increments the
-// appropriate counter if the particular message fails to validate.
+// appropriate counter if the particular message fails to validate, and bounds the validation by
+// the deadline of the topic.
 func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.Validator) {
+	deadline := validationDeadline(topic)
 	return topic, func(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
 		defer messagehandler.HandlePanic(ctx, msg)
-		ctx, _ = context.WithTimeout(ctx, pubsubMessageTimeout)
+		ctx, cancel := context.WithTimeout(ctx, deadline)
+		defer cancel()
 		messageReceivedCounter.WithLabelValues(topic).Inc()
+		start := time.Now()
 		b := v(ctx, pid, msg)
+		messageValidationLatency.WithLabelValues(topic).Observe(time.Since(start).Seconds())
 		if !b {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
+			if ctx.Err() == context.DeadlineExceeded {
+				messageValidationTimeoutCounter.WithLabelValues(topic).Inc()
+			}
 		}
 		return b
 	}
@@ -7,12 +7,14 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 )
 
 func TestBeaconAggregateProofSubscriber_CanSave(t *testing.T) {
 	r := &Service{
-		attPool: attestations.NewPool(),
+		attPool:           attestations.NewPool(),
+		operationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 
 	a := &ethpb.AggregateAttestationAndProof{Aggregate: &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0x07}}, AggregatorIndex: 100}
@@ -47,10 +47,7 @@ func (r *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 
 	// Handle block when the parent is unknown
 	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(block.ParentRoot)) {
-		r.pendingQueueLock.Lock()
-		r.slotToPendingBlocks[block.Slot] = signed
-		r.seenPendingBlocks[blockRoot] = true
-		r.pendingQueueLock.Unlock()
+		r.insertPendingBlock(signed, blockRoot, "")
 		return nil
 	}
 
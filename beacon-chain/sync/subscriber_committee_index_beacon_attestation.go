@@ -6,6 +6,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 )
 
@@ -19,6 +21,13 @@ func (r *Service) committeeIndexBeaconAttestationSubscriber(ctx context.Context,
 		return nil
 	}
 
+	r.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{
+			Attestation: a,
+		},
+	})
+
 	return r.attPool.SaveUnaggregatedAttestation(a)
 }
 
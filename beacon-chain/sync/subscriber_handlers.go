@@ -5,6 +5,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
 func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message) error {
@@ -12,7 +14,14 @@ func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message
 	if err != nil {
 		return err
 	}
-	r.exitPool.InsertVoluntaryExit(ctx, s, msg.(*ethpb.SignedVoluntaryExit))
+	exit := msg.(*ethpb.SignedVoluntaryExit)
+	r.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: exit,
+		},
+	})
+	r.exitPool.InsertVoluntaryExit(ctx, s, exit)
 	return nil
 }
 
@@ -50,6 +50,12 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return false
 	}
 
+	// Verify this is the first aggregate received from the aggregator with index and slot.
+	if r.hasSeenAggregatorIndexSlot(m.Aggregate.Data.Slot, m.AggregatorIndex) {
+		messageDuplicateCounter.WithLabelValues(msg.TopicIDs[0]).Inc()
+		return false
+	}
+
 	// Verify aggregate attestation has not already been seen via aggregate gossip, within a block, or through the creation locally.
 	seen, err := r.attPool.HasAggregatedAttestation(m.Aggregate)
 	if err != nil {
@@ -67,10 +73,14 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return false
 	}
 
-	if !featureconfig.Get().DisableStrictAttestationPubsubVerification && !r.chain.IsValidAttestation(ctx, m.Aggregate) {
+	// With batch verification the aggregate signature has already been verified against its pre-state.
+	if !featureconfig.Get().EnableBatchVerification &&
+		!featureconfig.Get().DisableStrictAttestationPubsubVerification && !r.chain.IsValidAttestation(ctx, m.Aggregate) {
 		return false
 	}
 
+	r.setAggregatorIndexSlotSeen(m.Aggregate.Data.Slot, m.AggregatorIndex)
+
 	msg.ValidatorData = m
 
 	return true
@@ -111,6 +121,10 @@ func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateA
 		return false
 	}
 
+	if featureconfig.Get().EnableBatchVerification {
+		return r.validateAggregatedAttWithBatchVerifier(ctx, s, a)
+	}
+
 	// Verify selection proof reflects to the right validator and signature is valid.
 	if err := validateSelection(ctx, s, a.Aggregate.Data, a.AggregatorIndex, a.SelectionProof); err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", a.AggregatorIndex))
@@ -126,6 +140,25 @@ func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateA
 	return true
 }
 
+// Verifies the selection proof and the aggregate signature together in the batch verifier. The aggregate
+// signature is computed against the attestation's pre-state, as done by the chain service.
+func (r *Service) validateAggregatedAttWithBatchVerifier(ctx context.Context, s *stateTrie.BeaconState, a *ethpb.AggregateAttestationAndProof) bool {
+	ctx, span := trace.StartSpan(ctx, "sync.validateAggregatedAttWithBatchVerifier")
+	defer span.End()
+
+	selectionSet, err := selectionSignatureSet(ctx, s, a.Aggregate.Data, a.AggregatorIndex, a.SelectionProof)
+	if err != nil {
+		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", a.AggregatorIndex))
+		return false
+	}
+	attSet, err := r.chain.AttestationSignatureSet(ctx, a.Aggregate)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return false
+	}
+	return r.validateWithBatchVerifier(ctx, "aggregate", selectionSet.Join(attSet))
+}
+
 func (r *Service) validateBlockInAttestation(ctx context.Context, a *ethpb.AggregateAttestationAndProof) bool {
 	// Verify the block being voted is in DB. The block should have passed validation if it's in the DB.
 	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(a.Aggregate.Data.BeaconBlockRoot)) {
@@ -166,38 +199,64 @@ func validateIndexInCommittee(ctx context.Context, s *stateTrie.BeaconState, a *
 // This validates selection proof by validating it's from the correct validator index of the slot and selection
 // proof is a valid signature.
 func validateSelection(ctx context.Context, s *stateTrie.BeaconState, data *ethpb.AttestationData, validatorIndex uint64, proof []byte) error {
-	_, span := trace.StartSpan(ctx, "sync.validateSelection")
+	ctx, span := trace.StartSpan(ctx, "sync.validateSelection")
 	defer span.End()
 
-	committee, err := helpers.BeaconCommitteeFromState(s, data.Slot, data.CommitteeIndex)
+	set, err := selectionSignatureSet(ctx, s, data, validatorIndex, proof)
 	if err != nil {
 		return err
 	}
+	if !set.Signatures[0].Verify(set.Messages[0][:], set.PublicKeys[0], set.Domains[0]) {
+		return errors.New("could not validate slot signature")
+	}
+
+	return nil
+}
+
+// This validates the selection proof makes the validator an aggregator of the slot and returns the
+// signature set of the proof without verifying it.
+func selectionSignatureSet(ctx context.Context, s *stateTrie.BeaconState, data *ethpb.AttestationData, validatorIndex uint64, proof []byte) (*bls.SignatureSet, error) {
+	_, span := trace.StartSpan(ctx, "sync.selectionSignatureSet")
+	defer span.End()
+
+	committee, err := helpers.BeaconCommitteeFromState(s, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
 	aggregator, err := helpers.IsAggregator(uint64(len(committee)), proof)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !aggregator {
-		return fmt.Errorf("validator is not an aggregator for slot %d", data.Slot)
+		return nil, fmt.Errorf("validator is not an aggregator for slot %d", data.Slot)
 	}
 
 	domain := helpers.Domain(s.Fork(), helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainBeaconAttester)
 	slotMsg, err := ssz.HashTreeRoot(data.Slot)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pubkeyState := s.PubkeyAtIndex(validatorIndex)
 	pubKey, err := bls.PublicKeyFromBytes(pubkeyState[:])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	slotSig, err := bls.SignatureFromBytes(proof)
 	if err != nil {
-		return err
-	}
-	if !slotSig.Verify(slotMsg[:], pubKey, domain) {
-		return errors.New("could not validate slot signature")
+		return nil, err
 	}
+	return bls.NewSet().Add(slotSig, pubKey, slotMsg, domain), nil
+}
 
-	return nil
+// Returns true if the node has received an aggregate from the aggregator index for the slot.
+func (r *Service) hasSeenAggregatorIndexSlot(slot uint64, aggregatorIndex uint64) bool {
+	b := append(bytesutil.Bytes8(slot), bytesutil.Bytes8(aggregatorIndex)...)
+	_, seen := r.seenAggregatedAttestationCache.Get(string(b))
+	return seen
+}
+
+// Set the aggregator index and slot as seen.
+func (r *Service) setAggregatorIndexSlotSeen(slot uint64, aggregatorIndex uint64) {
+	b := append(bytesutil.Bytes8(slot), bytesutil.Bytes8(aggregatorIndex)...)
+	r.seenAggregatedAttestationCache.Add(string(b), true)
 }
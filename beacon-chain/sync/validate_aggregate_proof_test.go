@@ -128,11 +128,12 @@ func TestValidateAggregateAndProof_NoBlock(t *testing.T) {
 	}
 
 	r := &Service{
-		p2p:                  p,
-		db:                   db,
-		initialSync:          &mockSync.Sync{IsSyncing: false},
-		attPool:              attestations.NewPool(),
-		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
+		p2p:                            p,
+		db:                             db,
+		initialSync:                    &mockSync.Sync{IsSyncing: false},
+		attPool:                        attestations.NewPool(),
+		blkRootToPendingAtts:           make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
+		seenAggregatedAttestationCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -191,7 +192,8 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
 			State: beaconState},
-		attPool: attestations.NewPool(),
+		attPool:                        attestations.NewPool(),
+		seenAggregatedAttestationCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -270,6 +272,7 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
 			State: beaconState},
+		seenAggregatedAttestationCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -362,7 +365,8 @@ func TestValidateAggregateAndProof_CanValidate(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
-		attPool: attestations.NewPool(),
+		attPool:                        attestations.NewPool(),
+		seenAggregatedAttestationCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -387,3 +391,108 @@ func TestValidateAggregateAndProof_CanValidate(t *testing.T) {
 		t.Error("Did not set validator data")
 	}
 }
+
+func TestValidateAggregateAndProof_RejectsSecondAggregateOfAggregator(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	p := p2ptest.NewTestP2P(t)
+
+	validators := uint64(256)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, validators)
+
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{ParentRoot: testutil.Random32Bytes(t)}}
+	db.SaveBlock(context.Background(), b)
+	root, _ := ssz.HashTreeRoot(b.Block)
+
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: root[:],
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: []byte("hello-world")},
+		Target:          &ethpb.Checkpoint{Epoch: 0, Root: []byte("hello-world")},
+	}
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashTreeRoot, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainBeaconAttester)
+	slotRoot, err := ssz.HashTreeRoot(data.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selectionProof := privKeys[154].Sign(slotRoot[:], domain).Marshal()
+
+	// aggregate returns a valid aggregate of the validator with index 154 covering the first
+	// attesters of the committee.
+	aggregate := func(attesters uint64) *ethpb.AggregateAttestationAndProof {
+		aggBits := bitfield.NewBitlist(uint64(len(committee)))
+		for i := uint64(0); i < attesters; i++ {
+			aggBits.SetBitAt(i, true)
+		}
+		attestingIndices, err := attestationutil.AttestingIndices(aggBits, committee)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigs := make([]*bls.Signature, len(attestingIndices))
+		for i, indice := range attestingIndices {
+			sigs[i] = privKeys[indice].Sign(hashTreeRoot[:], domain)
+		}
+		return &ethpb.AggregateAttestationAndProof{
+			SelectionProof: selectionProof,
+			Aggregate: &ethpb.Attestation{
+				Data:            data,
+				AggregationBits: aggBits,
+				Signature:       bls.AggregateSignatures(sigs).Marshal(),
+			},
+			AggregatorIndex: 154,
+		}
+	}
+	encode := func(a *ethpb.AggregateAttestationAndProof) *pubsub.Message {
+		buf := new(bytes.Buffer)
+		if _, err := p.Encoding().Encode(buf, a); err != nil {
+			t.Fatal(err)
+		}
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data: buf.Bytes(),
+				TopicIDs: []string{
+					p2p.GossipTypeMapping[reflect.TypeOf(a)],
+				},
+			},
+		}
+	}
+
+	if err := beaconState.SetGenesisTime(uint64(time.Now().Unix())); err != nil {
+		t.Fatal(err)
+	}
+	newService := func() *Service {
+		return &Service{
+			p2p:         p,
+			db:          db,
+			initialSync: &mockSync.Sync{IsSyncing: false},
+			chain: &mock.ChainService{Genesis: time.Now(),
+				State:            beaconState,
+				ValidAttestation: true,
+				FinalizedCheckPoint: &ethpb.Checkpoint{
+					Epoch: 0,
+				}},
+			attPool:                        attestations.NewPool(),
+			seenAggregatedAttestationCache: newSeenCache(10),
+		}
+	}
+	r := newService()
+	if !r.validateAggregateAndProof(context.Background(), "", encode(aggregate(1))) {
+		t.Fatal("Validated status is false")
+	}
+
+	// Another valid aggregate of the aggregator for the same slot is only accepted by a node
+	// which has not seen the first one.
+	if !newService().validateAggregateAndProof(context.Background(), "", encode(aggregate(2))) {
+		t.Fatal("Expected the second aggregate to be valid")
+	}
+	if r.validateAggregateAndProof(context.Background(), "", encode(aggregate(2))) {
+		t.Error("Expected the second aggregate of the aggregator for the slot to be rejected")
+	}
+}
@@ -5,10 +5,15 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -51,6 +56,12 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return false
 	}
 
+	// Blocks that have already been imported are rejected before any further work.
+	if r.db.HasBlock(ctx, blockRoot) {
+		messageDuplicateCounter.WithLabelValues(msg.TopicIDs[0]).Inc()
+		return false
+	}
+
 	r.pendingQueueLock.RLock()
 	if r.seenPendingBlocks[blockRoot] {
 		r.pendingQueueLock.RUnlock()
@@ -68,10 +79,98 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return false
 	}
 
-	if _, err = bls.SignatureFromBytes(blk.Signature); err != nil {
+	// Only the first block of the proposer of a slot is propagated, and only once its signature
+	// is known to be from that proposer, so that a forged block can not shadow the real one.
+	proposerIndex, err := r.verifyBlockProposer(ctx, blk)
+	if err != nil {
+		log.WithError(err).WithField("blockSlot", blk.Block.Slot).Debug("Could not verify block proposer")
+		traceutil.AnnotateError(span, err)
 		return false
 	}
+	if r.hasSeenBlockIndexSlot(blk.Block.Slot, proposerIndex) {
+		messageDuplicateCounter.WithLabelValues(msg.TopicIDs[0]).Inc()
+		return false
+	}
+
+	// Remember who gossiped a block with an unknown parent, as that peer is expected to back-fill it.
+	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot)) {
+		r.setPendingBlockSource(blockRoot, pid)
+	}
+
+	r.setSeenBlockIndexSlot(blk.Block.Slot, proposerIndex)
 
 	msg.ValidatorData = blk // Used in downstream subscriber
 	return true
 }
+
+// verifyBlockProposer checks the block signature against the key of the validator expected to
+// propose at the block slot, and returns the index of that validator. Blocks in this version of
+// the spec do not carry their proposer index, so it is computed from the head state, advanced to
+// the epoch of the block when needed.
+func (r *Service) verifyBlockProposer(ctx context.Context, blk *ethpb.SignedBeaconBlock) (uint64, error) {
+	sig, err := bls.SignatureFromBytes(blk.Signature)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not deserialize block signature")
+	}
+	s, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if s == nil {
+		return 0, errors.New("no head state")
+	}
+	slot := blk.Block.Slot
+	epoch := helpers.SlotToEpoch(slot)
+	// Only advance state if different epoch as the proposer seed and the active validators can
+	// only change on an epoch transition.
+	if epoch > helpers.SlotToEpoch(s.Slot()) {
+		s, err = state.ProcessSlots(ctx, s, helpers.StartSlot(epoch))
+		if err != nil {
+			return 0, errors.Wrap(err, "could not advance head state")
+		}
+	}
+	seed, err := helpers.Seed(s, epoch, params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not generate seed")
+	}
+	indices, err := helpers.ActiveValidatorIndices(s, epoch)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get active indices")
+	}
+	seedWithSlot := hashutil.Hash(append(seed[:], bytesutil.Bytes8(slot)...))
+	proposerIndex, err := helpers.ComputeProposerIndex(s.Validators(), indices, seedWithSlot)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute proposer index")
+	}
+	proposer, err := s.ValidatorAtIndex(proposerIndex)
+	if err != nil {
+		return 0, err
+	}
+	pub, err := bls.PublicKeyFromBytes(proposer.PublicKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not deserialize proposer public key")
+	}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		return 0, err
+	}
+	domain := helpers.Domain(s.Fork(), epoch, params.BeaconConfig().DomainBeaconProposer)
+	if !sig.Verify(root[:], pub, domain) {
+		return 0, errors.New("block signature is not from the expected proposer")
+	}
+	return proposerIndex, nil
+}
+
+// Returns true if a block of the proposer with the given index has already passed gossip
+// validation for the slot.
+func (r *Service) hasSeenBlockIndexSlot(slot uint64, proposerIndex uint64) bool {
+	b := append(bytesutil.Bytes8(slot), bytesutil.Bytes8(proposerIndex)...)
+	_, seen := r.seenBlockCache.Get(string(b))
+	return seen
+}
+
+// Set the proposer index and slot as seen.
+func (r *Service) setSeenBlockIndexSlot(slot uint64, proposerIndex uint64) {
+	b := append(bytesutil.Bytes8(slot), bytesutil.Bytes8(proposerIndex)...)
+	r.seenBlockCache.Add(string(b), true)
+}
@@ -7,16 +7,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
@@ -46,6 +51,8 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
 	}
 
 	buf := new(bytes.Buffer)
@@ -84,10 +91,12 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 	}
 
 	r := &Service{
-		db:          db,
-		p2p:         p,
-		initialSync: &mockSync.Sync{IsSyncing: false},
-		chain:       &mock.ChainService{Genesis: time.Now()},
+		db:                  db,
+		p2p:                 p,
+		initialSync:         &mockSync.Sync{IsSyncing: false},
+		chain:               &mock.ChainService{Genesis: time.Now()},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
 	}
 
 	buf := new(bytes.Buffer)
@@ -115,27 +124,22 @@ func TestValidateBeaconBlockPubSub_ValidSignature(t *testing.T) {
 	defer dbtest.TeardownDB(t, db)
 	p := p2ptest.NewTestP2P(t)
 	ctx := context.Background()
-	b := []byte("sk")
-	b32 := bytesutil.ToBytes32(b)
-	sk, err := bls.SecretKeyFromBytes(b32[:])
-	if err != nil {
-		t.Fatal(err)
-	}
-	msg := &ethpb.SignedBeaconBlock{
-		Block: &ethpb.BeaconBlock{
-			ParentRoot: testutil.Random32Bytes(t),
-		},
-		Signature: sk.Sign([]byte("data"), 0).Marshal(),
-	}
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	msg := signedProposerBlock(t, beaconState, privKeys, &ethpb.BeaconBlock{
+		ParentRoot: testutil.Random32Bytes(t),
+	})
 
 	r := &Service{
 		db:          db,
 		p2p:         p,
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
+			State: beaconState,
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
 	}
 
 	buf := new(bytes.Buffer)
@@ -160,6 +164,137 @@ func TestValidateBeaconBlockPubSub_ValidSignature(t *testing.T) {
 	}
 }
 
+func TestValidateBeaconBlockPubSub_RejectsSecondBlockOfProposer(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	newService := func() *Service {
+		return &Service{
+			db:          db,
+			p2p:         p,
+			initialSync: &mockSync.Sync{IsSyncing: false},
+			chain: &mock.ChainService{Genesis: time.Now(),
+				State: beaconState,
+				FinalizedCheckPoint: &ethpb.Checkpoint{
+					Epoch: 0,
+				}},
+			seenBlockCache:      newSeenCache(10),
+			pendingBlockSources: make(map[[32]byte]peer.ID),
+		}
+	}
+	message := func() *pubsub.Message {
+		msg := signedProposerBlock(t, beaconState, privKeys, &ethpb.BeaconBlock{
+			ParentRoot: testutil.Random32Bytes(t),
+		})
+		buf := new(bytes.Buffer)
+		if _, err := p.Encoding().Encode(buf, msg); err != nil {
+			t.Fatal(err)
+		}
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data: buf.Bytes(),
+				TopicIDs: []string{
+					p2p.GossipTypeMapping[reflect.TypeOf(msg)],
+				},
+			},
+		}
+	}
+
+	r := newService()
+	if !r.validateBeaconBlockPubSub(ctx, "", message()) {
+		t.Fatal("Expected the first block of the slot to be valid")
+	}
+	// Another block of the proposer for the same slot is only accepted by a node which has not
+	// seen the first one.
+	second := message()
+	if !newService().validateBeaconBlockPubSub(ctx, "", second) {
+		t.Fatal("Expected the second block to be valid")
+	}
+	if r.validateBeaconBlockPubSub(ctx, "", second) {
+		t.Error("Expected the second block of the proposer for the slot to be rejected")
+	}
+}
+
+func TestValidateBeaconBlockPubSub_ForgedBlockDoesNotShadowProposer(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	r := &Service{
+		db:          db,
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: false},
+		chain: &mock.ChainService{Genesis: time.Now(),
+			State: beaconState,
+			FinalizedCheckPoint: &ethpb.Checkpoint{
+				Epoch: 0,
+			}},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
+	}
+	message := func(msg *ethpb.SignedBeaconBlock) *pubsub.Message {
+		buf := new(bytes.Buffer)
+		if _, err := p.Encoding().Encode(buf, msg); err != nil {
+			t.Fatal(err)
+		}
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data: buf.Bytes(),
+				TopicIDs: []string{
+					p2p.GossipTypeMapping[reflect.TypeOf(msg)],
+				},
+			},
+		}
+	}
+
+	b32 := bytesutil.ToBytes32([]byte("sk"))
+	sk, err := bls.SecretKeyFromBytes(b32[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			ParentRoot: testutil.Random32Bytes(t),
+		},
+		Signature: sk.Sign([]byte("data"), 0).Marshal(),
+	}
+	if r.validateBeaconBlockPubSub(ctx, "", message(forged)) {
+		t.Fatal("Expected the block signed by another key to be rejected")
+	}
+	valid := signedProposerBlock(t, beaconState, privKeys, &ethpb.BeaconBlock{
+		ParentRoot: testutil.Random32Bytes(t),
+	})
+	if !r.validateBeaconBlockPubSub(ctx, "", message(valid)) {
+		t.Error("Expected the block of the proposer to be valid after a forged block for its slot")
+	}
+}
+
+// signedProposerBlock signs the block with the key of the validator expected to propose at its
+// slot in the given state.
+func signedProposerBlock(t *testing.T, beaconState *stateTrie.BeaconState, privKeys []*bls.SecretKey, blk *ethpb.BeaconBlock) *ethpb.SignedBeaconBlock {
+	s := beaconState.Copy()
+	if err := s.SetSlot(blk.Slot); err != nil {
+		t.Fatal(err)
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(s.Fork(), helpers.SlotToEpoch(blk.Slot), params.BeaconConfig().DomainBeaconProposer)
+	return &ethpb.SignedBeaconBlock{
+		Block:     blk,
+		Signature: privKeys[proposerIndex].Sign(root[:], domain).Marshal(),
+	}
+}
+
 func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 	db := dbtest.SetupDB(t)
 	defer dbtest.TeardownDB(t, db)
@@ -187,6 +322,8 @@ func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			}},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
 	}
 
 	buf := new(bytes.Buffer)
@@ -227,10 +364,12 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 	}
 
 	r := &Service{
-		p2p:         p,
-		db:          db,
-		initialSync: &mockSync.Sync{IsSyncing: false},
-		chain:       &mock.ChainService{Genesis: time.Now()},
+		p2p:                 p,
+		db:                  db,
+		initialSync:         &mockSync.Sync{IsSyncing: false},
+		chain:               &mock.ChainService{Genesis: time.Now()},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
 	}
 
 	buf := new(bytes.Buffer)
@@ -280,6 +419,8 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 1,
 			}},
+		seenBlockCache:      newSeenCache(10),
+		pendingBlockSources: make(map[[32]byte]peer.ID),
 	}
 
 	buf := new(bytes.Buffer)
@@ -65,6 +65,13 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return false
 	}
 
+	// Verify this is the first attestation received for the participating validator of the slot.
+	// As the attestation is unaggregated, its aggregation bits identify the validator within the committee.
+	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
+		messageDuplicateCounter.WithLabelValues(originalTopic).Inc()
+		return false
+	}
+
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE.
 	currentSlot := helpers.SlotsSince(s.chain.GenesisTime())
 	upper := att.Data.Slot + params.BeaconConfig().AttestationPropagationSlotRange
@@ -81,11 +88,39 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	// Attestation's signature is a valid BLS signature and belongs to correct public key..
-	if !featureconfig.Get().DisableStrictAttestationPubsubVerification && !s.chain.IsValidAttestation(ctx, att) {
-		return false
+	if !featureconfig.Get().DisableStrictAttestationPubsubVerification {
+		if featureconfig.Get().EnableBatchVerification {
+			set, err := s.chain.AttestationSignatureSet(ctx, att)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return false
+			}
+			if !s.validateWithBatchVerifier(ctx, "attestation", set) {
+				return false
+			}
+		} else if !s.chain.IsValidAttestation(ctx, att) {
+			return false
+		}
 	}
 
+	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
+
 	msg.ValidatorData = att
 
 	return true
 }
+
+// Returns true if the attestation with the same slot, committee index and aggregation bits was already seen.
+func (s *Service) hasSeenCommitteeIndicesSlot(slot uint64, committeeID uint64, aggregateBits []byte) bool {
+	b := append(bytesutil.Bytes8(slot), bytesutil.Bytes8(committeeID)...)
+	b = append(b, aggregateBits...)
+	_, seen := s.seenAttestationCache.Get(string(b))
+	return seen
+}
+
+// Set the attestation with slot, committee index and aggregation bits as seen.
+func (s *Service) setSeenCommitteeIndicesSlot(slot uint64, committeeID uint64, aggregateBits []byte) {
+	b := append(bytesutil.Bytes8(slot), bytesutil.Bytes8(committeeID)...)
+	b = append(b, aggregateBits...)
+	s.seenAttestationCache.Add(string(b), true)
+}
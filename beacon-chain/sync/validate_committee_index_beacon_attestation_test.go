@@ -12,10 +12,13 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
@@ -142,6 +145,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				},
 			}
 			chain.ValidAttestation = tt.validAttestationSignature
+			s.seenAttestationCache = newSeenCache(10)
 			if s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, m) != tt.want {
 				t.Errorf("Did not received wanted validation. Got %v, wanted %v", !tt.want, tt.want)
 			}
@@ -151,3 +155,143 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 		})
 	}
 }
+
+func TestService_validateCommitteeIndexBeaconAttestation_RejectsDuplicate(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	chain := &mockChain.ChainService{
+		Genesis:          time.Now().Add(time.Duration(-64*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second), // 64 slots ago
+		ValidAttestation: true,
+	}
+	s := &Service{
+		initialSync:          &mockSync.Sync{IsSyncing: false},
+		p2p:                  p,
+		db:                   db,
+		chain:                chain,
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
+		seenAttestationCache: newSeenCache(10),
+	}
+
+	blk := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot: 55,
+		},
+	}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	validBlockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1010},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: validBlockRoot[:],
+			CommitteeIndex:  1,
+			Slot:            63,
+		},
+	}
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().Encode(buf, att); err != nil {
+		t.Fatal(err)
+	}
+	newMsg := func() *pubsub.Message {
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data:     buf.Bytes(),
+				TopicIDs: []string{"/eth2/committee_index1_beacon_attestation"},
+			},
+		}
+	}
+
+	if !s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, newMsg()) {
+		t.Fatal("Expected first attestation to pass validation")
+	}
+	if s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, newMsg()) {
+		t.Error("Expected duplicate attestation to be rejected")
+	}
+}
+
+func TestService_validateCommitteeIndexBeaconAttestation_BatchVerification(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{EnableBatchVerification: true})
+	defer featureconfig.Init(&featureconfig.Flags{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 256)
+	s := &Service{
+		ctx:         ctx,
+		initialSync: &mockSync.Sync{IsSyncing: false},
+		p2p:         p,
+		db:          db,
+		chain: &mockChain.ChainService{
+			Genesis:          time.Now(),
+			State:            beaconState,
+			ValidAttestation: true,
+		},
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
+		seenAttestationCache: newSeenCache(10),
+		signatureChan:        make(chan *signatureVerifier, verifierLimit),
+	}
+	go s.verifierRoutine()
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: blockRoot[:],
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataRoot, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainBeaconAttester)
+
+	// attestation returns the unaggregated attestation of the committee member at position i,
+	// signed by the key of the committee member at position signer.
+	attestation := func(i, signer int) *pubsub.Message {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		bits.SetBitAt(uint64(i), true)
+		att := &ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            data,
+			Signature:       privKeys[committee[signer]].Sign(dataRoot[:], domain).Marshal(),
+		}
+		buf := new(bytes.Buffer)
+		if _, err := p.Encoding().Encode(buf, att); err != nil {
+			t.Fatal(err)
+		}
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data:     buf.Bytes(),
+				TopicIDs: []string{"/eth2/committee_index0_beacon_attestation"},
+			},
+		}
+	}
+
+	if !s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, attestation(0, 0)) {
+		t.Error("Expected attestation with a valid signature to pass validation")
+	}
+	if s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, attestation(1, 2)) {
+		t.Error("Expected attestation with an invalid signature to fail validation")
+	}
+}
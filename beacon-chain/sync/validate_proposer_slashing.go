@@ -41,6 +41,12 @@ func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		return false
 	}
 
+	// Only the first valid slashing of a proposer is propagated.
+	if r.hasSeenProposerSlashingIndex(slashing.ProposerIndex) {
+		messageDuplicateCounter.WithLabelValues(msg.TopicIDs[0]).Inc()
+		return false
+	}
+
 	// Retrieve head state, advance state to the epoch slot used specified in slashing message.
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
@@ -62,6 +68,19 @@ func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		return false
 	}
 
+	r.setProposerSlashingIndexSeen(slashing.ProposerIndex)
+
 	msg.ValidatorData = slashing // Used in downstream subscriber
 	return true
 }
+
+// Returns true if the node has already received a valid slashing for the proposer index.
+func (r *Service) hasSeenProposerSlashingIndex(i uint64) bool {
+	_, seen := r.seenProposerSlashingCache.Get(i)
+	return seen
+}
+
+// Set the slashing of the proposer index as seen.
+func (r *Service) setProposerSlashingIndexSeen(i uint64) {
+	r.seenProposerSlashingCache.Add(i, true)
+}
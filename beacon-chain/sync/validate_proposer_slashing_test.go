@@ -126,9 +126,10 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 	slashing, s := setupValidProposerSlashing(t)
 
 	r := &Service{
-		p2p:         p,
-		chain:       &mock.ChainService{State: s},
-		initialSync: &mockSync.Sync{IsSyncing: false},
+		p2p:                       p,
+		chain:                     &mock.ChainService{State: s},
+		initialSync:               &mockSync.Sync{IsSyncing: false},
+		seenProposerSlashingCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -163,9 +164,10 @@ func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
 	ctx, _ := context.WithTimeout(context.Background(), 100*time.Millisecond)
 
 	r := &Service{
-		p2p:         p,
-		chain:       &mock.ChainService{State: state},
-		initialSync: &mockSync.Sync{IsSyncing: false},
+		p2p:                       p,
+		chain:                     &mock.ChainService{State: state},
+		initialSync:               &mockSync.Sync{IsSyncing: false},
+		seenProposerSlashingCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -193,9 +195,10 @@ func TestValidateProposerSlashing_Syncing(t *testing.T) {
 	slashing, s := setupValidProposerSlashing(t)
 
 	r := &Service{
-		p2p:         p,
-		chain:       &mock.ChainService{State: s},
-		initialSync: &mockSync.Sync{IsSyncing: true},
+		p2p:                       p,
+		chain:                     &mock.ChainService{State: s},
+		initialSync:               &mockSync.Sync{IsSyncing: true},
+		seenProposerSlashingCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
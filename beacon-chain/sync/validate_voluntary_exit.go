@@ -41,6 +41,12 @@ func (r *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 		return false
 	}
 
+	// Only the first valid exit of a validator is propagated.
+	if r.hasSeenExitIndex(exit.Exit.ValidatorIndex) {
+		messageDuplicateCounter.WithLabelValues(msg.TopicIDs[0]).Inc()
+		return false
+	}
+
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
 		return false
@@ -58,7 +64,20 @@ func (r *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 		return false
 	}
 
+	r.setExitIndexSeen(exit.Exit.ValidatorIndex)
+
 	msg.ValidatorData = exit // Used in downstream subscriber
 
 	return true
 }
+
+// Returns true if the node has already received a valid exit for the validator index.
+func (r *Service) hasSeenExitIndex(i uint64) bool {
+	_, seen := r.seenExitCache.Get(i)
+	return seen
+}
+
+// Set the exit of the validator index as seen.
+func (r *Service) setExitIndexSeen(i uint64) {
+	r.seenExitCache.Add(i, true)
+}
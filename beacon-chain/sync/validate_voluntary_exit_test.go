@@ -89,7 +89,8 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 		chain: &mock.ChainService{
 			State: s,
 		},
-		initialSync: &mockSync.Sync{IsSyncing: false},
+		initialSync:   &mockSync.Sync{IsSyncing: false},
+		seenExitCache: newSeenCache(10),
 	}
 
 	buf := new(bytes.Buffer)
@@ -114,6 +115,41 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 	}
 }
 
+func TestValidateVoluntaryExit_DuplicateExitRejected(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+
+	exit, s := setupValidExit(t)
+
+	r := &Service{
+		p2p: p,
+		chain: &mock.ChainService{
+			State: s,
+		},
+		initialSync:   &mockSync.Sync{IsSyncing: false},
+		seenExitCache: newSeenCache(10),
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().Encode(buf, exit); err != nil {
+		t.Fatal(err)
+	}
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data: buf.Bytes(),
+			TopicIDs: []string{
+				p2p.GossipTypeMapping[reflect.TypeOf(exit)],
+			},
+		},
+	}
+	if !r.validateVoluntaryExit(ctx, "", m) {
+		t.Fatal("Failed validation")
+	}
+	if r.validateVoluntaryExit(ctx, "", m) {
+		t.Error("Expected duplicate exit to be rejected")
+	}
+}
+
 func TestValidateVoluntaryExit_ValidExit_Syncing(t *testing.T) {
 	p := p2ptest.NewTestP2P(t)
 	ctx := context.Background()
@@ -125,7 +161,8 @@ func TestValidateVoluntaryExit_ValidExit_Syncing(t *testing.T) {
 		chain: &mock.ChainService{
 			State: s,
 		},
-		initialSync: &mockSync.Sync{IsSyncing: true},
+		initialSync:   &mockSync.Sync{IsSyncing: true},
+		seenExitCache: newSeenCache(10),
 	}
 	buf := new(bytes.Buffer)
 	if _, err := p.Encoding().Encode(buf, exit); err != nil {
@@ -87,8 +87,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCMaxPageSize,
 			flags.CertFlag,
 			flags.KeyFlag,
+			flags.ClientCAFlag,
+			flags.RPCAuthPolicyFlag,
+			flags.RPCRateLimit,
+			flags.RPCRateLimitBurst,
 			flags.GRPCGatewayPort,
 			flags.HTTPWeb3ProviderFlag,
+			flags.FallbackWeb3ProviderFlag,
+			flags.FallbackHTTPWeb3ProviderFlag,
+			flags.Eth1QuorumFlag,
 		},
 	},
 	{
@@ -98,9 +105,14 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PHost,
 			cmd.P2PHostDNS,
 			cmd.P2PMaxPeers,
+			cmd.P2PMaxInboundPeers,
+			cmd.P2PMaxOutboundPeers,
+			cmd.P2PMaxPeersPerIP,
+			cmd.P2PMaxPeersPerSubnet,
 			cmd.P2PPrivKey,
 			cmd.P2PWhitelist,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
 			cmd.P2PEncoding,
 			flags.MinSyncPeers,
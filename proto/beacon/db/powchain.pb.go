@@ -25,6 +25,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type DepositAudit_Classification int32
+
+const (
+	DepositAudit_NEW_VALIDATOR                  DepositAudit_Classification = 0
+	DepositAudit_TOP_UP                         DepositAudit_Classification = 1
+	DepositAudit_INVALID_SIGNATURE              DepositAudit_Classification = 2
+	DepositAudit_INVALID_WITHDRAWAL_CREDENTIALS DepositAudit_Classification = 3
+)
+
+var DepositAudit_Classification_name = map[int32]string{
+	0: "NEW_VALIDATOR",
+	1: "TOP_UP",
+	2: "INVALID_SIGNATURE",
+	3: "INVALID_WITHDRAWAL_CREDENTIALS",
+}
+
+var DepositAudit_Classification_value = map[string]int32{
+	"NEW_VALIDATOR":                  0,
+	"TOP_UP":                         1,
+	"INVALID_SIGNATURE":              2,
+	"INVALID_WITHDRAWAL_CREDENTIALS": 3,
+}
+
+func (x DepositAudit_Classification) String() string {
+	return proto.EnumName(DepositAudit_Classification_name, int32(x))
+}
+
+func (DepositAudit_Classification) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_338787f8da2f3d61, []int{7, 0}
+}
+
 type ETH1ChainData struct {
 	CurrentEth1Data      *LatestETH1Data     `protobuf:"bytes,1,opt,name=current_eth1_data,json=currentEth1Data,proto3" json:"current_eth1_data,omitempty"`
 	ChainstartData       *ChainStartData     `protobuf:"bytes,2,opt,name=chainstart_data,json=chainstartData,proto3" json:"chainstart_data,omitempty"`
@@ -435,61 +466,281 @@ func (m *DepositContainer) GetDepositRoot() []byte {
 	return nil
 }
 
+type ETH1Header struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	ParentHash           []byte   `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Number               uint64   `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Timestamp            uint64   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,5,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,6,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ETH1Header) Reset()         { *m = ETH1Header{} }
+func (m *ETH1Header) String() string { return proto.CompactTextString(m) }
+func (*ETH1Header) ProtoMessage()    {}
+func (*ETH1Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_338787f8da2f3d61, []int{6}
+}
+func (m *ETH1Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ETH1Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ETH1Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ETH1Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ETH1Header.Merge(m, src)
+}
+func (m *ETH1Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *ETH1Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_ETH1Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ETH1Header proto.InternalMessageInfo
+
+func (m *ETH1Header) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ETH1Header) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
+}
+
+func (m *ETH1Header) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *ETH1Header) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ETH1Header) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *ETH1Header) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+type DepositAudit struct {
+	Index                 uint64                      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey             []byte                      `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentials []byte                      `protobuf:"bytes,3,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	Amount                uint64                      `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Classification        DepositAudit_Classification `protobuf:"varint,5,opt,name=classification,proto3,enum=prysm.beacon.db.DepositAudit_Classification" json:"classification,omitempty"`
+	Eth1BlockNumber       uint64                      `protobuf:"varint,6,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	Eth1BlockHash         []byte                      `protobuf:"bytes,7,opt,name=eth1_block_hash,json=eth1BlockHash,proto3" json:"eth1_block_hash,omitempty"`
+	Eth1TxHash            []byte                      `protobuf:"bytes,8,opt,name=eth1_tx_hash,json=eth1TxHash,proto3" json:"eth1_tx_hash,omitempty"`
+	Eth1Sender            []byte                      `protobuf:"bytes,9,opt,name=eth1_sender,json=eth1Sender,proto3" json:"eth1_sender,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                    `json:"-"`
+	XXX_unrecognized      []byte                      `json:"-"`
+	XXX_sizecache         int32                       `json:"-"`
+}
+
+func (m *DepositAudit) Reset()         { *m = DepositAudit{} }
+func (m *DepositAudit) String() string { return proto.CompactTextString(m) }
+func (*DepositAudit) ProtoMessage()    {}
+func (*DepositAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_338787f8da2f3d61, []int{7}
+}
+func (m *DepositAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAudit.Merge(m, src)
+}
+func (m *DepositAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAudit proto.InternalMessageInfo
+
+func (m *DepositAudit) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositAudit) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DepositAudit) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (m *DepositAudit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *DepositAudit) GetClassification() DepositAudit_Classification {
+	if m != nil {
+		return m.Classification
+	}
+	return DepositAudit_NEW_VALIDATOR
+}
+
+func (m *DepositAudit) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
+	}
+	return 0
+}
+
+func (m *DepositAudit) GetEth1BlockHash() []byte {
+	if m != nil {
+		return m.Eth1BlockHash
+	}
+	return nil
+}
+
+func (m *DepositAudit) GetEth1TxHash() []byte {
+	if m != nil {
+		return m.Eth1TxHash
+	}
+	return nil
+}
+
+func (m *DepositAudit) GetEth1Sender() []byte {
+	if m != nil {
+		return m.Eth1Sender
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("prysm.beacon.db.DepositAudit_Classification", DepositAudit_Classification_name, DepositAudit_Classification_value)
 	proto.RegisterType((*ETH1ChainData)(nil), "prysm.beacon.db.ETH1ChainData")
 	proto.RegisterType((*LatestETH1Data)(nil), "prysm.beacon.db.LatestETH1Data")
 	proto.RegisterType((*ChainStartData)(nil), "prysm.beacon.db.ChainStartData")
 	proto.RegisterType((*SparseMerkleTrie)(nil), "prysm.beacon.db.SparseMerkleTrie")
 	proto.RegisterType((*TrieLayer)(nil), "prysm.beacon.db.TrieLayer")
 	proto.RegisterType((*DepositContainer)(nil), "prysm.beacon.db.DepositContainer")
+	proto.RegisterType((*ETH1Header)(nil), "prysm.beacon.db.ETH1Header")
+	proto.RegisterType((*DepositAudit)(nil), "prysm.beacon.db.DepositAudit")
 }
 
 func init() { proto.RegisterFile("proto/beacon/db/powchain.proto", fileDescriptor_338787f8da2f3d61) }
 
 var fileDescriptor_338787f8da2f3d61 = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x93, 0xc0, 0x0f, 0x36, 0x21, 0x81, 0xfd, 0x71, 0x88, 0x90, 0x1a, 0x20, 0xa8, 0x12,
-	0xea, 0xc1, 0x6e, 0x52, 0x55, 0xea, 0x81, 0x53, 0x80, 0x2a, 0x55, 0xa9, 0x5a, 0x2d, 0x9c, 0x7a,
-	0x89, 0xd6, 0xf1, 0x28, 0x5e, 0xe1, 0xd8, 0xae, 0x77, 0x42, 0xcb, 0xb9, 0xc7, 0x3e, 0x46, 0x9f,
-	0xa0, 0x6f, 0xd1, 0x63, 0x1f, 0xa1, 0xe2, 0x25, 0x7a, 0xad, 0x76, 0x76, 0x4d, 0x48, 0x00, 0xf5,
-	0x38, 0x33, 0xdf, 0xf7, 0xed, 0xfc, 0xf9, 0x6c, 0xd6, 0xc9, 0x8b, 0x0c, 0xb3, 0x20, 0x04, 0x39,
-	0xce, 0xd2, 0x20, 0x0a, 0x83, 0x3c, 0xfb, 0x3c, 0x8e, 0xa5, 0x4a, 0x7d, 0x2a, 0xf0, 0x56, 0x5e,
-	0x5c, 0xeb, 0xa9, 0x6f, 0xeb, 0x7e, 0x14, 0xee, 0xec, 0x02, 0xc6, 0xc1, 0x55, 0x4f, 0x26, 0x79,
-	0x2c, 0x7b, 0x8e, 0x37, 0x0a, 0x93, 0x6c, 0x7c, 0x69, 0x19, 0x3b, 0xbb, 0x0b, 0x8a, 0x79, 0x3f,
-	0x0f, 0xae, 0x7a, 0x01, 0x5e, 0xe7, 0xa0, 0x2d, 0xa0, 0xfb, 0xa7, 0xc2, 0x36, 0x4e, 0x2f, 0x86,
-	0xbd, 0x63, 0xf3, 0xcc, 0x89, 0x44, 0xc9, 0xdf, 0xb2, 0xad, 0xf1, 0xac, 0x28, 0x20, 0xc5, 0x11,
-	0x60, 0xdc, 0x1b, 0x45, 0x12, 0x65, 0xdb, 0xdb, 0xf3, 0x0e, 0xeb, 0xfd, 0x5d, 0x7f, 0xa9, 0x01,
-	0xff, 0x4c, 0x22, 0x68, 0x34, 0x02, 0x86, 0x2b, 0x5a, 0x8e, 0x79, 0x8a, 0x31, 0x25, 0xf8, 0x90,
-	0xb5, 0x68, 0x00, 0x8d, 0xb2, 0x40, 0x2b, 0x55, 0x79, 0x44, 0x8a, 0x3a, 0x38, 0x37, 0x38, 0x92,
-	0x6a, 0xce, 0x79, 0xa4, 0xf4, 0x9a, 0x35, 0xdc, 0x7c, 0x1a, 0x25, 0x42, 0xbb, 0x4a, 0x32, 0x07,
-	0x3e, 0x60, 0x0c, 0x05, 0xcc, 0x6e, 0x95, 0xf2, 0x7e, 0xee, 0x5f, 0xf5, 0xfc, 0x01, 0x45, 0xe7,
-	0x06, 0x2a, 0xea, 0xe1, 0x3c, 0xe0, 0x2f, 0x59, 0x0d, 0x0b, 0x05, 0xed, 0x1a, 0xf1, 0xf7, 0xef,
-	0xb5, 0x71, 0x9e, 0xcb, 0x42, 0xc3, 0x3b, 0x28, 0x2e, 0x13, 0xb8, 0x28, 0x14, 0x08, 0x82, 0xf3,
-	0x0f, 0x8c, 0x47, 0x90, 0x67, 0x5a, 0xe1, 0x68, 0x9c, 0xa5, 0x28, 0x55, 0x0a, 0x85, 0x6e, 0xaf,
-	0xec, 0x55, 0x1f, 0x14, 0x39, 0xb1, 0xd0, 0xe3, 0x12, 0x29, 0xb6, 0xa2, 0xa5, 0x8c, 0xee, 0x7e,
-	0xf7, 0x58, 0x73, 0x71, 0x7d, 0x7c, 0x9f, 0x35, 0xe8, 0x78, 0xa3, 0x18, 0xd4, 0x24, 0x46, 0x5a,
-	0x55, 0x4d, 0xd4, 0x29, 0x37, 0xa4, 0x14, 0x7f, 0xc2, 0x98, 0x85, 0xa0, 0x9a, 0xda, 0x25, 0xd4,
-	0xc4, 0x3a, 0x65, 0x2e, 0xd4, 0x14, 0xe6, 0xe5, 0x58, 0xea, 0x98, 0x66, 0x6c, 0xb8, 0xf2, 0x50,
-	0xea, 0x98, 0x3f, 0x67, 0xdb, 0x89, 0xd4, 0x38, 0x2a, 0xe0, 0xd3, 0x0c, 0x34, 0x42, 0x64, 0xcd,
-	0xd2, 0x5e, 0x21, 0x1d, 0x6e, 0x6a, 0xa2, 0x2c, 0x0d, 0x4c, 0xa5, 0xfb, 0xad, 0xc2, 0x9a, 0x8b,
-	0x97, 0xe1, 0x5d, 0xd6, 0x98, 0xdf, 0x06, 0x22, 0xf2, 0xc6, 0x9a, 0x58, 0xc8, 0x99, 0x49, 0x26,
-	0x90, 0x82, 0x56, 0xda, 0x36, 0xea, 0x26, 0x71, 0x39, 0x6a, 0xf5, 0x80, 0x6d, 0x94, 0x10, 0xdb,
-	0x84, 0x1d, 0xa6, 0xe4, 0xd1, 0xf3, 0xfc, 0x88, 0xad, 0xcf, 0x4d, 0x58, 0x73, 0xce, 0xb9, 0x3d,
-	0x39, 0x60, 0xec, 0x97, 0xee, 0xf7, 0x4b, 0xcf, 0x89, 0x35, 0x28, 0xdd, 0xf7, 0x9e, 0xfd, 0x7f,
-	0xd7, 0x7d, 0xf6, 0x04, 0xe5, 0xd5, 0x3a, 0x8f, 0xe8, 0xb8, 0xdb, 0x09, 0x7e, 0xc7, 0x80, 0x8e,
-	0xd9, 0xfd, 0xea, 0xb1, 0xcd, 0x65, 0x83, 0xf0, 0x6d, 0xb6, 0x12, 0x41, 0x8e, 0x31, 0x2d, 0xa2,
-	0x26, 0x6c, 0xc0, 0xfb, 0x6c, 0x35, 0x91, 0xd7, 0xc6, 0x24, 0x15, 0x7a, 0x6e, 0xe7, 0x9e, 0x49,
-	0x0c, 0xf9, 0xcc, 0x40, 0x84, 0x43, 0xf2, 0xa7, 0xac, 0x99, 0x15, 0x6a, 0xa2, 0x52, 0x99, 0x8c,
-	0x14, 0xc2, 0x54, 0xb7, 0xab, 0x7b, 0xd5, 0xc3, 0x86, 0xd8, 0x28, 0xb3, 0x6f, 0x4c, 0xb2, 0xbb,
-	0xcf, 0xd6, 0x6f, 0xb9, 0xe6, 0x75, 0x62, 0xb7, 0x3d, 0x82, 0xda, 0xa0, 0xfb, 0xc3, 0x63, 0x9b,
-	0xcb, 0x26, 0x34, 0x50, 0x95, 0x46, 0xf0, 0x85, 0x1a, 0xad, 0x0a, 0x1b, 0xf0, 0x67, 0x6c, 0x8b,
-	0x56, 0xfc, 0x80, 0xf3, 0x5a, 0xa6, 0x30, 0xb8, 0xe3, 0xbe, 0x57, 0xec, 0x3f, 0xb7, 0x45, 0xf7,
-	0xfd, 0xfd, 0x6b, 0x89, 0x25, 0xdc, 0x18, 0xa2, 0xfc, 0x7e, 0x8a, 0x2c, 0x43, 0x67, 0xcd, 0xba,
-	0xcb, 0x89, 0x2c, 0xc3, 0xc1, 0xd1, 0xcf, 0x9b, 0x8e, 0xf7, 0xeb, 0xa6, 0xe3, 0xfd, 0xbe, 0xe9,
-	0x78, 0x1f, 0xfd, 0x89, 0xc2, 0x78, 0x16, 0xfa, 0xe3, 0x6c, 0x1a, 0xd0, 0xe6, 0x24, 0xaa, 0x71,
-	0x22, 0x43, 0x6d, 0xa3, 0x60, 0xe9, 0x57, 0x19, 0xae, 0x52, 0xe2, 0xc5, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0x35, 0x8c, 0x86, 0x44, 0x05, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xc1, 0x6e, 0x23, 0x45,
+	0x10, 0x65, 0x6c, 0xaf, 0x37, 0xae, 0x38, 0x8e, 0xd3, 0xec, 0xae, 0xac, 0x88, 0x75, 0x12, 0xaf,
+	0x40, 0x2b, 0x84, 0xc6, 0x38, 0x68, 0x25, 0x0e, 0x7b, 0x71, 0x12, 0x83, 0xad, 0x0d, 0x49, 0xd4,
+	0xf6, 0x12, 0x89, 0xcb, 0xa8, 0x3d, 0xd3, 0x64, 0x9a, 0x8c, 0x67, 0x86, 0xe9, 0x76, 0x12, 0x9f,
+	0x39, 0xf2, 0x19, 0x7c, 0x01, 0x7f, 0x81, 0x38, 0xf1, 0x09, 0x28, 0x57, 0x3e, 0x80, 0x2b, 0xea,
+	0xea, 0x9e, 0x4c, 0xec, 0x6c, 0xc4, 0xcd, 0xfd, 0xea, 0x55, 0x75, 0xbd, 0xaa, 0xd7, 0x63, 0x68,
+	0xa7, 0x59, 0xa2, 0x92, 0xee, 0x94, 0x33, 0x3f, 0x89, 0xbb, 0xc1, 0xb4, 0x9b, 0x26, 0xd7, 0x7e,
+	0xc8, 0x44, 0xec, 0x62, 0x80, 0x6c, 0xa6, 0xd9, 0x42, 0xce, 0x5c, 0x13, 0x77, 0x83, 0xe9, 0xf6,
+	0x0e, 0x57, 0x61, 0xf7, 0xaa, 0xc7, 0xa2, 0x34, 0x64, 0x3d, 0x9b, 0xe7, 0x4d, 0xa3, 0xc4, 0xbf,
+	0x34, 0x19, 0xdb, 0x3b, 0x4b, 0x15, 0xd3, 0xfd, 0xb4, 0x7b, 0xd5, 0xeb, 0xaa, 0x45, 0xca, 0xa5,
+	0x21, 0x74, 0xfe, 0x2d, 0xc1, 0xc6, 0x60, 0x32, 0xec, 0x1d, 0xea, 0x6b, 0x8e, 0x98, 0x62, 0xe4,
+	0x1d, 0x6c, 0xf9, 0xf3, 0x2c, 0xe3, 0xb1, 0xf2, 0xb8, 0x0a, 0x7b, 0x5e, 0xc0, 0x14, 0x6b, 0x39,
+	0xbb, 0xce, 0xeb, 0xf5, 0xfd, 0x1d, 0x77, 0xa5, 0x01, 0xf7, 0x98, 0x29, 0x2e, 0x95, 0x2e, 0xa0,
+	0x73, 0xe9, 0xa6, 0xcd, 0x1c, 0xa8, 0x10, 0x01, 0x32, 0x84, 0x4d, 0x14, 0x20, 0x15, 0xcb, 0x94,
+	0x29, 0x55, 0x7a, 0xa4, 0x14, 0x76, 0x30, 0xd6, 0x3c, 0x2c, 0xd5, 0x28, 0xf2, 0xb0, 0xd2, 0x37,
+	0x50, 0xb7, 0xfa, 0xa4, 0x62, 0x8a, 0xb7, 0xca, 0x58, 0xe6, 0x95, 0xcb, 0x55, 0xc8, 0x33, 0x3e,
+	0xbf, 0xab, 0x94, 0xee, 0xa7, 0xee, 0x55, 0xcf, 0x3d, 0xc0, 0xd3, 0x58, 0x53, 0xe9, 0xfa, 0xb4,
+	0x38, 0x90, 0x37, 0x50, 0x51, 0x99, 0xe0, 0xad, 0x0a, 0xe6, 0xef, 0x3d, 0x68, 0x63, 0x9c, 0xb2,
+	0x4c, 0xf2, 0xef, 0x78, 0x76, 0x19, 0xf1, 0x49, 0x26, 0x38, 0x45, 0x3a, 0x39, 0x03, 0x12, 0xf0,
+	0x34, 0x91, 0x42, 0x79, 0x7e, 0x12, 0x2b, 0x26, 0x62, 0x9e, 0xc9, 0xd6, 0x93, 0xdd, 0xf2, 0x07,
+	0x8b, 0x1c, 0x19, 0xea, 0x61, 0xce, 0xa4, 0x5b, 0xc1, 0x0a, 0x22, 0x3b, 0xbf, 0x39, 0xd0, 0x58,
+	0x1e, 0x1f, 0xd9, 0x83, 0x3a, 0x2e, 0xcf, 0x0b, 0xb9, 0xb8, 0x08, 0x15, 0x8e, 0xaa, 0x42, 0xd7,
+	0x11, 0x1b, 0x22, 0x44, 0x5e, 0x02, 0x18, 0x8a, 0x12, 0x33, 0x33, 0x84, 0x0a, 0xad, 0x21, 0x32,
+	0x11, 0x33, 0x5e, 0x84, 0x43, 0x26, 0x43, 0xd4, 0x58, 0xb7, 0xe1, 0x21, 0x93, 0x21, 0xf9, 0x12,
+	0x9e, 0x45, 0x4c, 0x2a, 0x2f, 0xe3, 0x3f, 0xcf, 0xb9, 0x54, 0x3c, 0x30, 0x66, 0x69, 0x3d, 0xc1,
+	0x3a, 0x44, 0xc7, 0x68, 0x1e, 0x3a, 0xd0, 0x91, 0xce, 0xaf, 0x25, 0x68, 0x2c, 0x6f, 0x86, 0x74,
+	0xa0, 0x5e, 0xec, 0x86, 0x07, 0xe8, 0x8d, 0x35, 0xba, 0x84, 0x69, 0x25, 0x17, 0x3c, 0xe6, 0x52,
+	0x48, 0xd3, 0xa8, 0x55, 0x62, 0x31, 0x6c, 0xf5, 0x15, 0x6c, 0xe4, 0x14, 0xd3, 0x84, 0x11, 0x93,
+	0xe7, 0xe1, 0xf5, 0xe4, 0x2d, 0xd4, 0x0a, 0x13, 0x56, 0xac, 0x73, 0xee, 0x56, 0xce, 0x55, 0xe8,
+	0xe6, 0xee, 0x77, 0x73, 0xcf, 0xd1, 0x35, 0x6e, 0x7f, 0x91, 0x53, 0xf8, 0xf8, 0xbe, 0xfb, 0xcc,
+	0x0a, 0xf2, 0xad, 0xb5, 0x1f, 0xa9, 0x63, 0x77, 0x47, 0xc9, 0x3d, 0x03, 0xda, 0xcc, 0xce, 0x2f,
+	0x0e, 0x34, 0x57, 0x0d, 0x42, 0x9e, 0xc1, 0x93, 0x80, 0xa7, 0x2a, 0xc4, 0x41, 0x54, 0xa8, 0x39,
+	0x90, 0x7d, 0xa8, 0x46, 0x6c, 0xa1, 0x4d, 0x52, 0xc2, 0xeb, 0xb6, 0x1f, 0x98, 0x44, 0x27, 0x1f,
+	0x6b, 0x0a, 0xb5, 0x4c, 0xf2, 0x29, 0x34, 0x92, 0x4c, 0x5c, 0x88, 0x98, 0x45, 0x9e, 0x50, 0x7c,
+	0x26, 0x5b, 0xe5, 0xdd, 0xf2, 0xeb, 0x3a, 0xdd, 0xc8, 0xd1, 0x91, 0x06, 0x3b, 0x7b, 0x50, 0xbb,
+	0xcb, 0xd5, 0xb7, 0x63, 0x76, 0xcb, 0x41, 0xaa, 0x39, 0x74, 0x7e, 0x77, 0xa0, 0xb9, 0x6a, 0x42,
+	0x4d, 0x15, 0x71, 0xc0, 0x6f, 0xb0, 0xd1, 0x32, 0x35, 0x07, 0xf2, 0x39, 0x6c, 0xe1, 0x88, 0x3f,
+	0xe0, 0xbc, 0x4d, 0x1d, 0x38, 0xb8, 0xe7, 0xbe, 0xaf, 0xe1, 0xa9, 0x9d, 0xa2, 0x7d, 0x7f, 0xff,
+	0x37, 0xc4, 0x9c, 0xae, 0x0d, 0x61, 0x7f, 0x7a, 0x59, 0x92, 0x28, 0x6b, 0xcd, 0x75, 0x8b, 0xd1,
+	0x24, 0x51, 0x9d, 0x3f, 0x1d, 0x00, 0xfd, 0x14, 0x86, 0x9c, 0x05, 0x3c, 0x5b, 0xb1, 0xb2, 0xb3,
+	0x6a, 0xe5, 0x1d, 0x58, 0x4f, 0x19, 0x7e, 0xa5, 0x30, 0x5e, 0xc2, 0x38, 0x18, 0x08, 0x09, 0x2f,
+	0xa0, 0x1a, 0xcf, 0x67, 0x53, 0x9e, 0x59, 0x63, 0xd9, 0x13, 0xf9, 0x04, 0x6a, 0xda, 0x92, 0x52,
+	0xb1, 0x59, 0x8a, 0x6d, 0x54, 0x68, 0x01, 0x68, 0x57, 0x16, 0xef, 0x7c, 0x1e, 0x2b, 0xfb, 0x34,
+	0xea, 0x77, 0xef, 0x77, 0x1e, 0x3f, 0x14, 0x53, 0x7d, 0x28, 0xe6, 0x9f, 0x32, 0xd4, 0xed, 0x10,
+	0xfa, 0xf3, 0x40, 0xa8, 0xe5, 0xe1, 0x57, 0xf2, 0xe1, 0xbf, 0x04, 0x48, 0xe7, 0xd3, 0x48, 0xf8,
+	0xde, 0x25, 0x5f, 0x58, 0x11, 0x35, 0x83, 0xbc, 0xe3, 0x0b, 0xf2, 0x06, 0x5e, 0x5c, 0x0b, 0x15,
+	0x06, 0x19, 0xbb, 0x66, 0x91, 0xe7, 0x67, 0x3c, 0xe0, 0xb1, 0x12, 0x2c, 0x92, 0xa8, 0xa9, 0x4e,
+	0x9f, 0x17, 0xd1, 0xc3, 0x22, 0xa8, 0xa5, 0xb3, 0x19, 0x76, 0x6f, 0xf4, 0xd9, 0x13, 0x99, 0x40,
+	0xc3, 0x8f, 0x98, 0x94, 0xe2, 0x47, 0xe1, 0x33, 0x25, 0x92, 0x18, 0xd5, 0x35, 0xf6, 0xbf, 0x78,
+	0xec, 0x03, 0x86, 0xad, 0xbb, 0x87, 0x4b, 0x39, 0x74, 0xa5, 0xc6, 0x8a, 0x81, 0xec, 0xcc, 0xab,
+	0x2b, 0x06, 0x3a, 0x31, 0xc3, 0xff, 0x0c, 0x36, 0xef, 0x9b, 0x4d, 0x6f, 0xee, 0x29, 0x2a, 0xd9,
+	0x28, 0xac, 0xa6, 0x97, 0xb7, 0x0b, 0x75, 0xe4, 0xa9, 0x1b, 0x43, 0x5a, 0x33, 0xeb, 0xd5, 0xd8,
+	0xe4, 0x26, 0xdf, 0x3f, 0x32, 0x24, 0x8f, 0x03, 0x9e, 0xb5, 0x6a, 0x05, 0x61, 0x8c, 0x48, 0xe7,
+	0x27, 0x68, 0x2c, 0x37, 0x4e, 0xb6, 0x60, 0xe3, 0x64, 0x70, 0xee, 0x7d, 0xdf, 0x3f, 0x1e, 0x1d,
+	0xf5, 0x27, 0xa7, 0xb4, 0xf9, 0x11, 0x01, 0xa8, 0x4e, 0x4e, 0xcf, 0xbc, 0xf7, 0x67, 0x4d, 0x87,
+	0x3c, 0x87, 0xad, 0xd1, 0x09, 0x06, 0xbd, 0xf1, 0xe8, 0xdb, 0x93, 0xfe, 0xe4, 0x3d, 0x1d, 0x34,
+	0x4b, 0xa4, 0x03, 0xed, 0x1c, 0x3e, 0x1f, 0x4d, 0x86, 0x47, 0xb4, 0x7f, 0xde, 0x3f, 0xf6, 0x0e,
+	0xe9, 0xe0, 0x68, 0x70, 0x32, 0x19, 0xf5, 0x8f, 0xc7, 0xcd, 0xf2, 0xc1, 0xdb, 0x3f, 0x6e, 0xdb,
+	0xce, 0x5f, 0xb7, 0x6d, 0xe7, 0xef, 0xdb, 0xb6, 0xf3, 0x83, 0x7b, 0x21, 0x54, 0x38, 0x9f, 0xba,
+	0x7e, 0x32, 0xeb, 0xe2, 0x60, 0x99, 0x12, 0x7e, 0xc4, 0xa6, 0xd2, 0x9c, 0xba, 0x2b, 0xff, 0xf2,
+	0xd3, 0x2a, 0x02, 0x5f, 0xfd, 0x37, 0x00, 0xe3, 0x63, 0x64, 0x31, 0xff, 0x07, 0x00, 0x00,
 }
 
 func (m *ETH1ChainData) Marshal() (dAtA []byte, err error) {
@@ -850,6 +1101,151 @@ func (m *DepositContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ETH1Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ETH1Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ETH1Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DepositRoot) > 0 {
+		i -= len(m.DepositRoot)
+		copy(dAtA[i:], m.DepositRoot)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.DepositRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Number != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Eth1Sender) > 0 {
+		i -= len(m.Eth1Sender)
+		copy(dAtA[i:], m.Eth1Sender)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.Eth1Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Eth1TxHash) > 0 {
+		i -= len(m.Eth1TxHash)
+		copy(dAtA[i:], m.Eth1TxHash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.Eth1TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Eth1BlockHash) > 0 {
+		i -= len(m.Eth1BlockHash)
+		copy(dAtA[i:], m.Eth1BlockHash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.Eth1BlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Eth1BlockNumber != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Eth1BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Classification != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Classification))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.WithdrawalCredentials) > 0 {
+		i -= len(m.WithdrawalCredentials)
+		copy(dAtA[i:], m.WithdrawalCredentials)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.WithdrawalCredentials)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPowchain(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPowchain(v)
 	base := offset
@@ -1022,14 +1418,91 @@ func (m *DepositContainer) Size() (n int) {
 	return n
 }
 
-func sovPowchain(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPowchain(x uint64) (n int) {
-	return sovPowchain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ETH1Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPowchain(uint64(m.Number))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPowchain(uint64(m.Timestamp))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovPowchain(uint64(m.DepositCount))
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *ETH1ChainData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *DepositAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovPowchain(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	l = len(m.WithdrawalCredentials)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovPowchain(uint64(m.Amount))
+	}
+	if m.Classification != 0 {
+		n += 1 + sovPowchain(uint64(m.Classification))
+	}
+	if m.Eth1BlockNumber != 0 {
+		n += 1 + sovPowchain(uint64(m.Eth1BlockNumber))
+	}
+	l = len(m.Eth1BlockHash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	l = len(m.Eth1TxHash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	l = len(m.Eth1Sender)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPowchain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPowchain(x uint64) (n int) {
+	return sovPowchain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ETH1ChainData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1974,113 +2447,600 @@ func (m *DepositContainer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func skipPowchain(dAtA []byte) (n int, err error) {
+func (m *ETH1Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return 0, ErrIntOverflowPowchain
+				return ErrIntOverflowPowchain
 			}
 			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
 		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
+		if wireType == 4 {
+			return fmt.Errorf("proto: ETH1Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ETH1Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return 0, ErrIntOverflowPowchain
+					return ErrIntOverflowPowchain
 				}
 				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
 				}
+				b := dAtA[iNdEx]
 				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			return iNdEx, nil
-		case 1:
-			iNdEx += 8
-			return iNdEx, nil
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			var length int
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return 0, ErrIntOverflowPowchain
+					return ErrIntOverflowPowchain
 				}
 				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				length |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if length < 0 {
-				return 0, ErrInvalidLengthPowchain
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
 			}
-			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthPowchain
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
 			}
-			return iNdEx, nil
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = append(m.ParentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentHash == nil {
+				m.ParentHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowPowchain
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
 				}
-				next, err := skipPowchain(dAtA[start:])
-				if err != nil {
-					return 0, err
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthPowchain
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			return iNdEx, nil
 		case 4:
-			return iNdEx, nil
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
-			iNdEx += 4
-			return iNdEx, nil
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+			iNdEx = preIndex
+			skippy, err := skipPowchain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowchain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentials", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentials = append(m.WithdrawalCredentials[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentials == nil {
+				m.WithdrawalCredentials = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+			}
+			m.Classification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Classification |= DepositAudit_Classification(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockNumber", wireType)
+			}
+			m.Eth1BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1BlockHash = append(m.Eth1BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1BlockHash == nil {
+				m.Eth1BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1TxHash = append(m.Eth1TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1TxHash == nil {
+				m.Eth1TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1Sender = append(m.Eth1Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1Sender == nil {
+				m.Eth1Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowchain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPowchain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPowchain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPowchain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPowchain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPowchain
+		}
+		if depth == 0 {
+			return iNdEx, nil
 		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthPowchain = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowPowchain   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthPowchain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPowchain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPowchain = fmt.Errorf("proto: unexpected end of group")
 )
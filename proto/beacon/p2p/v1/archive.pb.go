@@ -25,6 +25,52 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ArchivedValidatorMilestone_Type int32
+
+const (
+	ArchivedValidatorMilestone_DEPOSITED      ArchivedValidatorMilestone_Type = 0
+	ArchivedValidatorMilestone_INCLUDED       ArchivedValidatorMilestone_Type = 1
+	ArchivedValidatorMilestone_ELIGIBLE       ArchivedValidatorMilestone_Type = 2
+	ArchivedValidatorMilestone_QUEUED         ArchivedValidatorMilestone_Type = 3
+	ArchivedValidatorMilestone_ACTIVATED      ArchivedValidatorMilestone_Type = 4
+	ArchivedValidatorMilestone_EXIT_INITIATED ArchivedValidatorMilestone_Type = 5
+	ArchivedValidatorMilestone_EXITED         ArchivedValidatorMilestone_Type = 6
+	ArchivedValidatorMilestone_SLASHED        ArchivedValidatorMilestone_Type = 7
+	ArchivedValidatorMilestone_WITHDRAWABLE   ArchivedValidatorMilestone_Type = 8
+)
+
+var ArchivedValidatorMilestone_Type_name = map[int32]string{
+	0: "DEPOSITED",
+	1: "INCLUDED",
+	2: "ELIGIBLE",
+	3: "QUEUED",
+	4: "ACTIVATED",
+	5: "EXIT_INITIATED",
+	6: "EXITED",
+	7: "SLASHED",
+	8: "WITHDRAWABLE",
+}
+
+var ArchivedValidatorMilestone_Type_value = map[string]int32{
+	"DEPOSITED":      0,
+	"INCLUDED":       1,
+	"ELIGIBLE":       2,
+	"QUEUED":         3,
+	"ACTIVATED":      4,
+	"EXIT_INITIATED": 5,
+	"EXITED":         6,
+	"SLASHED":        7,
+	"WITHDRAWABLE":   8,
+}
+
+func (x ArchivedValidatorMilestone_Type) String() string {
+	return proto.EnumName(ArchivedValidatorMilestone_Type_name, int32(x))
+}
+
+func (ArchivedValidatorMilestone_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{3, 0}
+}
+
 type ArchivedActiveSetChanges struct {
 	Activated            []uint64                     `protobuf:"varint,1,rep,packed,name=activated,proto3" json:"activated,omitempty"`
 	Exited               []uint64                     `protobuf:"varint,2,rep,packed,name=exited,proto3" json:"exited,omitempty"`
@@ -167,40 +213,193 @@ func (m *ArchivedCommitteeInfo) GetAttesterSeed() []byte {
 	return nil
 }
 
+type ArchivedValidatorLifecycle struct {
+	Milestones           []*ArchivedValidatorMilestone `protobuf:"bytes,1,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ArchivedValidatorLifecycle) Reset()         { *m = ArchivedValidatorLifecycle{} }
+func (m *ArchivedValidatorLifecycle) String() string { return proto.CompactTextString(m) }
+func (*ArchivedValidatorLifecycle) ProtoMessage()    {}
+func (*ArchivedValidatorLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{2}
+}
+func (m *ArchivedValidatorLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedValidatorLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedValidatorLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedValidatorLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedValidatorLifecycle.Merge(m, src)
+}
+func (m *ArchivedValidatorLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedValidatorLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedValidatorLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedValidatorLifecycle proto.InternalMessageInfo
+
+func (m *ArchivedValidatorLifecycle) GetMilestones() []*ArchivedValidatorMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+type ArchivedValidatorMilestone struct {
+	Type                 ArchivedValidatorMilestone_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ethereum.beacon.p2p.v1.ArchivedValidatorMilestone_Type" json:"type,omitempty"`
+	Epoch                uint64                          `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot                 uint64                          `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Timestamp            uint64                          `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	QueuePosition        uint64                          `protobuf:"varint,5,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	Approximate          bool                            `protobuf:"varint,6,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ArchivedValidatorMilestone) Reset()         { *m = ArchivedValidatorMilestone{} }
+func (m *ArchivedValidatorMilestone) String() string { return proto.CompactTextString(m) }
+func (*ArchivedValidatorMilestone) ProtoMessage()    {}
+func (*ArchivedValidatorMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{3}
+}
+func (m *ArchivedValidatorMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedValidatorMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedValidatorMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedValidatorMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedValidatorMilestone.Merge(m, src)
+}
+func (m *ArchivedValidatorMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedValidatorMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedValidatorMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedValidatorMilestone proto.InternalMessageInfo
+
+func (m *ArchivedValidatorMilestone) GetType() ArchivedValidatorMilestone_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ArchivedValidatorMilestone_DEPOSITED
+}
+
+func (m *ArchivedValidatorMilestone) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ArchivedValidatorMilestone) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ArchivedValidatorMilestone) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ArchivedValidatorMilestone) GetQueuePosition() uint64 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
+}
+
+func (m *ArchivedValidatorMilestone) GetApproximate() bool {
+	if m != nil {
+		return m.Approximate
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("ethereum.beacon.p2p.v1.ArchivedValidatorMilestone_Type", ArchivedValidatorMilestone_Type_name, ArchivedValidatorMilestone_Type_value)
 	proto.RegisterType((*ArchivedActiveSetChanges)(nil), "ethereum.beacon.p2p.v1.ArchivedActiveSetChanges")
 	proto.RegisterType((*ArchivedCommitteeInfo)(nil), "ethereum.beacon.p2p.v1.ArchivedCommitteeInfo")
+	proto.RegisterType((*ArchivedValidatorLifecycle)(nil), "ethereum.beacon.p2p.v1.ArchivedValidatorLifecycle")
+	proto.RegisterType((*ArchivedValidatorMilestone)(nil), "ethereum.beacon.p2p.v1.ArchivedValidatorMilestone")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/archive.proto", fileDescriptor_289929478e9672a3) }
 
 var fileDescriptor_289929478e9672a3 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0xc9, 0xee, 0xd2, 0xd5, 0xb1, 0xab, 0xec, 0x80, 0x4b, 0x58, 0xa4, 0x5b, 0x83, 0x60,
-	0x2f, 0x9d, 0x90, 0x14, 0x3c, 0x78, 0x6b, 0x8b, 0x07, 0x0f, 0x82, 0xa4, 0xd0, 0x6b, 0x99, 0x24,
-	0xaf, 0x99, 0xc1, 0x24, 0x33, 0x64, 0x26, 0xa1, 0xf6, 0x0b, 0xf8, 0xd5, 0x3c, 0xfa, 0x09, 0x44,
-	0x7a, 0xf3, 0xea, 0x27, 0x90, 0x4c, 0x92, 0x46, 0x0b, 0xdd, 0x5b, 0xde, 0x7b, 0xff, 0xff, 0xef,
-	0xfd, 0x67, 0x32, 0xe8, 0xb5, 0x2c, 0x84, 0x16, 0x6e, 0x08, 0x34, 0x12, 0xb9, 0x2b, 0x7d, 0xe9,
-	0x56, 0x9e, 0x4b, 0x8b, 0x88, 0xf1, 0x0a, 0x88, 0x99, 0xe1, 0x3b, 0xd0, 0x0c, 0x0a, 0x28, 0x33,
-	0xd2, 0xa8, 0x88, 0xf4, 0x25, 0xa9, 0xbc, 0xfb, 0x69, 0xc2, 0x35, 0x2b, 0x43, 0x12, 0x89, 0xcc,
-	0x4d, 0x44, 0x22, 0x5c, 0x23, 0x0f, 0xcb, 0xad, 0xa9, 0x1a, 0x6e, 0xfd, 0xd5, 0x60, 0xee, 0x1f,
-	0x40, 0x33, 0xb7, 0xf2, 0x68, 0x2a, 0x19, 0xf5, 0xda, 0x85, 0x9b, 0x30, 0x15, 0xd1, 0x97, 0x46,
-	0xe0, 0xfc, 0xbe, 0x40, 0xf6, 0xbc, 0xd9, 0x1c, 0xcf, 0x23, 0xcd, 0x2b, 0x58, 0x81, 0x5e, 0x32,
-	0x9a, 0x27, 0xa0, 0xf0, 0x2b, 0xf4, 0x94, 0xd6, 0x3d, 0xaa, 0x21, 0xb6, 0xad, 0xf1, 0xe5, 0xe4,
-	0x2a, 0xe8, 0x1b, 0xf8, 0x0e, 0x0d, 0x60, 0xc7, 0xeb, 0xd1, 0x85, 0x19, 0xb5, 0x15, 0xb6, 0xd1,
-	0xb5, 0x4a, 0xa9, 0x62, 0x10, 0xdb, 0x57, 0x66, 0xd0, 0x95, 0xf8, 0x13, 0x7a, 0x51, 0x89, 0xb4,
-	0xcc, 0x35, 0x2d, 0xbe, 0x6e, 0x6a, 0xb5, 0xb2, 0x07, 0xe3, 0xcb, 0xc9, 0x33, 0xff, 0x0d, 0x39,
-	0x1e, 0x17, 0x34, 0x23, 0x5d, 0x60, 0xb2, 0xee, 0xd4, 0x1f, 0x76, 0x5c, 0x07, 0xcf, 0xab, 0x7f,
-	0x4b, 0x85, 0xd7, 0x08, 0xcb, 0x42, 0x48, 0xa1, 0xa0, 0xd8, 0x98, 0x15, 0x3c, 0x4f, 0x94, 0x7d,
-	0x6d, 0x88, 0x6f, 0xcf, 0x10, 0x3f, 0xb7, 0x86, 0x55, 0xab, 0x0f, 0x6e, 0xe5, 0x49, 0xc7, 0x70,
-	0xa9, 0xd6, 0xa0, 0xf4, 0x7f, 0xdc, 0x27, 0x8f, 0x72, 0xe7, 0xad, 0xa1, 0xe7, 0xd2, 0x93, 0x8e,
-	0x72, 0xbe, 0x59, 0xe8, 0x65, 0x77, 0xd7, 0x4b, 0x91, 0x65, 0x5c, 0x6b, 0x80, 0x8f, 0xf9, 0x56,
-	0xe0, 0x77, 0xe8, 0xa6, 0x3f, 0x09, 0x98, 0xcb, 0xb6, 0x26, 0xc3, 0xc5, 0xed, 0x9f, 0x9f, 0x0f,
-	0x37, 0x4a, 0xed, 0xa7, 0x8a, 0xef, 0xe1, 0xbd, 0x33, 0xf3, 0x9d, 0x60, 0x78, 0x8c, 0x0b, 0x10,
-	0xd7, 0xbe, 0x3e, 0x29, 0x98, 0x3f, 0x71, 0xce, 0x77, 0x8c, 0x03, 0x10, 0x2f, 0x86, 0xdf, 0x0f,
-	0x23, 0xeb, 0xc7, 0x61, 0x64, 0xfd, 0x3a, 0x8c, 0xac, 0x70, 0x60, 0x9e, 0xc2, 0xec, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0xde, 0x85, 0x08, 0x97, 0x02, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0xdc, 0xb8, 0x49, 0x3a, 0xf9, 0xf9, 0xd2, 0xd1, 0xf7, 0x55, 0x56, 0x84, 0xda, 0x10,
+	0x81, 0xc8, 0xa6, 0x8e, 0x92, 0x4a, 0x20, 0xb1, 0x73, 0x63, 0x8b, 0x5a, 0xa4, 0xa5, 0x38, 0x69,
+	0xca, 0x2e, 0x9a, 0x38, 0xb7, 0xf1, 0x08, 0x3b, 0x33, 0x78, 0x26, 0x56, 0xd3, 0x17, 0x00, 0x89,
+	0x17, 0x63, 0xc9, 0x13, 0x20, 0xd4, 0x1d, 0x5b, 0x9e, 0x00, 0x79, 0x92, 0x34, 0xa5, 0x50, 0x24,
+	0x76, 0xbe, 0xe7, 0x9e, 0x73, 0xee, 0x99, 0x3b, 0x23, 0xa3, 0x87, 0x3c, 0x66, 0x92, 0x35, 0x47,
+	0x40, 0x7c, 0x36, 0x6d, 0xf2, 0x36, 0x6f, 0x26, 0xad, 0x26, 0x89, 0xfd, 0x80, 0x26, 0x60, 0xaa,
+	0x1e, 0xde, 0x01, 0x19, 0x40, 0x0c, 0xb3, 0xc8, 0x5c, 0xb0, 0x4c, 0xde, 0xe6, 0x66, 0xd2, 0xaa,
+	0xee, 0x4f, 0xa8, 0x0c, 0x66, 0x23, 0xd3, 0x67, 0x51, 0x73, 0xc2, 0x26, 0xac, 0xa9, 0xe8, 0xa3,
+	0xd9, 0x85, 0xaa, 0x16, 0xbe, 0xe9, 0xd7, 0xc2, 0xa6, 0xba, 0x07, 0x32, 0x68, 0x26, 0x2d, 0x12,
+	0xf2, 0x80, 0xb4, 0x96, 0x03, 0x87, 0xa3, 0x90, 0xf9, 0x6f, 0x17, 0x84, 0xfa, 0xb7, 0x0d, 0x64,
+	0x58, 0x8b, 0xc9, 0x63, 0xcb, 0x97, 0x34, 0x81, 0x1e, 0xc8, 0x4e, 0x40, 0xa6, 0x13, 0x10, 0xf8,
+	0x01, 0xda, 0x22, 0x29, 0x46, 0x24, 0x8c, 0x0d, 0xad, 0x96, 0x69, 0xe8, 0xde, 0x1a, 0xc0, 0x3b,
+	0x28, 0x0b, 0x97, 0x34, 0x6d, 0x6d, 0xa8, 0xd6, 0xb2, 0xc2, 0x06, 0xca, 0x89, 0x90, 0x88, 0x00,
+	0xc6, 0x86, 0xae, 0x1a, 0xab, 0x12, 0x1f, 0xa3, 0x7f, 0x13, 0x16, 0xce, 0xa6, 0x92, 0xc4, 0xf3,
+	0x61, 0xca, 0x16, 0x46, 0xb6, 0x96, 0x69, 0x14, 0xda, 0x8f, 0xcc, 0x9b, 0xe3, 0x82, 0x0c, 0xcc,
+	0x55, 0x60, 0x73, 0xb0, 0x62, 0x3b, 0x97, 0x54, 0x7a, 0xe5, 0xe4, 0x76, 0x29, 0xf0, 0x00, 0x61,
+	0x1e, 0x33, 0xce, 0x04, 0xc4, 0x43, 0x35, 0x82, 0x4e, 0x27, 0xc2, 0xc8, 0x29, 0xc7, 0x27, 0xf7,
+	0x38, 0x9e, 0x2e, 0x05, 0xbd, 0x25, 0xdf, 0xdb, 0xe6, 0x77, 0x10, 0xe5, 0x4b, 0xa4, 0x04, 0x21,
+	0x7f, 0xf2, 0xcd, 0xff, 0xd1, 0xd7, 0x5a, 0x0a, 0xd6, 0xbe, 0xe4, 0x0e, 0x22, 0xea, 0xef, 0x35,
+	0xf4, 0xff, 0x6a, 0xd7, 0x1d, 0x16, 0x45, 0x54, 0x4a, 0x00, 0x77, 0x7a, 0xc1, 0xf0, 0x53, 0x54,
+	0x5a, 0x9f, 0x04, 0xd4, 0xb2, 0xb5, 0x46, 0xf1, 0x70, 0xfb, 0xfb, 0x97, 0xbd, 0x92, 0x10, 0x57,
+	0xfb, 0x82, 0x5e, 0xc1, 0xf3, 0xfa, 0x41, 0xbb, 0xee, 0x15, 0x6f, 0xe2, 0x02, 0x8c, 0x53, 0xdd,
+	0x3a, 0x29, 0xa8, 0x9b, 0xb8, 0x4f, 0x77, 0x13, 0x07, 0x60, 0x5c, 0xe7, 0xa8, 0xba, 0x0a, 0x32,
+	0x20, 0x21, 0x1d, 0x13, 0xc9, 0xe2, 0x2e, 0xbd, 0x00, 0x7f, 0xee, 0x87, 0x80, 0x3d, 0x84, 0x22,
+	0x1a, 0x82, 0x90, 0x6c, 0x0a, 0x42, 0xdd, 0x7b, 0xa1, 0xdd, 0x36, 0x7f, 0xff, 0x20, 0xcd, 0x5f,
+	0x7c, 0x8e, 0x57, 0x52, 0xef, 0x96, 0x4b, 0xfd, 0x43, 0x06, 0x55, 0xef, 0xa7, 0xe2, 0x97, 0x48,
+	0x97, 0x73, 0x0e, 0xea, 0xdc, 0xe5, 0xf6, 0xb3, 0xbf, 0x1f, 0x66, 0xf6, 0xe7, 0x1c, 0x3c, 0x65,
+	0x82, 0xff, 0x43, 0x9b, 0xc0, 0x99, 0x1f, 0xa8, 0x6d, 0xe8, 0xde, 0xa2, 0xc0, 0x18, 0xe9, 0x22,
+	0x64, 0xd2, 0xc8, 0x28, 0x50, 0x7d, 0xa7, 0x0f, 0x5c, 0xd2, 0x08, 0x84, 0x24, 0x11, 0x37, 0x74,
+	0xd5, 0x58, 0x03, 0xf8, 0x31, 0x2a, 0xbf, 0x9b, 0xc1, 0x0c, 0x86, 0x9c, 0x09, 0x2a, 0x29, 0x9b,
+	0x1a, 0x9b, 0x8a, 0x52, 0x52, 0xe8, 0xe9, 0x12, 0xc4, 0x35, 0x54, 0x20, 0x9c, 0xc7, 0xec, 0x92,
+	0x46, 0x44, 0x82, 0x91, 0xad, 0x69, 0x8d, 0xbc, 0x77, 0x1b, 0xaa, 0x7f, 0xd4, 0x90, 0x9e, 0xe6,
+	0xc3, 0x25, 0xb4, 0x65, 0x3b, 0xa7, 0xaf, 0x7a, 0x6e, 0xdf, 0xb1, 0x2b, 0xff, 0xe0, 0x22, 0xca,
+	0xbb, 0x27, 0x9d, 0xee, 0x99, 0xed, 0xd8, 0x15, 0x2d, 0xad, 0x9c, 0xae, 0xfb, 0xc2, 0x3d, 0xec,
+	0x3a, 0x95, 0x0d, 0x8c, 0x50, 0xf6, 0xf5, 0x99, 0x73, 0xe6, 0xd8, 0x95, 0x4c, 0x2a, 0xb3, 0x3a,
+	0x7d, 0x77, 0x60, 0xa5, 0x32, 0x1d, 0x63, 0x54, 0x76, 0xde, 0xb8, 0xfd, 0xa1, 0x7b, 0xe2, 0xf6,
+	0x5d, 0x85, 0x6d, 0xa6, 0xf4, 0x14, 0x73, 0xec, 0x4a, 0x16, 0x17, 0x50, 0xae, 0xd7, 0xb5, 0x7a,
+	0x47, 0x8e, 0x5d, 0xc9, 0xe1, 0x0a, 0x2a, 0x9e, 0xbb, 0xfd, 0x23, 0xdb, 0xb3, 0xce, 0xad, 0xd4,
+	0x39, 0x7f, 0x58, 0xfc, 0x74, 0xbd, 0xab, 0x7d, 0xbe, 0xde, 0xd5, 0xbe, 0x5e, 0xef, 0x6a, 0xa3,
+	0xac, 0xfa, 0x0f, 0x1c, 0xfc, 0x18, 0x00, 0x1c, 0x5f, 0xcc, 0x87, 0x94, 0x04, 0x00, 0x00,
 }
 
 func (m *ArchivedActiveSetChanges) Marshal() (dAtA []byte, err error) {
@@ -367,6 +566,109 @@ func (m *ArchivedCommitteeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArchivedValidatorLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedValidatorLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedValidatorLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedValidatorMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedValidatorMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedValidatorMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Approximate {
+		i--
+		if m.Approximate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QueuePosition != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.QueuePosition))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slot != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
 	offset -= sovArchive(v)
 	base := offset
@@ -449,6 +751,54 @@ func (m *ArchivedCommitteeInfo) Size() (n int) {
 	return n
 }
 
+func (m *ArchivedValidatorLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedValidatorMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovArchive(uint64(m.Type))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovArchive(uint64(m.Slot))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovArchive(uint64(m.Timestamp))
+	}
+	if m.QueuePosition != 0 {
+		n += 1 + sovArchive(uint64(m.QueuePosition))
+	}
+	if m.Approximate {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -961,9 +1311,267 @@ func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArchivedValidatorLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedValidatorLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedValidatorLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, &ArchivedValidatorMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedValidatorMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedValidatorMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedValidatorMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ArchivedValidatorMilestone_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
+			}
+			m.QueuePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuePosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approximate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approximate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -995,10 +1603,8 @@ func skipArchive(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -1019,55 +1625,30 @@ func skipArchive(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthArchive
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthArchive
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowArchive
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipArchive(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthArchive
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthArchive = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowArchive   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
 )
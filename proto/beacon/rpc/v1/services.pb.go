@@ -104,6 +104,108 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type DepositInfo_Classification int32
+
+const (
+	DepositInfo_NEW_VALIDATOR                  DepositInfo_Classification = 0
+	DepositInfo_TOP_UP                         DepositInfo_Classification = 1
+	DepositInfo_INVALID_SIGNATURE              DepositInfo_Classification = 2
+	DepositInfo_INVALID_WITHDRAWAL_CREDENTIALS DepositInfo_Classification = 3
+)
+
+var DepositInfo_Classification_name = map[int32]string{
+	0: "NEW_VALIDATOR",
+	1: "TOP_UP",
+	2: "INVALID_SIGNATURE",
+	3: "INVALID_WITHDRAWAL_CREDENTIALS",
+}
+
+var DepositInfo_Classification_value = map[string]int32{
+	"NEW_VALIDATOR":                  0,
+	"TOP_UP":                         1,
+	"INVALID_SIGNATURE":              2,
+	"INVALID_WITHDRAWAL_CREDENTIALS": 3,
+}
+
+func (x DepositInfo_Classification) String() string {
+	return proto.EnumName(DepositInfo_Classification_name, int32(x))
+}
+
+func (DepositInfo_Classification) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25, 0}
+}
+
+type DepositInfo_Inclusion int32
+
+const (
+	DepositInfo_PENDING  DepositInfo_Inclusion = 0
+	DepositInfo_INCLUDED DepositInfo_Inclusion = 1
+)
+
+var DepositInfo_Inclusion_name = map[int32]string{
+	0: "PENDING",
+	1: "INCLUDED",
+}
+
+var DepositInfo_Inclusion_value = map[string]int32{
+	"PENDING":  0,
+	"INCLUDED": 1,
+}
+
+func (x DepositInfo_Inclusion) String() string {
+	return proto.EnumName(DepositInfo_Inclusion_name, int32(x))
+}
+
+func (DepositInfo_Inclusion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25, 1}
+}
+
+type ValidatorMilestone_Type int32
+
+const (
+	ValidatorMilestone_DEPOSITED      ValidatorMilestone_Type = 0
+	ValidatorMilestone_INCLUDED       ValidatorMilestone_Type = 1
+	ValidatorMilestone_ELIGIBLE       ValidatorMilestone_Type = 2
+	ValidatorMilestone_QUEUED         ValidatorMilestone_Type = 3
+	ValidatorMilestone_ACTIVATED      ValidatorMilestone_Type = 4
+	ValidatorMilestone_EXIT_INITIATED ValidatorMilestone_Type = 5
+	ValidatorMilestone_EXITED         ValidatorMilestone_Type = 6
+	ValidatorMilestone_SLASHED        ValidatorMilestone_Type = 7
+	ValidatorMilestone_WITHDRAWABLE   ValidatorMilestone_Type = 8
+)
+
+var ValidatorMilestone_Type_name = map[int32]string{
+	0: "DEPOSITED",
+	1: "INCLUDED",
+	2: "ELIGIBLE",
+	3: "QUEUED",
+	4: "ACTIVATED",
+	5: "EXIT_INITIATED",
+	6: "EXITED",
+	7: "SLASHED",
+	8: "WITHDRAWABLE",
+}
+
+var ValidatorMilestone_Type_value = map[string]int32{
+	"DEPOSITED":      0,
+	"INCLUDED":       1,
+	"ELIGIBLE":       2,
+	"QUEUED":         3,
+	"ACTIVATED":      4,
+	"EXIT_INITIATED": 5,
+	"EXITED":         6,
+	"SLASHED":        7,
+	"WITHDRAWABLE":   8,
+}
+
+func (x ValidatorMilestone_Type) String() string {
+	return proto.EnumName(ValidatorMilestone_Type_name, int32(x))
+}
+
+func (ValidatorMilestone_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28, 0}
+}
+
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
@@ -1530,1814 +1632,1741 @@ func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
-	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
-	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
-	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
-	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+type ChainReorg struct {
+	OldHeadRoot          []byte   `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,2,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,3,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,6,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
-
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
-	0x15, 0x0f, 0x25, 0x59, 0xab, 0x7d, 0x92, 0x65, 0x7a, 0xec, 0xb5, 0x15, 0xed, 0x9f, 0xb8, 0x4c,
-	0xd2, 0xd8, 0x5b, 0x44, 0xb2, 0x95, 0x60, 0xd1, 0x26, 0x48, 0x03, 0xd9, 0xe2, 0xda, 0x82, 0x17,
-	0xb2, 0x42, 0x69, 0xed, 0x14, 0x39, 0x10, 0x23, 0x6a, 0x2c, 0x11, 0x91, 0x38, 0x34, 0x39, 0x12,
-	0xe2, 0x4b, 0x81, 0x5e, 0x5a, 0xf4, 0xd6, 0x1e, 0x8a, 0x1e, 0x8b, 0x7e, 0x84, 0xa2, 0x87, 0x7e,
-	0x85, 0x1c, 0xfb, 0x01, 0x7a, 0x28, 0xf6, 0x93, 0x04, 0x9c, 0x19, 0x52, 0xd4, 0x3f, 0x5b, 0xce,
-	0x8d, 0xf3, 0xde, 0xfb, 0xbd, 0xbf, 0x33, 0x6f, 0xde, 0x10, 0x34, 0xd7, 0xa3, 0x8c, 0x96, 0x3b,
-	0x04, 0x5b, 0xd4, 0x29, 0x7b, 0xae, 0x55, 0x1e, 0x1f, 0x95, 0x7d, 0xe2, 0x8d, 0x6d, 0x8b, 0xf8,
-	0x25, 0xce, 0x44, 0x3b, 0x84, 0xf5, 0x89, 0x47, 0x46, 0xc3, 0x92, 0x10, 0x2b, 0x79, 0xae, 0x55,
-	0x1a, 0x1f, 0x15, 0x9f, 0xf6, 0x28, 0xed, 0x0d, 0x48, 0x99, 0x4b, 0x75, 0x46, 0xd7, 0x65, 0x32,
-	0x74, 0xd9, 0xad, 0x00, 0x15, 0x3f, 0x20, 0xac, 0x5f, 0x1e, 0x1f, 0xe1, 0x81, 0xdb, 0xc7, 0x47,
-	0x52, 0xbf, 0xd9, 0x19, 0x50, 0xeb, 0x7b, 0x29, 0xf0, 0x62, 0x4a, 0x00, 0x33, 0x46, 0x7c, 0x86,
-	0x99, 0x4d, 0x1d, 0xc1, 0xd7, 0x2c, 0xc8, 0x1d, 0x07, 0xe2, 0x06, 0xb9, 0x19, 0x11, 0x9f, 0x21,
-	0x04, 0x29, 0x7f, 0x40, 0x59, 0x41, 0xd9, 0x53, 0xf6, 0x53, 0x06, 0xff, 0x46, 0x1f, 0xc2, 0xba,
-	0x87, 0x9d, 0x2e, 0xa6, 0xa6, 0x47, 0xc6, 0x04, 0x0f, 0x0a, 0x89, 0x3d, 0x65, 0x3f, 0x67, 0xe4,
-	0x04, 0xd1, 0xe0, 0x34, 0x54, 0x84, 0x4c, 0xcf, 0xc3, 0xd7, 0xd7, 0x36, 0xb3, 0x0b, 0x49, 0xce,
-	0x8f, 0xd6, 0xda, 0x21, 0x6c, 0x34, 0x3d, 0xea, 0x52, 0x9f, 0x18, 0xc4, 0x77, 0xa9, 0xe3, 0x13,
-	0xf4, 0x1c, 0x80, 0xbb, 0x69, 0x7a, 0x54, 0x5a, 0xcb, 0x19, 0x8f, 0x39, 0xc5, 0xa0, 0x94, 0x69,
-	0x7f, 0x56, 0x00, 0x55, 0x27, 0xce, 0x86, 0xde, 0x3d, 0x07, 0x70, 0x47, 0x9d, 0x81, 0x6d, 0x99,
-	0xdf, 0x93, 0xdb, 0x10, 0x25, 0x28, 0xe7, 0xe4, 0x16, 0xed, 0xc2, 0x23, 0x97, 0x5a, 0x66, 0xc7,
-	0x66, 0xd2, 0xc5, 0xb4, 0x4b, 0xad, 0x63, 0x7b, 0x12, 0x55, 0x32, 0x16, 0xd5, 0x27, 0xb0, 0x61,
-	0xd1, 0xe1, 0xd0, 0x66, 0x8c, 0x10, 0xd3, 0x76, 0xba, 0xe4, 0x87, 0x42, 0x8a, 0xb3, 0xf3, 0x11,
-	0xb9, 0x1e, 0x50, 0xb5, 0x8f, 0x20, 0x2f, 0x5c, 0x89, 0x9c, 0x47, 0x90, 0x8a, 0xb9, 0xcd, 0xbf,
-	0xb5, 0xbf, 0x07, 0x1e, 0xf7, 0x7a, 0x1e, 0xe9, 0x4d, 0x79, 0xbc, 0x28, 0x9f, 0x0b, 0x2c, 0x27,
-	0x16, 0x59, 0x9e, 0x09, 0x37, 0x39, 0x1b, 0xee, 0xc7, 0x90, 0x0f, 0xf4, 0x99, 0xbe, 0xdd, 0x73,
-	0x30, 0x1b, 0x79, 0x84, 0x07, 0x90, 0x33, 0xd6, 0x03, 0x6a, 0x2b, 0x24, 0x6a, 0x07, 0xb0, 0x35,
-	0xe5, 0xd8, 0x1d, 0x41, 0x18, 0xf0, 0xf4, 0x12, 0x0f, 0xec, 0x2e, 0x66, 0xd4, 0x6b, 0x12, 0xef,
-	0x9a, 0x7a, 0x43, 0xec, 0x58, 0xe4, 0xae, 0x60, 0x3e, 0x80, 0xec, 0xc4, 0x47, 0xbf, 0x90, 0xd8,
-	0x4b, 0xee, 0xe7, 0x0c, 0x88, 0x9c, 0xf4, 0xb5, 0xbf, 0x25, 0xe0, 0xd9, 0x62, 0xa5, 0xd2, 0x91,
-	0x22, 0x64, 0x3a, 0x78, 0x10, 0x90, 0xfc, 0x82, 0xb2, 0x97, 0xdc, 0x4f, 0x19, 0xd1, 0x1a, 0x1d,
-	0x80, 0xca, 0x28, 0xc3, 0x03, 0x73, 0x1c, 0x6a, 0xf0, 0x65, 0xae, 0x36, 0x38, 0x3d, 0x52, 0xec,
-	0xa3, 0x57, 0xb0, 0x2b, 0x44, 0xb1, 0xc5, 0xec, 0x31, 0x89, 0x23, 0x44, 0xd9, 0x9f, 0x70, 0x76,
-	0x95, 0x73, 0x63, 0xb8, 0x4f, 0x01, 0x0d, 0x6d, 0xdf, 0xb7, 0x9d, 0x5e, 0x1c, 0x92, 0xe2, 0x71,
-	0x6c, 0x4a, 0x4e, 0x4c, 0xfc, 0x14, 0xf6, 0xf0, 0x98, 0x78, 0xb8, 0x47, 0xe6, 0x0c, 0x99, 0xd2,
-	0xed, 0xc2, 0xda, 0x9e, 0xb2, 0x9f, 0x30, 0x9e, 0x4b, 0xb9, 0x19, 0x8b, 0xc7, 0x42, 0x48, 0xfb,
-	0x0a, 0x8a, 0x11, 0x8d, 0x8b, 0x4c, 0xed, 0x9b, 0x99, 0xb4, 0x2a, 0x73, 0x69, 0xfd, 0x47, 0x22,
-	0x56, 0xab, 0x38, 0x5e, 0x66, 0xf5, 0x15, 0x3c, 0xc1, 0x82, 0x4a, 0xba, 0xe6, 0x9c, 0xaa, 0xe3,
-	0x44, 0x41, 0x31, 0xb6, 0x22, 0x81, 0x66, 0xa4, 0x17, 0x5d, 0x42, 0x26, 0x38, 0x74, 0x23, 0x9f,
-	0x88, 0x62, 0x66, 0x2b, 0x5f, 0x94, 0x16, 0x77, 0xa6, 0xd2, 0x1d, 0xe6, 0x4b, 0x2d, 0xae, 0xc3,
-	0x88, 0x74, 0x15, 0x5d, 0x48, 0x0b, 0xda, 0x7d, 0x87, 0xf8, 0x14, 0xd2, 0x02, 0xc4, 0x0b, 0x9d,
-	0xad, 0x94, 0xef, 0x35, 0x2f, 0x6d, 0x49, 0xd3, 0x86, 0x84, 0x6b, 0x5f, 0xc0, 0xae, 0xfe, 0x83,
-	0xcd, 0x48, 0x77, 0x52, 0xbd, 0x95, 0xb3, 0xfb, 0x25, 0x14, 0xe6, 0xb1, 0x32, 0xb3, 0xf7, 0x82,
-	0xbf, 0x01, 0x74, 0xd2, 0xc7, 0xb6, 0xd3, 0x62, 0xd8, 0x9b, 0x34, 0x8d, 0x02, 0x3c, 0xf2, 0x03,
-	0x02, 0xe9, 0xf2, 0x98, 0x33, 0x46, 0xb8, 0x44, 0xbf, 0x80, 0x5c, 0x8f, 0x38, 0xc4, 0xb7, 0x7d,
-	0x93, 0xd9, 0x43, 0x22, 0x37, 0x78, 0x56, 0xd2, 0xda, 0xf6, 0x90, 0x68, 0xaf, 0xe0, 0x49, 0xe4,
-	0x09, 0xef, 0x0d, 0xab, 0x75, 0x44, 0xad, 0x04, 0x3b, 0xb3, 0x38, 0xe9, 0xce, 0x36, 0xac, 0x89,
-	0xd6, 0x23, 0x0e, 0xb3, 0x58, 0x68, 0x6f, 0x61, 0xb3, 0xea, 0x07, 0xfd, 0x64, 0x48, 0x1c, 0x16,
-	0xcb, 0x16, 0x71, 0xa9, 0xd5, 0x37, 0xb9, 0xc3, 0x12, 0x00, 0x9c, 0xc4, 0x43, 0xbc, 0xbf, 0x07,
-	0xfc, 0x25, 0x09, 0x28, 0xae, 0x57, 0xfa, 0x70, 0x03, 0xdb, 0x93, 0xc3, 0x83, 0x23, 0x3e, 0x4f,
-	0x69, 0xb6, 0xf2, 0xdb, 0x65, 0x85, 0x9f, 0xd7, 0x14, 0xdb, 0x8a, 0x13, 0xde, 0xd6, 0x78, 0x9e,
-	0x58, 0xfc, 0x63, 0x02, 0xb6, 0x16, 0x08, 0xa3, 0x67, 0xf0, 0x38, 0x6a, 0xbe, 0xb2, 0x0b, 0x4d,
-	0x08, 0xab, 0x77, 0xec, 0x0f, 0x61, 0x5d, 0xdc, 0xb1, 0xc4, 0x33, 0x63, 0x37, 0x4e, 0x2e, 0x24,
-	0xb6, 0xe4, 0x7d, 0xea, 0x8a, 0xeb, 0x50, 0x0a, 0x89, 0x7b, 0x27, 0x17, 0x12, 0xb9, 0xd0, 0x74,
-	0x61, 0xd7, 0x66, 0x4f, 0xc9, 0xd7, 0xd1, 0x29, 0x49, 0xef, 0x29, 0xfb, 0xf9, 0xca, 0x27, 0xab,
-	0x9e, 0x92, 0xf0, 0x74, 0xfc, 0x27, 0x01, 0xbb, 0x4b, 0x4e, 0x50, 0x4c, 0xb9, 0xf2, 0xb3, 0x94,
-	0xa3, 0xdf, 0xc0, 0xfb, 0x84, 0xf5, 0x8f, 0xcc, 0x2e, 0x71, 0xa9, 0x6f, 0x33, 0x31, 0x91, 0x98,
-	0xce, 0x68, 0xd8, 0x21, 0x9e, 0xcc, 0x5c, 0x30, 0xee, 0x1c, 0xd5, 0x04, 0x9f, 0x4f, 0x20, 0x0d,
-	0xce, 0x45, 0x9f, 0xc3, 0x4e, 0x88, 0xb2, 0x1d, 0x6b, 0x30, 0xf2, 0x6d, 0xea, 0xc4, 0x53, 0xb9,
-	0x2d, 0xb9, 0xf5, 0x90, 0xc9, 0xb3, 0x75, 0x00, 0x2a, 0x8e, 0x9a, 0x90, 0xc9, 0xb7, 0xa6, 0xcc,
-	0xea, 0xc6, 0x84, 0xae, 0x07, 0x64, 0xf4, 0x35, 0x3c, 0xe3, 0x0a, 0x02, 0x41, 0xdb, 0x31, 0x63,
-	0xb0, 0x9b, 0x11, 0x19, 0x89, 0xe6, 0x9d, 0x32, 0xde, 0x0f, 0x65, 0xea, 0xce, 0xa4, 0xbb, 0x7d,
-	0x13, 0x08, 0x68, 0x5f, 0xc1, 0x7a, 0x8d, 0x0e, 0xb1, 0x1d, 0xf5, 0xea, 0x6d, 0x58, 0x13, 0x16,
-	0xe5, 0x51, 0xe2, 0x0b, 0xb4, 0x03, 0xe9, 0x2e, 0x17, 0x0b, 0x67, 0x11, 0xb1, 0xd2, 0xbe, 0x84,
-	0x7c, 0x08, 0x97, 0xe9, 0x3e, 0x00, 0x35, 0xba, 0xc2, 0x4d, 0x89, 0x11, 0xaa, 0x36, 0x22, 0xba,
-	0x80, 0x68, 0x7f, 0x4d, 0xc0, 0x26, 0xcf, 0x56, 0xdb, 0x23, 0x93, 0x1b, 0xf4, 0x35, 0xa4, 0x98,
-	0x27, 0xf7, 0x6d, 0xb6, 0x52, 0x59, 0x56, 0xad, 0x39, 0x60, 0x29, 0x58, 0x34, 0x68, 0x97, 0x18,
-	0x1c, 0x5f, 0xfc, 0xb7, 0x02, 0x99, 0x90, 0x84, 0x7e, 0x0d, 0x6b, 0xbc, 0x6c, 0xdc, 0x95, 0x6c,
-	0x45, 0x9b, 0x68, 0x25, 0xac, 0x5f, 0x0a, 0x47, 0xca, 0xd2, 0x31, 0x37, 0x21, 0x66, 0x48, 0x01,
-	0x98, 0x99, 0xed, 0x12, 0x33, 0xb3, 0x5d, 0x70, 0xe1, 0xba, 0xd8, 0x63, 0xb6, 0x65, 0xbb, 0xfc,
-	0x72, 0x1a, 0x53, 0x46, 0xc2, 0x3b, 0x7a, 0x33, 0xce, 0xb9, 0x0c, 0x18, 0x41, 0x73, 0x91, 0x23,
-	0x00, 0x97, 0x13, 0x55, 0x05, 0x71, 0xfb, 0x07, 0x14, 0xed, 0x0d, 0x6c, 0x07, 0x4e, 0x73, 0x17,
-	0x82, 0xcd, 0x10, 0x96, 0xe5, 0x29, 0x3c, 0xe6, 0xe3, 0xd1, 0xb5, 0x47, 0x87, 0x32, 0x9f, 0x99,
-	0x80, 0xf0, 0xda, 0xa3, 0xc3, 0x60, 0x54, 0xe4, 0x4c, 0x46, 0xe5, 0x7e, 0x4c, 0x07, 0xcb, 0x36,
-	0x7d, 0x79, 0x06, 0xeb, 0xd1, 0xae, 0x36, 0xe8, 0x80, 0xa0, 0x2c, 0x3c, 0x7a, 0xdb, 0x38, 0x6f,
-	0x5c, 0x5c, 0x35, 0xd4, 0xf7, 0x50, 0x0e, 0x32, 0xd5, 0x76, 0x5b, 0x6f, 0xb5, 0x75, 0x43, 0x55,
-	0x82, 0x55, 0xd3, 0xb8, 0x68, 0x5e, 0xb4, 0x74, 0x43, 0x4d, 0xa0, 0x3c, 0x40, 0xf5, 0xf4, 0xd4,
-	0xd0, 0x4f, 0xab, 0xed, 0x0b, 0x43, 0x4d, 0xbe, 0xfc, 0xa7, 0x02, 0x1b, 0x33, 0x07, 0x04, 0x21,
-	0xc8, 0x4b, 0x65, 0x66, 0xab, 0x5d, 0x6d, 0xbf, 0x6d, 0xa9, 0xef, 0xa1, 0x6d, 0x50, 0x6b, 0x7a,
-	0xf3, 0xa2, 0x55, 0x6f, 0x9b, 0x86, 0x7e, 0xa2, 0xd7, 0x2f, 0xf5, 0x9a, 0xaa, 0x04, 0x92, 0x4d,
-	0xbd, 0x51, 0xab, 0x37, 0x4e, 0xcd, 0xea, 0x49, 0xbb, 0x7e, 0xa9, 0xab, 0x09, 0x04, 0x90, 0x96,
-	0xdf, 0xc9, 0x80, 0x5f, 0x6f, 0xd4, 0xdb, 0xf5, 0x6a, 0x5b, 0xaf, 0x99, 0xfa, 0xb7, 0xf5, 0xb6,
-	0x9a, 0x42, 0x2a, 0xe4, 0xae, 0xea, 0xed, 0xb3, 0x9a, 0x51, 0xbd, 0xaa, 0x1e, 0xbf, 0xd1, 0xd5,
-	0xb5, 0x00, 0x11, 0xf0, 0xf4, 0x9a, 0x9a, 0x0e, 0x10, 0xe2, 0xdb, 0x6c, 0xbd, 0xa9, 0xb6, 0xce,
-	0xf4, 0x9a, 0xfa, 0xa8, 0xf2, 0x3f, 0x05, 0x36, 0xaa, 0x61, 0x6f, 0x12, 0xef, 0x11, 0xd4, 0x07,
-	0x24, 0x53, 0x18, 0x9b, 0xc0, 0xd1, 0xcb, 0xa5, 0xdd, 0x78, 0x6e, 0x4c, 0x2f, 0xfe, 0x72, 0xc9,
-	0x5e, 0x89, 0x89, 0xd6, 0x30, 0xc3, 0xc8, 0x84, 0xcd, 0xd6, 0xa8, 0x33, 0xb4, 0xa7, 0x0c, 0x69,
-	0xf7, 0x83, 0xe3, 0x06, 0x16, 0x39, 0x13, 0xee, 0xef, 0xca, 0x8f, 0x4a, 0xf4, 0xf2, 0x88, 0xc2,
-	0xfb, 0x16, 0x72, 0xd2, 0x4f, 0xbe, 0x63, 0xd0, 0x47, 0x77, 0x1e, 0x97, 0x30, 0xa4, 0x15, 0xb6,
-	0x3f, 0xfa, 0x0e, 0x72, 0xd2, 0x98, 0x58, 0xaf, 0x80, 0x29, 0x2e, 0x6d, 0xad, 0x33, 0x0f, 0xa6,
-	0xca, 0x9f, 0x14, 0xd8, 0x0c, 0xc7, 0x78, 0x1a, 0x05, 0xe3, 0xc1, 0xae, 0xcc, 0xa0, 0x64, 0x91,
-	0xaa, 0xd3, 0x6d, 0x7a, 0x94, 0x5e, 0xdf, 0x51, 0xb0, 0xb9, 0x57, 0x4a, 0xf1, 0x57, 0x2b, 0xc9,
-	0x4a, 0x4f, 0xfe, 0x95, 0x01, 0x75, 0xb2, 0xaf, 0xa5, 0x23, 0xdf, 0x01, 0x88, 0x16, 0xc5, 0x0b,
-	0xfb, 0xf1, 0x32, 0x7d, 0x53, 0x8d, 0x73, 0x79, 0x19, 0x67, 0x1a, 0xe4, 0xef, 0x61, 0xf3, 0x0a,
-	0xdb, 0xec, 0x75, 0x7c, 0xd2, 0x44, 0x95, 0x07, 0x8d, 0xa5, 0xc2, 0xe0, 0x67, 0x3f, 0x63, 0x94,
-	0x3d, 0x54, 0x10, 0x85, 0xfc, 0xf4, 0x14, 0x85, 0x3e, 0xbd, 0x57, 0x51, 0x7c, 0x4a, 0x2b, 0x96,
-	0x56, 0x15, 0x97, 0x01, 0x0f, 0x60, 0xeb, 0x24, 0x1c, 0x2c, 0x62, 0x43, 0xca, 0xc1, 0x2a, 0x13,
-	0x91, 0xb0, 0xf8, 0x72, 0xf5, 0xe1, 0x09, 0xdd, 0xcc, 0xf7, 0xa9, 0x07, 0xc6, 0xf7, 0xd0, 0x19,
-	0x1d, 0xfd, 0x41, 0x81, 0xed, 0x45, 0x8f, 0x42, 0x74, 0x7f, 0x85, 0xe6, 0xdf, 0xa5, 0xc5, 0xcf,
-	0x1f, 0x06, 0x92, 0x3e, 0x8c, 0x40, 0x9d, 0x9d, 0xf1, 0xd1, 0xd2, 0x40, 0x96, 0xbc, 0x24, 0x8a,
-	0x87, 0xab, 0x03, 0xa4, 0xd9, 0xdf, 0x45, 0x9b, 0x79, 0xf2, 0x48, 0x40, 0x3b, 0x25, 0xf1, 0x97,
-	0xa7, 0x14, 0xfe, 0xe5, 0x29, 0xe9, 0x43, 0x97, 0xdd, 0x2e, 0x2f, 0xe3, 0xfc, 0x03, 0xe3, 0x50,
-	0x41, 0xe7, 0xb0, 0x7e, 0x82, 0x1d, 0xea, 0xd8, 0x16, 0x1e, 0x9c, 0x11, 0xdc, 0x5d, 0xaa, 0x76,
-	0x95, 0x6e, 0x76, 0x0e, 0x59, 0xd9, 0x83, 0x82, 0x50, 0xe2, 0x6d, 0x72, 0x0a, 0x72, 0x49, 0x07,
-	0x23, 0x87, 0x61, 0xef, 0x36, 0x90, 0x2a, 0x2e, 0x31, 0x78, 0x9c, 0xfb, 0xf1, 0xdd, 0x0b, 0xe5,
-	0xbf, 0xef, 0x5e, 0x28, 0xff, 0x7f, 0xf7, 0x42, 0xe9, 0xa4, 0x39, 0xf7, 0xb3, 0x9f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xfd, 0x4f, 0x4f, 0xa7, 0x17, 0x13, 0x00, 0x00,
+func (m *ChainReorg) Reset()         { *m = ChainReorg{} }
+func (m *ChainReorg) String() string { return proto.CompactTextString(m) }
+func (*ChainReorg) ProtoMessage()    {}
+func (*ChainReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+func (m *ChainReorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainReorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorg.Merge(m, src)
+}
+func (m *ChainReorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorg.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ChainReorg proto.InternalMessageInfo
 
-// AttesterServiceClient is the client API for AttesterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AttesterServiceClient interface {
-	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+func (m *ChainReorg) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
 }
 
-type attesterServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ChainReorg) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
+	}
+	return 0
 }
 
-func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
-	return &attesterServiceClient{cc}
+func (m *ChainReorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
 }
 
-func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
-	out := new(v1alpha1.AttestationData)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChainReorg) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
 	}
-	return out, nil
+	return 0
 }
 
-func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
-	out := new(AttestResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChainReorg) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
 	}
-	return out, nil
+	return nil
 }
 
-// AttesterServiceServer is the server API for AttesterService service.
-type AttesterServiceServer interface {
-	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+func (m *ChainReorg) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
+	}
+	return 0
 }
 
-// UnimplementedAttesterServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAttesterServiceServer struct {
+func (m *ChainReorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
 
-func (*UnimplementedAttesterServiceServer) RequestAttestation(ctx context.Context, req *AttestationRequest) (*v1alpha1.AttestationData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestAttestation not implemented")
-}
-func (*UnimplementedAttesterServiceServer) SubmitAttestation(ctx context.Context, req *v1alpha1.Attestation) (*AttestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestation not implemented")
+type ListDepositsRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Eth1Address          []byte   `protobuf:"bytes,2,opt,name=eth1_address,json=eth1Address,proto3" json:"eth1_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
-	s.RegisterService(&_AttesterService_serviceDesc, srv)
+func (m *ListDepositsRequest) Reset()         { *m = ListDepositsRequest{} }
+func (m *ListDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDepositsRequest) ProtoMessage()    {}
+func (*ListDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
 }
-
-func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ListDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.Attestation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+func (m *ListDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDepositsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _AttesterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
-	HandlerType: (*AttesterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestAttestation",
-			Handler:    _AttesterService_RequestAttestation_Handler,
-		},
-		{
-			MethodName: "SubmitAttestation",
-			Handler:    _AttesterService_SubmitAttestation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *ListDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDepositsRequest.Merge(m, src)
 }
-
-// ProposerServiceClient is the client API for ProposerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProposerServiceClient interface {
-	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+func (m *ListDepositsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type proposerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ListDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDepositsRequest.DiscardUnknown(m)
 }
 
-func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
-	return &proposerServiceClient{cc}
-}
+var xxx_messageInfo_ListDepositsRequest proto.InternalMessageInfo
 
-func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListDepositsRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
-	out := new(ProposeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListDepositsRequest) GetEth1Address() []byte {
+	if m != nil {
+		return m.Eth1Address
 	}
-	return out, nil
-}
-
-// ProposerServiceServer is the server API for ProposerService service.
-type ProposerServiceServer interface {
-	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+	return nil
 }
 
-// UnimplementedProposerServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedProposerServiceServer struct {
+type ListDepositsResponse struct {
+	Deposits             []*DepositInfo `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (*UnimplementedProposerServiceServer) RequestBlock(ctx context.Context, req *BlockRequest) (*v1alpha1.BeaconBlock, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBlock not implemented")
+func (m *ListDepositsResponse) Reset()         { *m = ListDepositsResponse{} }
+func (m *ListDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDepositsResponse) ProtoMessage()    {}
+func (*ListDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
-func (*UnimplementedProposerServiceServer) ProposeBlock(ctx context.Context, req *v1alpha1.BeaconBlock) (*ProposeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposeBlock not implemented")
+func (m *ListDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
-	s.RegisterService(&_ProposerService_serviceDesc, srv)
+func (m *ListDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDepositsResponse.Merge(m, src)
+}
+func (m *ListDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDepositsResponse.DiscardUnknown(m)
 }
 
-func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+var xxx_messageInfo_ListDepositsResponse proto.InternalMessageInfo
+
+func (m *ListDepositsResponse) GetDeposits() []*DepositInfo {
+	if m != nil {
+		return m.Deposits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.BeaconBlock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
+type DepositInfo struct {
+	Index                 uint64                     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey             []byte                     `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentials []byte                     `protobuf:"bytes,3,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	Amount                uint64                     `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Classification        DepositInfo_Classification `protobuf:"varint,5,opt,name=classification,proto3,enum=ethereum.beacon.rpc.v1.DepositInfo_Classification" json:"classification,omitempty"`
+	Eth1BlockNumber       uint64                     `protobuf:"varint,6,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	Eth1TxHash            []byte                     `protobuf:"bytes,7,opt,name=eth1_tx_hash,json=eth1TxHash,proto3" json:"eth1_tx_hash,omitempty"`
+	Eth1Address           []byte                     `protobuf:"bytes,8,opt,name=eth1_address,json=eth1Address,proto3" json:"eth1_address,omitempty"`
+	Inclusion             DepositInfo_Inclusion      `protobuf:"varint,9,opt,name=inclusion,proto3,enum=ethereum.beacon.rpc.v1.DepositInfo_Inclusion" json:"inclusion,omitempty"`
+	ValidatorIndex        uint64                     `protobuf:"varint,10,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ValidatorStatus       ValidatorStatus            `protobuf:"varint,11,opt,name=validator_status,json=validatorStatus,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"validator_status,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
+	XXX_unrecognized      []byte                     `json:"-"`
+	XXX_sizecache         int32                      `json:"-"`
+}
+
+func (m *DepositInfo) Reset()         { *m = DepositInfo{} }
+func (m *DepositInfo) String() string { return proto.CompactTextString(m) }
+func (*DepositInfo) ProtoMessage()    {}
+func (*DepositInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
+}
+func (m *DepositInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestBlock",
-			Handler:    _ProposerService_RequestBlock_Handler,
-		},
-		{
-			MethodName: "ProposeBlock",
-			Handler:    _ProposerService_ProposeBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *DepositInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositInfo.Merge(m, src)
 }
-
-// AggregatorServiceClient is the client API for AggregatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AggregatorServiceClient interface {
-	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
+func (m *DepositInfo) XXX_Size() int {
+	return m.Size()
 }
-
-type aggregatorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DepositInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositInfo.DiscardUnknown(m)
 }
 
-func NewAggregatorServiceClient(cc *grpc.ClientConn) AggregatorServiceClient {
-	return &aggregatorServiceClient{cc}
-}
+var xxx_messageInfo_DepositInfo proto.InternalMessageInfo
 
-func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error) {
-	out := new(AggregationResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DepositInfo) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-// AggregatorServiceServer is the server API for AggregatorService service.
-type AggregatorServiceServer interface {
-	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
+func (m *DepositInfo) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
 }
 
-// UnimplementedAggregatorServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAggregatorServiceServer struct {
+func (m *DepositInfo) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
 }
 
-func (*UnimplementedAggregatorServiceServer) SubmitAggregateAndProof(ctx context.Context, req *AggregationRequest) (*AggregationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateAndProof not implemented")
+func (m *DepositInfo) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
-	s.RegisterService(&_AggregatorService_serviceDesc, srv)
+func (m *DepositInfo) GetClassification() DepositInfo_Classification {
+	if m != nil {
+		return m.Classification
+	}
+	return DepositInfo_NEW_VALIDATOR
 }
 
-func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AggregationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, in)
+func (m *DepositInfo) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof",
+	return 0
+}
+
+func (m *DepositInfo) GetEth1TxHash() []byte {
+	if m != nil {
+		return m.Eth1TxHash
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, req.(*AggregationRequest))
+	return nil
+}
+
+func (m *DepositInfo) GetEth1Address() []byte {
+	if m != nil {
+		return m.Eth1Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _AggregatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
-	HandlerType: (*AggregatorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitAggregateAndProof",
-			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *DepositInfo) GetInclusion() DepositInfo_Inclusion {
+	if m != nil {
+		return m.Inclusion
+	}
+	return DepositInfo_PENDING
 }
 
-// ValidatorServiceClient is the client API for ValidatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ValidatorServiceClient interface {
-	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
-	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
-	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
-	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
-	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error)
-	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *DepositInfo) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
 }
 
-type validatorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DepositInfo) GetValidatorStatus() ValidatorStatus {
+	if m != nil {
+		return m.ValidatorStatus
+	}
+	return ValidatorStatus_UNKNOWN_STATUS
 }
 
-func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
-	return &validatorServiceClient{cc}
+type ValidatorTimelineRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
-	out := new(DomainResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorTimelineRequest) Reset()         { *m = ValidatorTimelineRequest{} }
+func (m *ValidatorTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTimelineRequest) ProtoMessage()    {}
+func (*ValidatorTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26}
+}
+func (m *ValidatorTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidatorTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTimelineRequest.Merge(m, src)
+}
+func (m *ValidatorTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTimelineRequest.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForActivationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorTimelineRequest proto.InternalMessageInfo
+
+func (m *ValidatorTimelineRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return x, nil
+	return nil
 }
 
-type ValidatorService_WaitForActivationClient interface {
-	Recv() (*ValidatorActivationResponse, error)
-	grpc.ClientStream
+type ValidatorTimelineResponse struct {
+	ValidatorIndex       uint64                `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	HasValidator         bool                  `protobuf:"varint,2,opt,name=has_validator,json=hasValidator,proto3" json:"has_validator,omitempty"`
+	Milestones           []*ValidatorMilestone `protobuf:"bytes,3,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-type validatorServiceWaitForActivationClient struct {
-	grpc.ClientStream
+func (m *ValidatorTimelineResponse) Reset()         { *m = ValidatorTimelineResponse{} }
+func (m *ValidatorTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTimelineResponse) ProtoMessage()    {}
+func (*ValidatorTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
+}
+func (m *ValidatorTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTimelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTimelineResponse.Merge(m, src)
+}
+func (m *ValidatorTimelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTimelineResponse.DiscardUnknown(m)
 }
 
-func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
-	m := new(ValidatorActivationResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorTimelineResponse proto.InternalMessageInfo
+
+func (m *ValidatorTimelineResponse) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
 	}
-	return m, nil
+	return 0
 }
 
-func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
-	out := new(ValidatorIndexResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorTimelineResponse) GetHasValidator() bool {
+	if m != nil {
+		return m.HasValidator
 	}
-	return out, nil
+	return false
 }
 
-func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
-	out := new(AssignmentResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorTimelineResponse) GetMilestones() []*ValidatorMilestone {
+	if m != nil {
+		return m.Milestones
 	}
-	return out, nil
+	return nil
 }
 
-func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
-	out := new(ValidatorStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ValidatorMilestone struct {
+	Type                 ValidatorMilestone_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ethereum.beacon.rpc.v1.ValidatorMilestone_Type" json:"type,omitempty"`
+	Epoch                uint64                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Timestamp            uint64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	QueuePosition        uint64                  `protobuf:"varint,5,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	Approximate          bool                    `protobuf:"varint,6,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	DepositIndex         uint64                  `protobuf:"varint,7,opt,name=deposit_index,json=depositIndex,proto3" json:"deposit_index,omitempty"`
+	Eth1BlockNumber      uint64                  `protobuf:"varint,8,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	Eth1TxHash           []byte                  `protobuf:"bytes,9,opt,name=eth1_tx_hash,json=eth1TxHash,proto3" json:"eth1_tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ValidatorMilestone) Reset()         { *m = ValidatorMilestone{} }
+func (m *ValidatorMilestone) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMilestone) ProtoMessage()    {}
+func (*ValidatorMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
+}
+func (m *ValidatorMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidatorMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMilestone.Merge(m, src)
+}
+func (m *ValidatorMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMilestone.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
-	out := new(ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorMilestone proto.InternalMessageInfo
+
+func (m *ValidatorMilestone) GetType() ValidatorMilestone_Type {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ValidatorMilestone_DEPOSITED
 }
 
-func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
-	out := new(ExitedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorMilestone) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *validatorServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForChainStart", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *ValidatorMilestone) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *ValidatorMilestone) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return x, nil
+	return 0
 }
 
-type ValidatorService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+func (m *ValidatorMilestone) GetQueuePosition() uint64 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
 }
 
-type validatorServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func (m *ValidatorMilestone) GetApproximate() bool {
+	if m != nil {
+		return m.Approximate
+	}
+	return false
 }
 
-func (x *validatorServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ValidatorMilestone) GetDepositIndex() uint64 {
+	if m != nil {
+		return m.DepositIndex
 	}
-	return m, nil
+	return 0
 }
 
-func (c *validatorServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorMilestone) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
 	}
-	return out, nil
+	return 0
 }
 
-func (c *validatorServiceClient) ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorMilestone) GetEth1TxHash() []byte {
+	if m != nil {
+		return m.Eth1TxHash
 	}
-	return out, nil
+	return nil
 }
 
-// ValidatorServiceServer is the server API for ValidatorService service.
-type ValidatorServiceServer interface {
-	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
-	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
-	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
-	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
-	WaitForChainStart(*types.Empty, ValidatorService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
-	ProposeExit(context.Context, *v1alpha1.VoluntaryExit) (*types.Empty, error)
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.DepositInfo_Classification", DepositInfo_Classification_name, DepositInfo_Classification_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.DepositInfo_Inclusion", DepositInfo_Inclusion_name, DepositInfo_Inclusion_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorMilestone_Type", ValidatorMilestone_Type_name, ValidatorMilestone_Type_value)
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
+	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
+	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
+	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
+	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*ChainReorg)(nil), "ethereum.beacon.rpc.v1.ChainReorg")
+	proto.RegisterType((*ListDepositsRequest)(nil), "ethereum.beacon.rpc.v1.ListDepositsRequest")
+	proto.RegisterType((*ListDepositsResponse)(nil), "ethereum.beacon.rpc.v1.ListDepositsResponse")
+	proto.RegisterType((*DepositInfo)(nil), "ethereum.beacon.rpc.v1.DepositInfo")
+	proto.RegisterType((*ValidatorTimelineRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorTimelineRequest")
+	proto.RegisterType((*ValidatorTimelineResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorTimelineResponse")
+	proto.RegisterType((*ValidatorMilestone)(nil), "ethereum.beacon.rpc.v1.ValidatorMilestone")
 }
 
-// UnimplementedValidatorServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedValidatorServiceServer struct {
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285)
 }
 
-func (*UnimplementedValidatorServiceServer) DomainData(ctx context.Context, req *DomainRequest) (*DomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DomainData not implemented")
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 2412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x19, 0xcb, 0x72, 0x1b, 0x59,
+	0x35, 0x2d, 0xcb, 0x8e, 0x7c, 0xf4, 0xb0, 0x74, 0xed, 0x38, 0x8a, 0xf2, 0x18, 0x4f, 0x27, 0x93,
+	0x71, 0x02, 0x91, 0x6d, 0xcd, 0x90, 0x62, 0x66, 0x6a, 0x98, 0x92, 0xad, 0x8e, 0x23, 0x62, 0x64,
+	0xa7, 0x25, 0xdb, 0x03, 0xb3, 0xe8, 0xba, 0x96, 0xae, 0xad, 0x66, 0x5a, 0x7d, 0x3b, 0xdd, 0x2d,
+	0xc5, 0x2e, 0xaa, 0xa8, 0x62, 0x03, 0x45, 0xc1, 0x02, 0x16, 0xc0, 0x92, 0xe2, 0x13, 0x28, 0x8a,
+	0x62, 0xc3, 0x07, 0xcc, 0x92, 0x0f, 0x60, 0x41, 0x65, 0xcb, 0x4f, 0x4c, 0xdd, 0x47, 0x3f, 0xf4,
+	0xb2, 0xe5, 0xd9, 0xe9, 0x9e, 0xd7, 0x3d, 0x8f, 0x7b, 0x1e, 0x7d, 0x04, 0xaa, 0xe3, 0x52, 0x9f,
+	0x6e, 0x9c, 0x10, 0xdc, 0xa6, 0xf6, 0x86, 0xeb, 0xb4, 0x37, 0x06, 0x5b, 0x1b, 0x1e, 0x71, 0x07,
+	0x66, 0x9b, 0x78, 0x65, 0x8e, 0x44, 0xab, 0xc4, 0xef, 0x12, 0x97, 0xf4, 0x7b, 0x65, 0x41, 0x56,
+	0x76, 0x9d, 0x76, 0x79, 0xb0, 0x55, 0xba, 0x7b, 0x46, 0xe9, 0x99, 0x45, 0x36, 0x38, 0xd5, 0x49,
+	0xff, 0x74, 0x83, 0xf4, 0x1c, 0xff, 0x42, 0x30, 0x95, 0xde, 0x23, 0x7e, 0x77, 0x63, 0xb0, 0x85,
+	0x2d, 0xa7, 0x8b, 0xb7, 0xa4, 0x7c, 0xe3, 0xc4, 0xa2, 0xed, 0xaf, 0x25, 0xc1, 0x83, 0x21, 0x02,
+	0xec, 0xfb, 0xc4, 0xf3, 0xb1, 0x6f, 0x52, 0x5b, 0xe0, 0xd5, 0x36, 0x64, 0xb6, 0x19, 0xb9, 0x4e,
+	0xde, 0xf4, 0x89, 0xe7, 0x23, 0x04, 0x49, 0xcf, 0xa2, 0x7e, 0x51, 0x59, 0x53, 0xd6, 0x93, 0x3a,
+	0xff, 0x8d, 0x1e, 0x42, 0xd6, 0xc5, 0x76, 0x07, 0x53, 0xc3, 0x25, 0x03, 0x82, 0xad, 0x62, 0x62,
+	0x4d, 0x59, 0xcf, 0xe8, 0x19, 0x01, 0xd4, 0x39, 0x0c, 0x95, 0x20, 0x75, 0xe6, 0xe2, 0xd3, 0x53,
+	0xd3, 0x37, 0x8b, 0x73, 0x1c, 0x1f, 0x9e, 0xd5, 0x4d, 0x58, 0x3a, 0x70, 0xa9, 0x43, 0x3d, 0xa2,
+	0x13, 0xcf, 0xa1, 0xb6, 0x47, 0xd0, 0x7d, 0x00, 0xae, 0xa6, 0xe1, 0x52, 0x79, 0x5b, 0x46, 0x5f,
+	0xe4, 0x10, 0x9d, 0x52, 0x5f, 0xfd, 0xad, 0x02, 0xa8, 0x1a, 0x29, 0x1b, 0x68, 0x77, 0x1f, 0xc0,
+	0xe9, 0x9f, 0x58, 0x66, 0xdb, 0xf8, 0x9a, 0x5c, 0x04, 0x5c, 0x02, 0xf2, 0x8a, 0x5c, 0xa0, 0xdb,
+	0x70, 0xd3, 0xa1, 0x6d, 0xe3, 0xc4, 0xf4, 0xa5, 0x8a, 0x0b, 0x0e, 0x6d, 0x6f, 0x9b, 0x91, 0x55,
+	0x73, 0x31, 0xab, 0x3e, 0x84, 0xa5, 0x36, 0xed, 0xf5, 0x4c, 0xdf, 0x27, 0xc4, 0x30, 0xed, 0x0e,
+	0x39, 0x2f, 0x26, 0x39, 0x3a, 0x17, 0x82, 0xeb, 0x0c, 0xaa, 0x3e, 0x82, 0x9c, 0x50, 0x25, 0x54,
+	0x1e, 0x41, 0x32, 0xa6, 0x36, 0xff, 0xad, 0xfe, 0x85, 0x69, 0x7c, 0x76, 0xe6, 0x92, 0xb3, 0x21,
+	0x8d, 0x27, 0xf9, 0x73, 0xc2, 0xcd, 0x89, 0x49, 0x37, 0x8f, 0x98, 0x3b, 0x37, 0x6a, 0xee, 0x07,
+	0x90, 0x63, 0xf2, 0x0c, 0xcf, 0x3c, 0xb3, 0xb1, 0xdf, 0x77, 0x09, 0x37, 0x20, 0xa3, 0x67, 0x19,
+	0xb4, 0x19, 0x00, 0xd5, 0x27, 0xb0, 0x3c, 0xa4, 0xd8, 0x25, 0x46, 0xe8, 0x70, 0xf7, 0x08, 0x5b,
+	0x66, 0x07, 0xfb, 0xd4, 0x3d, 0x20, 0xee, 0x29, 0x75, 0x7b, 0xd8, 0x6e, 0x93, 0xcb, 0x8c, 0x79,
+	0x0f, 0xd2, 0x91, 0x8e, 0x5e, 0x31, 0xb1, 0x36, 0xb7, 0x9e, 0xd1, 0x21, 0x54, 0xd2, 0x53, 0xff,
+	0x94, 0x80, 0x7b, 0x93, 0x85, 0x4a, 0x45, 0x4a, 0x90, 0x3a, 0xc1, 0x16, 0x03, 0x79, 0x45, 0x65,
+	0x6d, 0x6e, 0x3d, 0xa9, 0x87, 0x67, 0xf4, 0x04, 0xf2, 0x3e, 0xf5, 0xb1, 0x65, 0x0c, 0x02, 0x09,
+	0x9e, 0xf4, 0xd5, 0x12, 0x87, 0x87, 0x82, 0x3d, 0xf4, 0x1c, 0x6e, 0x0b, 0x52, 0xdc, 0xf6, 0xcd,
+	0x01, 0x89, 0x73, 0x88, 0xb0, 0xdf, 0xe2, 0xe8, 0x2a, 0xc7, 0xc6, 0xf8, 0x9e, 0x01, 0xea, 0x99,
+	0x9e, 0x67, 0xda, 0x67, 0x71, 0x96, 0x24, 0xb7, 0xa3, 0x20, 0x31, 0x31, 0xf2, 0x5d, 0x58, 0xc3,
+	0x03, 0xe2, 0xe2, 0x33, 0x32, 0x76, 0x91, 0x21, 0xd5, 0x2e, 0xce, 0xaf, 0x29, 0xeb, 0x09, 0xfd,
+	0xbe, 0xa4, 0x1b, 0xb9, 0x71, 0x5b, 0x10, 0xa9, 0x9f, 0x43, 0x29, 0x84, 0x71, 0x92, 0xa1, 0x77,
+	0x33, 0xe2, 0x56, 0x65, 0xcc, 0xad, 0x7f, 0x4d, 0xc0, 0xdd, 0x89, 0xfc, 0xd2, 0xab, 0xcf, 0xe1,
+	0x16, 0x16, 0x50, 0xd2, 0x31, 0xc6, 0x44, 0x6d, 0x27, 0x8a, 0x8a, 0xbe, 0x1c, 0x12, 0x1c, 0x84,
+	0x72, 0xd1, 0x11, 0xa4, 0x58, 0xd2, 0xf5, 0x3d, 0x22, 0x82, 0x99, 0xae, 0x7c, 0x5a, 0x9e, 0x5c,
+	0x99, 0xca, 0x97, 0x5c, 0x5f, 0x6e, 0x72, 0x19, 0x7a, 0x28, 0xab, 0xe4, 0xc0, 0x82, 0x80, 0x5d,
+	0x95, 0xc4, 0xbb, 0xb0, 0x20, 0x98, 0x78, 0xa0, 0xd3, 0x95, 0x8d, 0x2b, 0xaf, 0x97, 0x77, 0xc9,
+	0xab, 0x75, 0xc9, 0xae, 0x7e, 0x0a, 0xb7, 0xb5, 0x73, 0xd3, 0x27, 0x9d, 0x28, 0x7a, 0x33, 0x7b,
+	0xf7, 0x33, 0x28, 0x8e, 0xf3, 0x4a, 0xcf, 0x5e, 0xc9, 0xfc, 0x1a, 0xd0, 0x4e, 0x17, 0x9b, 0x76,
+	0xd3, 0xc7, 0x6e, 0x54, 0x34, 0x8a, 0x70, 0xd3, 0x63, 0x00, 0xd2, 0xe1, 0x36, 0xa7, 0xf4, 0xe0,
+	0x88, 0xde, 0x87, 0xcc, 0x19, 0xb1, 0x89, 0x67, 0x7a, 0x86, 0x6f, 0xf6, 0x88, 0x7c, 0xe0, 0x69,
+	0x09, 0x6b, 0x99, 0x3d, 0xa2, 0x3e, 0x87, 0x5b, 0xa1, 0x26, 0xbc, 0x36, 0xcc, 0x56, 0x11, 0xd5,
+	0x32, 0xac, 0x8e, 0xf2, 0x49, 0x75, 0x56, 0x60, 0x5e, 0x94, 0x1e, 0x91, 0xcc, 0xe2, 0xa0, 0x1e,
+	0x42, 0xa1, 0xea, 0xb1, 0x7a, 0xd2, 0x23, 0xb6, 0x1f, 0xf3, 0x16, 0x71, 0x68, 0xbb, 0x6b, 0x70,
+	0x85, 0x25, 0x03, 0x70, 0x10, 0x37, 0xf1, 0xea, 0x1a, 0xf0, 0x87, 0x39, 0x40, 0x71, 0xb9, 0x52,
+	0x87, 0x37, 0xb0, 0x12, 0x25, 0x0f, 0x0e, 0xf1, 0xdc, 0xa5, 0xe9, 0xca, 0x8f, 0xa6, 0x05, 0x7e,
+	0x5c, 0x52, 0xec, 0x29, 0x46, 0xb8, 0xe5, 0xc1, 0x38, 0xb0, 0xf4, 0xeb, 0x04, 0x2c, 0x4f, 0x20,
+	0x46, 0xf7, 0x60, 0x31, 0x2c, 0xbe, 0xb2, 0x0a, 0x45, 0x80, 0xd9, 0x2b, 0xf6, 0x43, 0xc8, 0x8a,
+	0x1e, 0x4b, 0x5c, 0x23, 0xd6, 0x71, 0x32, 0x01, 0xb0, 0x29, 0xfb, 0xa9, 0x23, 0xda, 0xa1, 0x24,
+	0x12, 0x7d, 0x27, 0x13, 0x00, 0x39, 0xd1, 0x70, 0x60, 0xe7, 0x47, 0xb3, 0xe4, 0x8b, 0x30, 0x4b,
+	0x16, 0xd6, 0x94, 0xf5, 0x5c, 0xe5, 0xc3, 0x59, 0xb3, 0x24, 0xc8, 0x8e, 0x7f, 0x25, 0xe0, 0xf6,
+	0x94, 0x0c, 0x8a, 0x09, 0x57, 0xbe, 0x93, 0x70, 0xf4, 0x09, 0xdc, 0x21, 0x7e, 0x77, 0xcb, 0xe8,
+	0x10, 0x87, 0x7a, 0xa6, 0x2f, 0x26, 0x12, 0xc3, 0xee, 0xf7, 0x4e, 0x88, 0x2b, 0x3d, 0xc7, 0xc6,
+	0x9d, 0xad, 0x9a, 0xc0, 0xf3, 0x09, 0xa4, 0xc1, 0xb1, 0xe8, 0x63, 0x58, 0x0d, 0xb8, 0x4c, 0xbb,
+	0x6d, 0xf5, 0x3d, 0x93, 0xda, 0x71, 0x57, 0xae, 0x48, 0x6c, 0x3d, 0x40, 0x72, 0x6f, 0x3d, 0x81,
+	0x3c, 0x0e, 0x8b, 0x90, 0xc1, 0x9f, 0xa6, 0xf4, 0xea, 0x52, 0x04, 0xd7, 0x18, 0x18, 0x7d, 0x01,
+	0xf7, 0xb8, 0x00, 0x46, 0x68, 0xda, 0x46, 0x8c, 0xed, 0x4d, 0x9f, 0xf4, 0x45, 0xf1, 0x4e, 0xea,
+	0x77, 0x02, 0x9a, 0xba, 0x1d, 0x55, 0xb7, 0xd7, 0x8c, 0x40, 0xfd, 0x1c, 0xb2, 0x35, 0xda, 0xc3,
+	0x66, 0x58, 0xab, 0x57, 0x60, 0x5e, 0xdc, 0x28, 0x53, 0x89, 0x1f, 0xd0, 0x2a, 0x2c, 0x74, 0x38,
+	0x59, 0x30, 0x8b, 0x88, 0x93, 0xfa, 0x19, 0xe4, 0x02, 0x76, 0xe9, 0xee, 0x27, 0x90, 0x0f, 0x5b,
+	0xb8, 0x21, 0x79, 0x84, 0xa8, 0xa5, 0x10, 0x2e, 0x58, 0xd4, 0x3f, 0x26, 0xa0, 0xc0, 0xbd, 0xd5,
+	0x72, 0x49, 0xd4, 0x41, 0x5f, 0x40, 0xd2, 0x77, 0xe5, 0xbb, 0x4d, 0x57, 0x2a, 0xd3, 0xa2, 0x35,
+	0xc6, 0x58, 0x66, 0x87, 0x06, 0xed, 0x10, 0x9d, 0xf3, 0x97, 0xfe, 0xa1, 0x40, 0x2a, 0x00, 0xa1,
+	0x1f, 0xc2, 0x3c, 0x0f, 0x1b, 0x57, 0x25, 0x5d, 0x51, 0x23, 0xa9, 0xc4, 0xef, 0x96, 0x83, 0x91,
+	0xb2, 0xbc, 0xcd, 0xaf, 0xe0, 0xa2, 0x75, 0xc1, 0x30, 0x32, 0xdb, 0x25, 0x46, 0x66, 0x3b, 0xd6,
+	0x70, 0x1d, 0xec, 0xfa, 0x66, 0xdb, 0x74, 0x78, 0x73, 0x1a, 0x50, 0x9f, 0x04, 0x3d, 0xba, 0x10,
+	0xc7, 0x1c, 0x31, 0x04, 0x2b, 0x2e, 0x72, 0x04, 0xe0, 0x74, 0x22, 0xaa, 0x20, 0xba, 0x3f, 0x83,
+	0xa8, 0x7b, 0xb0, 0xc2, 0x94, 0xe6, 0x2a, 0xb0, 0xc7, 0x10, 0x84, 0xe5, 0x2e, 0x2c, 0xf2, 0xf1,
+	0xe8, 0xd4, 0xa5, 0x3d, 0xe9, 0xcf, 0x14, 0x03, 0xbc, 0x70, 0x69, 0x8f, 0x8d, 0x8a, 0x1c, 0xe9,
+	0x53, 0xf9, 0x1e, 0x17, 0xd8, 0xb1, 0x45, 0xd5, 0x3f, 0x27, 0x00, 0x78, 0xf5, 0xd6, 0x09, 0x75,
+	0xcf, 0x90, 0x0a, 0x59, 0x6a, 0x75, 0x8c, 0x2e, 0xc1, 0x9d, 0xf8, 0xa8, 0x9a, 0xa6, 0x56, 0xe7,
+	0x25, 0xc1, 0x1d, 0x6e, 0x50, 0x9c, 0x86, 0xbf, 0x54, 0x59, 0xc0, 0x25, 0x0d, 0x7f, 0xa0, 0x2a,
+	0x64, 0x6d, 0xf2, 0x36, 0x26, 0x47, 0x4c, 0x73, 0x69, 0x9b, 0xbc, 0x8d, 0xcb, 0x09, 0x69, 0x62,
+	0x75, 0x21, 0xa0, 0xe1, 0x72, 0x36, 0x61, 0x85, 0x95, 0x1c, 0x6a, 0x1b, 0x7c, 0x40, 0x62, 0x85,
+	0x93, 0x8b, 0x13, 0x05, 0x02, 0x09, 0x5c, 0x55, 0xa2, 0x74, 0x3a, 0x99, 0x83, 0x0b, 0x5f, 0xe0,
+	0xc2, 0x47, 0x38, 0xf8, 0x1d, 0x2b, 0x30, 0xdf, 0x21, 0x8e, 0xdf, 0x2d, 0xde, 0x14, 0xef, 0x99,
+	0x1f, 0xd4, 0x63, 0x58, 0xde, 0x33, 0x3d, 0x5f, 0xa6, 0xac, 0x37, 0xe3, 0x48, 0xfe, 0x3e, 0x64,
+	0x78, 0x25, 0xc0, 0x9d, 0x8e, 0x4b, 0x3c, 0x4f, 0xbe, 0x86, 0x34, 0x83, 0x55, 0x05, 0x48, 0x3d,
+	0x86, 0x95, 0x61, 0xc1, 0x61, 0x15, 0x4a, 0xc9, 0x5c, 0xf7, 0xe4, 0xcb, 0x7e, 0x38, 0xed, 0x65,
+	0xd7, 0x82, 0x9a, 0x70, 0x4a, 0xf5, 0x90, 0x49, 0xfd, 0xf7, 0x3c, 0xa4, 0x63, 0x98, 0xc9, 0x2d,
+	0x6f, 0xc4, 0x80, 0xc4, 0xa8, 0x01, 0x3f, 0x80, 0xd5, 0xb7, 0xa6, 0xdf, 0xed, 0xb8, 0xf8, 0x2d,
+	0xb6, 0x8c, 0xb6, 0x4b, 0x3a, 0xc4, 0xf6, 0x4d, 0x6c, 0x79, 0x32, 0x82, 0xb7, 0x22, 0xec, 0x4e,
+	0x84, 0x64, 0xd9, 0x8f, 0x7b, 0xb4, 0x6f, 0x07, 0x41, 0x94, 0x27, 0xf4, 0x33, 0xc8, 0xb5, 0x2d,
+	0xd6, 0xea, 0x4e, 0xcd, 0x36, 0xaf, 0x29, 0x3c, 0x72, 0xb9, 0xe9, 0x49, 0x1b, 0x33, 0xa0, 0xbc,
+	0x33, 0xc4, 0xa9, 0x8f, 0x48, 0x42, 0x4f, 0xa1, 0xc0, 0x7d, 0x3d, 0x54, 0x6d, 0x45, 0x98, 0x97,
+	0x18, 0x22, 0x5e, 0x66, 0xd7, 0x64, 0x5c, 0xfc, 0x73, 0xa3, 0x8b, 0x3d, 0x11, 0xea, 0x8c, 0x0e,
+	0x0c, 0xd6, 0x3a, 0x7f, 0x89, 0xbd, 0xee, 0x58, 0xe4, 0x52, 0x63, 0x91, 0x43, 0xaf, 0x60, 0x31,
+	0xac, 0xd1, 0xc5, 0x45, 0x6e, 0xc7, 0xb3, 0x59, 0xec, 0x08, 0x6b, 0xb7, 0x1e, 0xf1, 0xb3, 0x1e,
+	0x1b, 0x0d, 0x03, 0x22, 0x4e, 0x20, 0x7a, 0xec, 0x60, 0x68, 0x82, 0x41, 0x3a, 0xe4, 0x23, 0x42,
+	0xd9, 0xa7, 0xd2, 0xd7, 0xeb, 0x53, 0x4b, 0x83, 0x61, 0x80, 0xfa, 0x73, 0xc8, 0x0d, 0x3b, 0x17,
+	0x15, 0x20, 0xdb, 0xd0, 0x8e, 0x8d, 0xa3, 0xea, 0x5e, 0xbd, 0x56, 0x6d, 0xed, 0xeb, 0xf9, 0x1b,
+	0x08, 0x60, 0xa1, 0xb5, 0x7f, 0x60, 0x1c, 0x1e, 0xe4, 0x15, 0x74, 0x0b, 0x0a, 0xf5, 0x06, 0x47,
+	0x1a, 0xcd, 0xfa, 0x6e, 0xa3, 0xda, 0x3a, 0xd4, 0xb5, 0x7c, 0x02, 0xa9, 0xf0, 0x20, 0x00, 0x1f,
+	0xd7, 0x5b, 0x2f, 0x6b, 0x7a, 0xf5, 0xb8, 0xba, 0x67, 0xec, 0xe8, 0x5a, 0x4d, 0x6b, 0xb4, 0xea,
+	0xd5, 0xbd, 0x66, 0x7e, 0x4e, 0x7d, 0x0c, 0x8b, 0xa1, 0x03, 0x50, 0x1a, 0x6e, 0x1e, 0x68, 0x8d,
+	0x5a, 0xbd, 0xb1, 0x9b, 0xbf, 0x81, 0x32, 0x90, 0xaa, 0x37, 0x76, 0xf6, 0x0e, 0x6b, 0x5a, 0x2d,
+	0xaf, 0xa8, 0x9f, 0x40, 0x31, 0xd4, 0x9b, 0x0d, 0x81, 0x96, 0x69, 0x93, 0x19, 0xc7, 0xbe, 0x7f,
+	0x2a, 0x70, 0x67, 0x02, 0xaf, 0x4c, 0xac, 0x09, 0x9e, 0x56, 0x26, 0x7a, 0xfa, 0x21, 0x64, 0xbb,
+	0xd8, 0x8b, 0x3e, 0x70, 0x78, 0x76, 0xa4, 0xf4, 0x4c, 0x17, 0x7b, 0xa1, 0x74, 0xf4, 0x63, 0x80,
+	0x9e, 0x69, 0xb1, 0xea, 0x61, 0xf3, 0x32, 0xce, 0x12, 0xf5, 0xe9, 0x95, 0x81, 0xf8, 0x49, 0xc0,
+	0xa2, 0xc7, 0xb8, 0xd5, 0xff, 0xcf, 0x01, 0x1a, 0x27, 0x41, 0x3b, 0x90, 0xf4, 0x2f, 0x1c, 0x22,
+	0xa7, 0x91, 0x8d, 0xd9, 0x85, 0x97, 0x5b, 0x17, 0x0e, 0x6b, 0x6e, 0x17, 0x0e, 0x89, 0xba, 0x74,
+	0x22, 0xde, 0xa5, 0x27, 0x6d, 0x06, 0xee, 0xc1, 0x22, 0x9b, 0xc3, 0x3d, 0x1f, 0xf7, 0x1c, 0x99,
+	0xbe, 0x11, 0x80, 0x7d, 0x75, 0xf3, 0x41, 0xc1, 0x08, 0x26, 0x04, 0x39, 0x31, 0x64, 0x39, 0xf4,
+	0x40, 0x02, 0xd1, 0x1a, 0xa4, 0xb1, 0xe3, 0xb8, 0xf4, 0xdc, 0xec, 0x61, 0x9f, 0xf0, 0x34, 0x4c,
+	0xe9, 0x71, 0x10, 0xf3, 0x6e, 0x34, 0xe9, 0xb0, 0x20, 0x88, 0x72, 0x9b, 0x09, 0x07, 0x1c, 0x16,
+	0x82, 0x89, 0x39, 0x9d, 0x9a, 0x2d, 0xa7, 0x17, 0x47, 0x73, 0x5a, 0xfd, 0x9d, 0x02, 0x49, 0xe6,
+	0x12, 0x94, 0x85, 0xc5, 0x9a, 0x76, 0xb0, 0xdf, 0xac, 0xb7, 0xb4, 0xda, 0xe8, 0xc3, 0x63, 0x27,
+	0x6d, 0xaf, 0xbe, 0x5b, 0xdf, 0xde, 0x63, 0x4f, 0x1a, 0x60, 0xe1, 0xf5, 0xa1, 0x76, 0xa8, 0xd5,
+	0xf2, 0x73, 0x8c, 0xad, 0xba, 0xd3, 0xaa, 0x1f, 0x55, 0x19, 0x5b, 0x12, 0x21, 0xc8, 0x69, 0x5f,
+	0xd6, 0x5b, 0x46, 0xbd, 0x51, 0x6f, 0xd5, 0x39, 0x6c, 0x9e, 0x91, 0x33, 0x98, 0x56, 0xcb, 0x2f,
+	0xb0, 0xc7, 0xdd, 0xdc, 0xab, 0x36, 0x5f, 0x6a, 0xb5, 0xfc, 0x4d, 0x94, 0x87, 0x4c, 0x98, 0x12,
+	0x4c, 0x72, 0xea, 0xe9, 0x4b, 0xc8, 0x86, 0x21, 0xd3, 0xa9, 0x45, 0x18, 0xfd, 0x61, 0xe3, 0x55,
+	0x63, 0xff, 0xb8, 0x21, 0x74, 0xaa, 0xb6, 0x5a, 0x5a, 0xb3, 0xa5, 0xe9, 0x42, 0xa7, 0x03, 0x7d,
+	0xff, 0x60, 0xbf, 0xa9, 0xe9, 0xf9, 0x04, 0xca, 0x01, 0x54, 0x77, 0x77, 0x75, 0x6d, 0x97, 0x67,
+	0xe6, 0xdc, 0xd3, 0xbf, 0x29, 0xb0, 0x34, 0x92, 0xe3, 0x4c, 0x39, 0x29, 0xcc, 0x68, 0xb6, 0xaa,
+	0xad, 0xc3, 0x66, 0xfe, 0x06, 0x5a, 0x81, 0xbc, 0x34, 0xdb, 0xd0, 0xb5, 0x1d, 0xad, 0x7e, 0xc4,
+	0xed, 0x45, 0x90, 0x93, 0x39, 0x68, 0x70, 0xeb, 0xa4, 0xd5, 0xf2, 0xf7, 0x1c, 0xc3, 0x87, 0x16,
+	0x1a, 0xcc, 0xb8, 0x7c, 0x72, 0xcc, 0x9a, 0x61, 0xc3, 0xa5, 0x63, 0xb4, 0x9a, 0x11, 0xda, 0x5f,
+	0xf9, 0xaf, 0x02, 0x4b, 0xd5, 0xe0, 0x33, 0x40, 0xac, 0xfe, 0x50, 0x17, 0x90, 0xcc, 0xe8, 0xd8,
+	0xb2, 0x0b, 0x4d, 0xcd, 0x9e, 0xf1, 0x8d, 0x58, 0xe9, 0xf1, 0x94, 0xb1, 0x2c, 0x46, 0x5a, 0xc3,
+	0x3e, 0x46, 0x06, 0x14, 0x9a, 0xfd, 0x93, 0x9e, 0x39, 0x74, 0x91, 0x7a, 0x35, 0x73, 0xe9, 0xf1,
+	0xe5, 0xca, 0x04, 0x45, 0xa5, 0xf2, 0x8d, 0x12, 0x2e, 0xf9, 0x42, 0xf3, 0xbe, 0x84, 0x8c, 0xd4,
+	0x93, 0x3f, 0x53, 0xf4, 0xe8, 0xd2, 0xc9, 0x34, 0x30, 0x69, 0x86, 0x49, 0x13, 0x7d, 0x05, 0x19,
+	0x79, 0x99, 0x38, 0xcf, 0xc0, 0x53, 0x9a, 0xda, 0x1d, 0x46, 0x76, 0x93, 0x95, 0xdf, 0x28, 0x50,
+	0x08, 0x36, 0x66, 0x34, 0x34, 0xc6, 0x85, 0xdb, 0xd2, 0x83, 0x12, 0x45, 0xaa, 0x76, 0xe7, 0xc0,
+	0xa5, 0xf4, 0xf4, 0x92, 0x80, 0x8d, 0x2d, 0x04, 0x4b, 0xdf, 0x9b, 0x89, 0x56, 0x6a, 0xf2, 0xf7,
+	0x14, 0xe4, 0xa3, 0x77, 0x2d, 0x15, 0xf9, 0x0a, 0x40, 0x7c, 0x0d, 0xf0, 0xc0, 0x7e, 0x30, 0xb5,
+	0xe1, 0xc6, 0xbf, 0x51, 0x4a, 0x8f, 0xaf, 0x22, 0x93, 0xbd, 0xe1, 0x97, 0x50, 0x38, 0xc6, 0xa6,
+	0xff, 0x22, 0xbe, 0xd4, 0x41, 0x95, 0x6b, 0x6d, 0x80, 0xc4, 0x85, 0x1f, 0x7d, 0x87, 0xad, 0xd1,
+	0xa6, 0x82, 0x28, 0xe4, 0x86, 0x17, 0x16, 0xe8, 0xd9, 0x95, 0x82, 0xe2, 0x0b, 0x91, 0x52, 0x79,
+	0x56, 0x72, 0x69, 0xb0, 0x05, 0xcb, 0x3b, 0xc1, 0x37, 0x7c, 0x6c, 0x1f, 0xf0, 0x64, 0x96, 0xe5,
+	0x83, 0xb8, 0xf1, 0xe9, 0xec, 0x7b, 0x0a, 0xf4, 0x66, 0xbc, 0x4e, 0x5d, 0xd3, 0xbe, 0xeb, 0xae,
+	0xc3, 0xd0, 0xaf, 0x14, 0x58, 0x99, 0xb4, 0x7f, 0x45, 0x57, 0x47, 0x68, 0x7c, 0x05, 0x5c, 0xfa,
+	0xf8, 0x7a, 0x4c, 0x52, 0x87, 0x3e, 0xe4, 0x47, 0xd7, 0x69, 0x68, 0xaa, 0x21, 0x53, 0x96, 0x76,
+	0xa5, 0xcd, 0xd9, 0x19, 0xe4, 0xb5, 0x3f, 0x0d, 0x1f, 0x73, 0xb4, 0x8f, 0x43, 0xab, 0x65, 0xf1,
+	0x87, 0x4a, 0x39, 0xf8, 0x43, 0xa5, 0xac, 0xb1, 0x3f, 0x54, 0xa6, 0x87, 0x71, 0x7c, 0x97, 0xb7,
+	0xa9, 0xa0, 0x57, 0x90, 0xdd, 0xc1, 0x36, 0xb5, 0xcd, 0x36, 0xb6, 0xd8, 0xc7, 0xd9, 0x54, 0xb1,
+	0xb3, 0x54, 0xb3, 0x57, 0x90, 0x96, 0x35, 0x88, 0x99, 0x82, 0x1e, 0x4d, 0x61, 0x39, 0xa2, 0x56,
+	0xdf, 0xf6, 0xb1, 0x7b, 0xc1, 0xa8, 0x4a, 0x53, 0x2e, 0xac, 0x60, 0xc8, 0x08, 0x8d, 0x65, 0xb9,
+	0x78, 0x0d, 0x85, 0xa6, 0xef, 0x12, 0xdc, 0x8b, 0xbe, 0x6a, 0xbd, 0x59, 0xb4, 0x9d, 0xe0, 0x04,
+	0xce, 0xbc, 0xa9, 0x54, 0x7e, 0x01, 0x39, 0x39, 0xce, 0x07, 0x97, 0x98, 0x90, 0x89, 0x7f, 0xc3,
+	0xa1, 0xa9, 0x55, 0x6e, 0xc2, 0x27, 0x64, 0xe9, 0xfb, 0xb3, 0x11, 0xcb, 0x9a, 0xf8, 0x7b, 0x05,
+	0xf2, 0x7b, 0xe6, 0x29, 0x69, 0x5f, 0xb4, 0x2d, 0x12, 0xdc, 0x7f, 0x0e, 0x85, 0xb1, 0x79, 0x17,
+	0x6d, 0x5e, 0xf9, 0x56, 0x47, 0xc6, 0xea, 0xd2, 0xd6, 0x35, 0x38, 0x84, 0x3a, 0xdb, 0x99, 0x6f,
+	0xde, 0x3d, 0x50, 0xfe, 0xf3, 0xee, 0x81, 0xf2, 0xbf, 0x77, 0x0f, 0x94, 0x93, 0x05, 0xee, 0xcf,
+	0x8f, 0xbe, 0x1d, 0x00, 0xf6, 0x5c, 0x24, 0x73, 0xf1, 0x1b, 0x00, 0x00,
 }
-func (*UnimplementedValidatorServiceServer) WaitForActivation(req *ValidatorActivationRequest, srv ValidatorService_WaitForActivationServer) error {
-	return status.Errorf(codes.Unimplemented, "method WaitForActivation not implemented")
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AttesterServiceClient is the client API for AttesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttesterServiceClient interface {
+	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
 }
-func (*UnimplementedValidatorServiceServer) ValidatorIndex(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorIndex not implemented")
+
+type attesterServiceClient struct {
+	cc *grpc.ClientConn
 }
-func (*UnimplementedValidatorServiceServer) CommitteeAssignment(ctx context.Context, req *AssignmentRequest) (*AssignmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitteeAssignment not implemented")
+
+func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
+	return &attesterServiceClient{cc}
 }
-func (*UnimplementedValidatorServiceServer) ValidatorStatus(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStatus not implemented")
+
+func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
+	out := new(v1alpha1.AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedValidatorServiceServer) ValidatorPerformance(ctx context.Context, req *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+
+func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedValidatorServiceServer) ExitedValidators(ctx context.Context, req *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExitedValidators not implemented")
+
+// AttesterServiceServer is the server API for AttesterService service.
+type AttesterServiceServer interface {
+	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
 }
-func (*UnimplementedValidatorServiceServer) WaitForChainStart(req *types.Empty, srv ValidatorService_WaitForChainStartServer) error {
-	return status.Errorf(codes.Unimplemented, "method WaitForChainStart not implemented")
+
+// UnimplementedAttesterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAttesterServiceServer struct {
 }
-func (*UnimplementedValidatorServiceServer) CanonicalHead(ctx context.Context, req *types.Empty) (*v1alpha1.BeaconBlock, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CanonicalHead not implemented")
+
+func (*UnimplementedAttesterServiceServer) RequestAttestation(ctx context.Context, req *AttestationRequest) (*v1alpha1.AttestationData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAttestation not implemented")
 }
-func (*UnimplementedValidatorServiceServer) ProposeExit(ctx context.Context, req *v1alpha1.VoluntaryExit) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposeExit not implemented")
+func (*UnimplementedAttesterServiceServer) SubmitAttestation(ctx context.Context, req *v1alpha1.Attestation) (*AttestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestation not implemented")
 }
 
-func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
-	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
+	s.RegisterService(&_AttesterService_serviceDesc, srv)
 }
 
-func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DomainRequest)
+func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).DomainData(ctx, in)
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ValidatorActivationRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
-}
-
-type ValidatorService_WaitForActivationServer interface {
-	Send(*ValidatorActivationResponse) error
-	grpc.ServerStream
-}
-
-type validatorServiceWaitForActivationServer struct {
-	grpc.ServerStream
-}
-
-func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
+func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.Attestation)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var _AttesterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
+	HandlerType: (*AttesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestAttestation",
+			Handler:    _AttesterService_RequestAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _AttesterService_SubmitAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ProposerServiceClient is the client API for ProposerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposerServiceClient interface {
+	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
 }
 
-func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorPerformanceRequest)
-	if err := dec(in); err != nil {
+type proposerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
+	return &proposerServiceClient{cc}
+}
+
+func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitedValidatorsRequest)
-	if err := dec(in); err != nil {
+func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ValidatorService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ValidatorServiceServer).WaitForChainStart(m, &validatorServiceWaitForChainStartServer{stream})
+// ProposerServiceServer is the server API for ProposerService service.
+type ProposerServiceServer interface {
+	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
 }
 
-type ValidatorService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
-	grpc.ServerStream
+// UnimplementedProposerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProposerServiceServer struct {
 }
 
-type validatorServiceWaitForChainStartServer struct {
-	grpc.ServerStream
+func (*UnimplementedProposerServiceServer) RequestBlock(ctx context.Context, req *BlockRequest) (*v1alpha1.BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBlock not implemented")
+}
+func (*UnimplementedProposerServiceServer) ProposeBlock(ctx context.Context, req *v1alpha1.BeaconBlock) (*ProposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBlock not implemented")
 }
 
-func (x *validatorServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
-	return x.ServerStream.SendMsg(m)
+func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
+	s.RegisterService(&_ProposerService_serviceDesc, srv)
 }
 
-func _ValidatorService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CanonicalHead(ctx, in)
+		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead",
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.VoluntaryExit)
+func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.BeaconBlock)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ProposeExit(ctx, in)
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit",
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ProposeExit(ctx, req.(*v1alpha1.VoluntaryExit))
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ValidatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
-	HandlerType: (*ValidatorServiceServer)(nil),
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "DomainData",
-			Handler:    _ValidatorService_DomainData_Handler,
-		},
-		{
-			MethodName: "ValidatorIndex",
-			Handler:    _ValidatorService_ValidatorIndex_Handler,
-		},
-		{
-			MethodName: "CommitteeAssignment",
-			Handler:    _ValidatorService_CommitteeAssignment_Handler,
-		},
-		{
-			MethodName: "ValidatorStatus",
-			Handler:    _ValidatorService_ValidatorStatus_Handler,
-		},
-		{
-			MethodName: "ValidatorPerformance",
-			Handler:    _ValidatorService_ValidatorPerformance_Handler,
-		},
-		{
-			MethodName: "ExitedValidators",
-			Handler:    _ValidatorService_ExitedValidators_Handler,
-		},
-		{
-			MethodName: "CanonicalHead",
-			Handler:    _ValidatorService_CanonicalHead_Handler,
-		},
-		{
-			MethodName: "ProposeExit",
-			Handler:    _ValidatorService_ProposeExit_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForActivation",
-			Handler:       _ValidatorService_WaitForActivation_Handler,
-			ServerStreams: true,
+			MethodName: "RequestBlock",
+			Handler:    _ProposerService_RequestBlock_Handler,
 		},
 		{
-			StreamName:    "WaitForChainStart",
-			Handler:       _ValidatorService_WaitForChainStart_Handler,
-			ServerStreams: true,
+			MethodName: "ProposeBlock",
+			Handler:    _ProposerService_ProposeBlock_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// AggregatorServiceClient is the client API for AggregatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AggregatorServiceClient interface {
+	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type aggregatorServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Graffiti) > 0 {
-		i -= len(m.Graffiti)
-		copy(dAtA[i:], m.Graffiti)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Graffiti)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RandaoReveal) > 0 {
-		i -= len(m.RandaoReveal)
-		copy(dAtA[i:], m.RandaoReveal)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Slot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func NewAggregatorServiceClient(cc *grpc.ClientConn) AggregatorServiceClient {
+	return &aggregatorServiceClient{cc}
 }
 
-func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error) {
+	out := new(AggregationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AggregatorServiceServer is the server API for AggregatorService service.
+type AggregatorServiceServer interface {
+	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
 }
 
-func (m *ProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedAggregatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAggregatorServiceServer struct {
 }
 
-func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAggregatorServiceServer) SubmitAggregateAndProof(ctx context.Context, req *AggregationRequest) (*AggregationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateAndProof not implemented")
 }
 
-func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
+	s.RegisterService(&_AggregatorService_serviceDesc, srv)
 }
 
-func (m *AttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CommitteeIndex != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-		i--
-		dAtA[i] = 0x20
+func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, in)
 	}
-	if len(m.PocBit) > 0 {
-		i -= len(m.PocBit)
-		copy(dAtA[i:], m.PocBit)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof",
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, req.(*AggregationRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var _AggregatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
+	HandlerType: (*AggregatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitAggregateAndProof",
+			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorServiceClient interface {
+	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error)
+	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
-func (m *AttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type validatorServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *AggregationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AggregationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *AggregationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.SlotSignature) > 0 {
-		i -= len(m.SlotSignature)
-		copy(dAtA[i:], m.SlotSignature)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.SlotSignature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x1a
+func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CommitteeIndex != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-		i--
-		dAtA[i] = 0x10
+	x := &validatorServiceWaitForActivationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *AggregationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ValidatorService_WaitForActivationClient interface {
+	Recv() (*ValidatorActivationResponse, error)
+	grpc.ClientStream
 }
 
-func (m *AggregationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type validatorServiceWaitForActivationClient struct {
+	grpc.ClientStream
 }
 
-func (m *AggregationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0xa
+func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
+	m := new(ValidatorActivationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
+	out := new(ValidatorIndexResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Slot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
+	out := new(ValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.AverageActiveValidatorBalance != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
-		i--
-		dAtA[i] = 0x2d
-	}
-	if len(m.MissingValidators) > 0 {
-		for iNdEx := len(m.MissingValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MissingValidators[iNdEx])
-			copy(dAtA[i:], m.MissingValidators[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.MissingValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.TotalActiveValidators != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TotalValidators != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Balances) > 0 {
-		dAtA2 := make([]byte, len(m.Balances)*10)
-		var j1 int
-		for _, num := range m.Balances {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintServices(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0xa
+func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
+	out := new(ExitedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ValidatorActivationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *validatorServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForChainStart", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	x := &validatorServiceWaitForChainStartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ValidatorActivationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Statuses) > 0 {
-		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServices(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for iNdEx := len(m.ActivatedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActivatedPublicKeys[iNdEx])
-			copy(dAtA[i:], m.ActivatedPublicKeys[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.ActivatedPublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type validatorServiceWaitForChainStartClient struct {
+	grpc.ClientStream
 }
 
-func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *validatorServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *ValidatorActivationResponse_Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServices(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
+func (c *validatorServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *validatorServiceClient) ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorServiceServer is the server API for ValidatorService service.
+type ValidatorServiceServer interface {
+	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
+	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
+	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+	WaitForChainStart(*types.Empty, ValidatorService_WaitForChainStartServer) error
+	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+	ProposeExit(context.Context, *v1alpha1.VoluntaryExit) (*types.Empty, error)
 }
 
-func (m *ExitedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedValidatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorServiceServer struct {
 }
 
-func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedValidatorServiceServer) DomainData(ctx context.Context, req *DomainRequest) (*DomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainData not implemented")
+}
+func (*UnimplementedValidatorServiceServer) WaitForActivation(req *ValidatorActivationRequest, srv ValidatorService_WaitForActivationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForActivation not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorIndex(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorIndex not implemented")
+}
+func (*UnimplementedValidatorServiceServer) CommitteeAssignment(ctx context.Context, req *AssignmentRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitteeAssignment not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorStatus(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStatus not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorPerformance(ctx context.Context, req *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ExitedValidators(ctx context.Context, req *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitedValidators not implemented")
+}
+func (*UnimplementedValidatorServiceServer) WaitForChainStart(req *types.Empty, srv ValidatorService_WaitForChainStartServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForChainStart not implemented")
+}
+func (*UnimplementedValidatorServiceServer) CanonicalHead(ctx context.Context, req *types.Empty) (*v1alpha1.BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalHead not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ProposeExit(ctx context.Context, req *v1alpha1.VoluntaryExit) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeExit not implemented")
 }
 
-func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
+	s.RegisterService(&_ValidatorService_serviceDesc, srv)
 }
 
-func (m *ExitedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).DomainData(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorActivationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
 }
 
-func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorService_WaitForActivationServer interface {
+	Send(*ValidatorActivationResponse) error
+	grpc.ServerStream
 }
 
-func (m *ChainStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type validatorServiceWaitForActivationServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GenesisTime != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
 	}
-	if m.Started {
-		i--
-		if m.Started {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
 	}
-	if m.Index != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
 	}
-	if m.EpochStart != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _ValidatorService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(ValidatorServiceServer).WaitForChainStart(m, &validatorServiceWaitForChainStartServer{stream})
 }
 
-func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
+	grpc.ServerStream
 }
 
-func (m *AssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ValidatorAssignment) > 0 {
-		for iNdEx := len(m.ValidatorAssignment) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServices(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type validatorServiceWaitForChainStartServer struct {
+	grpc.ServerStream
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *validatorServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ValidatorService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CanonicalHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ValidatorService_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.VoluntaryExit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, in)
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit",
 	}
-	if m.ProposerSlot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.ProposerSlot))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, req.(*v1alpha1.VoluntaryExit))
 	}
-	if m.AttesterSlot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.AttesterSlot))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DomainData",
+			Handler:    _ValidatorService_DomainData_Handler,
+		},
+		{
+			MethodName: "ValidatorIndex",
+			Handler:    _ValidatorService_ValidatorIndex_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignment",
+			Handler:    _ValidatorService_CommitteeAssignment_Handler,
+		},
+		{
+			MethodName: "ValidatorStatus",
+			Handler:    _ValidatorService_ValidatorStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ExitedValidators",
+			Handler:    _ValidatorService_ExitedValidators_Handler,
+		},
+		{
+			MethodName: "CanonicalHead",
+			Handler:    _ValidatorService_CanonicalHead_Handler,
+		},
+		{
+			MethodName: "ProposeExit",
+			Handler:    _ValidatorService_ProposeExit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForActivation",
+			Handler:       _ValidatorService_WaitForActivation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WaitForChainStart",
+			Handler:       _ValidatorService_WaitForChainStart_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ChainServiceClient is the client API for ChainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChainServiceClient interface {
+	StreamChainReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ChainService_StreamChainReorgsClient, error)
+}
+
+type chainServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChainServiceClient(cc *grpc.ClientConn) ChainServiceClient {
+	return &chainServiceClient{cc}
+}
+
+func (c *chainServiceClient) StreamChainReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ChainService_StreamChainReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChainService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ChainService/StreamChainReorgs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CommitteeIndex != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-		i--
-		dAtA[i] = 0x10
+	x := &chainServiceStreamChainReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.Committee) > 0 {
-		dAtA5 := make([]byte, len(m.Committee)*10)
-		var j4 int
-		for _, num := range m.Committee {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintServices(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+type ChainService_StreamChainReorgsClient interface {
+	Recv() (*ChainReorg, error)
+	grpc.ClientStream
+}
+
+type chainServiceStreamChainReorgsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainServiceStreamChainReorgsClient) Recv() (*ChainReorg, error) {
+	m := new(ChainReorg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChainServiceServer is the server API for ChainService service.
+type ChainServiceServer interface {
+	StreamChainReorgs(*types.Empty, ChainService_StreamChainReorgsServer) error
 }
 
-func (m *ValidatorStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedChainServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedChainServiceServer struct {
+}
+
+func (*UnimplementedChainServiceServer) StreamChainReorgs(req *types.Empty, srv ChainService_StreamChainReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChainReorgs not implemented")
+}
+
+func RegisterChainServiceServer(s *grpc.Server, srv ChainServiceServer) {
+	s.RegisterService(&_ChainService_serviceDesc, srv)
+}
+
+func _ChainService_StreamChainReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.PositionInActivationQueue != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
-		i--
-		dAtA[i] = 0x28
+	return srv.(ChainServiceServer).StreamChainReorgs(m, &chainServiceStreamChainReorgsServer{stream})
+}
+
+type ChainService_StreamChainReorgsServer interface {
+	Send(*ChainReorg) error
+	grpc.ServerStream
+}
+
+type chainServiceStreamChainReorgsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainServiceStreamChainReorgsServer) Send(m *ChainReorg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ChainService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ChainService",
+	HandlerType: (*ChainServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChainReorgs",
+			Handler:       _ChainService_StreamChainReorgs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// DepositServiceClient is the client API for DepositService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DepositServiceClient interface {
+	ListDeposits(ctx context.Context, in *ListDepositsRequest, opts ...grpc.CallOption) (*ListDepositsResponse, error)
+}
+
+type depositServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDepositServiceClient(cc *grpc.ClientConn) DepositServiceClient {
+	return &depositServiceClient{cc}
+}
+
+func (c *depositServiceClient) ListDeposits(ctx context.Context, in *ListDepositsRequest, opts ...grpc.CallOption) (*ListDepositsResponse, error) {
+	out := new(ListDepositsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DepositService/ListDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ActivationEpoch != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
-		i--
-		dAtA[i] = 0x20
+	return out, nil
+}
+
+// DepositServiceServer is the server API for DepositService service.
+type DepositServiceServer interface {
+	ListDeposits(context.Context, *ListDepositsRequest) (*ListDepositsResponse, error)
+}
+
+// UnimplementedDepositServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDepositServiceServer struct {
+}
+
+func (*UnimplementedDepositServiceServer) ListDeposits(ctx context.Context, req *ListDepositsRequest) (*ListDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeposits not implemented")
+}
+
+func RegisterDepositServiceServer(s *grpc.Server, srv DepositServiceServer) {
+	s.RegisterService(&_DepositService_serviceDesc, srv)
+}
+
+func _DepositService_ListDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DepositInclusionSlot != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(DepositServiceServer).ListDeposits(ctx, in)
 	}
-	if m.Eth1DepositBlockNumber != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DepositService/ListDeposits",
 	}
-	if m.Status != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).ListDeposits(ctx, req.(*ListDepositsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var _DepositService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.DepositService",
+	HandlerType: (*DepositServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeposits",
+			Handler:    _DepositService_ListDeposits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// LifecycleServiceClient is the client API for LifecycleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LifecycleServiceClient interface {
+	ValidatorTimeline(ctx context.Context, in *ValidatorTimelineRequest, opts ...grpc.CallOption) (*ValidatorTimelineResponse, error)
+}
+
+type lifecycleServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLifecycleServiceClient(cc *grpc.ClientConn) LifecycleServiceClient {
+	return &lifecycleServiceClient{cc}
+}
+
+func (c *lifecycleServiceClient) ValidatorTimeline(ctx context.Context, in *ValidatorTimelineRequest, opts ...grpc.CallOption) (*ValidatorTimelineResponse, error) {
+	out := new(ValidatorTimelineResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.LifecycleService/ValidatorTimeline", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LifecycleServiceServer is the server API for LifecycleService service.
+type LifecycleServiceServer interface {
+	ValidatorTimeline(context.Context, *ValidatorTimelineRequest) (*ValidatorTimelineResponse, error)
 }
 
-func (m *DomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedLifecycleServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLifecycleServiceServer struct {
+}
+
+func (*UnimplementedLifecycleServiceServer) ValidatorTimeline(ctx context.Context, req *ValidatorTimelineRequest) (*ValidatorTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTimeline not implemented")
+}
+
+func RegisterLifecycleServiceServer(s *grpc.Server, srv LifecycleServiceServer) {
+	s.RegisterService(&_LifecycleService_serviceDesc, srv)
+}
+
+func _LifecycleService_ValidatorTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(LifecycleServiceServer).ValidatorTimeline(ctx, in)
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.LifecycleService/ValidatorTimeline",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifecycleServiceServer).ValidatorTimeline(ctx, req.(*ValidatorTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+var _LifecycleService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.LifecycleService",
+	HandlerType: (*LifecycleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatorTimeline",
+			Handler:    _LifecycleService_ValidatorTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3347,12 +3376,12 @@ func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3361,15 +3390,29 @@ func (m *DomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SignatureDomain != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RandaoReveal) > 0 {
+		i -= len(m.RandaoReveal)
+		copy(dAtA[i:], m.RandaoReveal)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3379,12 +3422,12 @@ func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3393,24 +3436,17 @@ func (m *BlockTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tree) > 0 {
-		for iNdEx := len(m.Tree) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintServices(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3420,12 +3456,12 @@ func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockTreeResponse_TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3434,39 +3470,34 @@ func (m *BlockTreeResponse_TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TotalVotes != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.ParticipatedVotes != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+	if len(m.PocBit) > 0 {
+		i -= len(m.PocBit)
+		copy(dAtA[i:], m.PocBit)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServices(dAtA, i, uint64(size))
-		}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3476,12 +3507,12 @@ func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TreeBlockSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3490,559 +3521,3214 @@ func (m *TreeBlockSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SlotTo != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SlotFrom != 0 {
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
-	offset -= sovServices(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.RandaoReveal)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.Graffiti)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AggregationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ProposeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *AggregationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.PocBit)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.SlotSignature) > 0 {
+		i -= len(m.SlotSignature)
+		copy(dAtA[i:], m.SlotSignature)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.SlotSignature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AttestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AggregationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.SlotSignature)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *AggregationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPerformanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorPerformanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		l = 0
-		for _, e := range m.Balances {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
-	}
-	if m.TotalValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalValidators))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TotalActiveValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	if m.AverageActiveValidatorBalance != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
+		i--
+		dAtA[i] = 0x2d
 	}
 	if len(m.MissingValidators) > 0 {
-		for _, b := range m.MissingValidators {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.MissingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingValidators[iNdEx])
+			copy(dAtA[i:], m.MissingValidators[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.MissingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.AverageActiveValidatorBalance != 0 {
-		n += 5
+	if m.TotalActiveValidators != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TotalValidators != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Balances) > 0 {
+		dAtA2 := make([]byte, len(m.Balances)*10)
+		var j1 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintServices(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorActivationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorActivationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Statuses) > 0 {
-		for _, e := range m.Statuses {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ActivatedPublicKeys) > 0 {
+		for iNdEx := len(m.ActivatedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivatedPublicKeys[iNdEx])
+			copy(dAtA[i:], m.ActivatedPublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.ActivatedPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorActivationResponse_Status) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovServices(uint64(l))
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExitedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExitedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Started {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.GenesisTime != 0 {
-		n += 1 + sovServices(uint64(m.GenesisTime))
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovServices(uint64(m.Index))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Index != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AssignmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochStart != 0 {
-		n += 1 + sovServices(uint64(m.EpochStart))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.EpochStart != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.ValidatorAssignment) > 0 {
-		for _, e := range m.ValidatorAssignment {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.ValidatorAssignment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Committee) > 0 {
-		l = 0
-		for _, e := range m.Committee {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	if m.Status != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.AttesterSlot != 0 {
-		n += 1 + sovServices(uint64(m.AttesterSlot))
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.ProposerSlot != 0 {
-		n += 1 + sovServices(uint64(m.ProposerSlot))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+		i = encodeVarintServices(dAtA, i, uint64(m.ProposerSlot))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	if m.AttesterSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.AttesterSlot))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func (m *ValidatorStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Committee) > 0 {
+		dAtA5 := make([]byte, len(m.Committee)*10)
+		var j4 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintServices(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
-	}
-	if m.Eth1DepositBlockNumber != 0 {
-		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DepositInclusionSlot != 0 {
-		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	if m.PositionInActivationQueue != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.ActivationEpoch != 0 {
-		n += 1 + sovServices(uint64(m.ActivationEpoch))
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PositionInActivationQueue != 0 {
-		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	if m.DepositInclusionSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Eth1DepositBlockNumber != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Status != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovServices(uint64(m.Epoch))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SignatureDomain != 0 {
-		n += 1 + sovServices(uint64(m.SignatureDomain))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.SignatureDomain != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Tree) > 0 {
-		for _, e := range m.Tree {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+		for iNdEx := len(m.Tree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlockTreeResponse_TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovServices(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.TotalVotes != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.ParticipatedVotes != 0 {
-		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.TotalVotes != 0 {
-		n += 1 + sovServices(uint64(m.TotalVotes))
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeBlockSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlotTo != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlotFrom != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainReorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainReorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainReorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommonAncestorSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommonAncestorSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommonAncestorRoot) > 0 {
+		i -= len(m.CommonAncestorRoot)
+		copy(dAtA[i:], m.CommonAncestorRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.CommonAncestorRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NewHeadSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.NewHeadSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldHeadSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.OldHeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Eth1Address) > 0 {
+		i -= len(m.Eth1Address)
+		copy(dAtA[i:], m.Eth1Address)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Eth1Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ValidatorStatus != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ValidatorStatus))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Inclusion != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Inclusion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Eth1Address) > 0 {
+		i -= len(m.Eth1Address)
+		copy(dAtA[i:], m.Eth1Address)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Eth1Address)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Eth1TxHash) > 0 {
+		i -= len(m.Eth1TxHash)
+		copy(dAtA[i:], m.Eth1TxHash)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Eth1TxHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Eth1BlockNumber != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Classification != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Classification))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.WithdrawalCredentials) > 0 {
+		i -= len(m.WithdrawalCredentials)
+		copy(dAtA[i:], m.WithdrawalCredentials)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.WithdrawalCredentials)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorTimelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HasValidator {
+		i--
+		if m.HasValidator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Eth1TxHash) > 0 {
+		i -= len(m.Eth1TxHash)
+		copy(dAtA[i:], m.Eth1TxHash)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Eth1TxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Eth1BlockNumber != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1BlockNumber))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DepositIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Approximate {
+		i--
+		if m.Approximate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QueuePosition != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.QueuePosition))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServices(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.PocBit)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.SlotSignature)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.TotalValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalValidators))
+	}
+	if m.TotalActiveValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	}
+	if len(m.MissingValidators) > 0 {
+		for _, b := range m.MissingValidators {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse_Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovServices(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStart != 0 {
+		n += 1 + sovServices(uint64(m.EpochStart))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAssignment) > 0 {
+		for _, e := range m.ValidatorAssignment {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		l = 0
+		for _, e := range m.Committee {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.AttesterSlot != 0 {
+		n += 1 + sovServices(uint64(m.AttesterSlot))
+	}
+	if m.ProposerSlot != 0 {
+		n += 1 + sovServices(uint64(m.ProposerSlot))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	}
+	if m.DepositInclusionSlot != 0 {
+		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	}
+	if m.PositionInActivationQueue != 0 {
+		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureDomain != 0 {
+		n += 1 + sovServices(uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tree) > 0 {
+		for _, e := range m.Tree {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse_TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.ParticipatedVotes != 0 {
+		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovServices(uint64(m.TotalVotes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeBlockSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotFrom != 0 {
+		n += 1 + sovServices(uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		n += 1 + sovServices(uint64(m.SlotTo))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainReorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.OldHeadSlot))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.NewHeadSlot))
+	}
+	l = len(m.CommonAncestorRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.CommonAncestorSlot != 0 {
+		n += 1 + sovServices(uint64(m.CommonAncestorSlot))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovServices(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Eth1Address)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DepositInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovServices(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.WithdrawalCredentials)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovServices(uint64(m.Amount))
+	}
+	if m.Classification != 0 {
+		n += 1 + sovServices(uint64(m.Classification))
+	}
+	if m.Eth1BlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1BlockNumber))
+	}
+	l = len(m.Eth1TxHash)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Eth1Address)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Inclusion != 0 {
+		n += 1 + sovServices(uint64(m.Inclusion))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovServices(uint64(m.ValidatorIndex))
+	}
+	if m.ValidatorStatus != 0 {
+		n += 1 + sovServices(uint64(m.ValidatorStatus))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovServices(uint64(m.ValidatorIndex))
+	}
+	if m.HasValidator {
+		n += 2
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovServices(uint64(m.Type))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServices(uint64(m.Timestamp))
+	}
+	if m.QueuePosition != 0 {
+		n += 1 + sovServices(uint64(m.QueuePosition))
+	}
+	if m.Approximate {
+		n += 2
+	}
+	if m.DepositIndex != 0 {
+		n += 1 + sovServices(uint64(m.DepositIndex))
+	}
+	if m.Eth1BlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1BlockNumber))
+	}
+	l = len(m.Eth1TxHash)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServices(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServices(x uint64) (n int) {
+	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
+			if m.PocBit == nil {
+				m.PocBit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotSignature = append(m.SlotSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlotSignature == nil {
+				m.SlotSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Balances = append(m.Balances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Balances) == 0 {
+					m.Balances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Balances = append(m.Balances, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			}
+			m.TotalValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+			}
+			m.TotalActiveValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingValidators = append(m.MissingValidators, make([]byte, postIndex-iNdEx))
+			copy(m.MissingValidators[len(m.MissingValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TreeBlockSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SlotFrom != 0 {
-		n += 1 + sovServices(uint64(m.SlotFrom))
-	}
-	if m.SlotTo != 0 {
-		n += 1 + sovServices(uint64(m.SlotTo))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovServices(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozServices(x uint64) (n int) {
-	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4065,34 +6751,15 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4119,16 +6786,14 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandaoReveal == nil {
-				m.RandaoReveal = []byte{}
-			}
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4138,24 +6803,24 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
-			if m.Graffiti == nil {
-				m.Graffiti = []byte{}
+			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4183,7 +6848,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4206,15 +6871,15 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4241,9 +6906,45 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ValidatorStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4271,7 +6972,7 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4294,15 +6995,15 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4329,14 +7030,66 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4363,16 +7116,68 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
-			if m.PocBit == nil {
-				m.PocBit = []byte{}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.Slot = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4382,16 +7187,17 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Started = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
 			}
-			m.CommitteeIndex = 0
+			m.GenesisTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4401,7 +7207,7 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				m.GenesisTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4431,7 +7237,7 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4454,15 +7260,15 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4489,9 +7295,9 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4519,7 +7325,7 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4542,89 +7348,17 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
-			}
-			m.CommitteeIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var byteLen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4634,26 +7368,11 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlotSignature = append(m.SlotSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.SlotSignature == nil {
-				m.SlotSignature = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4679,7 +7398,7 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
+func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4702,15 +7421,34 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			m.EpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4737,10 +7475,8 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4767,7 +7503,7 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4790,36 +7526,17 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4829,23 +7546,25 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
+			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4872,7 +7591,7 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,10 +7614,10 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4918,7 +7637,7 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Balances = append(m.Balances, v)
+				m.Committee = append(m.Committee, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -4953,8 +7672,8 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Balances) == 0 {
-					m.Balances = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Committee) == 0 {
+					m.Committee = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -4972,16 +7691,35 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Balances = append(m.Balances, v)
+					m.Committee = append(m.Committee, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
 			}
-			m.TotalValidators = 0
+			m.AttesterSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4991,16 +7729,16 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalValidators |= uint64(b&0x7F) << shift
+				m.AttesterSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlot", wireType)
 			}
-			m.TotalActiveValidators = 0
+			m.ProposerSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5010,14 +7748,14 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				m.ProposerSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5044,20 +7782,30 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingValidators = append(m.MissingValidators, make([]byte, postIndex-iNdEx))
-			copy(m.MissingValidators[len(m.MissingValidators)-1], dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5083,7 +7831,7 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5106,17 +7854,17 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5126,24 +7874,87 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= ValidatorStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+			m.Eth1DepositBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
+			}
+			m.DepositInclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
+			}
+			m.PositionInActivationQueue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5169,7 +7980,7 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5192,17 +8003,17 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5212,29 +8023,16 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5244,24 +8042,24 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
-			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
+			if m.Domain == nil {
+				m.Domain = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5289,7 +8087,7 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
+func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5312,51 +8110,17 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
 			}
-			var msglen int
+			m.SignatureDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5366,28 +8130,11 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SignatureDomain |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &ValidatorStatusResponse{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5413,7 +8160,7 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5436,17 +8183,17 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5456,23 +8203,25 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
+			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5499,7 +8248,7 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5522,15 +8271,51 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5557,9 +8342,49 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
+			}
+			m.ParticipatedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipatedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			m.TotalVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5585,7 +8410,7 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
+func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5608,17 +8433,17 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
 			}
-			var v int
+			m.SlotFrom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5628,17 +8453,16 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SlotFrom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Started = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
 			}
-			m.GenesisTime = 0
+			m.SlotTo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5648,7 +8472,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GenesisTime |= uint64(b&0x7F) << shift
+				m.SlotTo |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5678,7 +8502,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainReorg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5701,15 +8525,15 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainReorg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainReorg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5736,70 +8560,16 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
 			}
-			m.Index = 0
+			m.OldHeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5809,70 +8579,50 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.OldHeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
 			}
-			m.EpochStart = 0
+			m.NewHeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5882,14 +8632,14 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochStart |= uint64(b&0x7F) << shift
+				m.NewHeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5916,9 +8666,49 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.CommonAncestorRoot = append(m.CommonAncestorRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestorRoot == nil {
+				m.CommonAncestorRoot = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorSlot", wireType)
+			}
+			m.CommonAncestorSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonAncestorSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5944,7 +8734,7 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *ListDepositsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5967,17 +8757,17 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDepositsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5987,24 +8777,58 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
-			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1Address = append(m.Eth1Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1Address == nil {
+				m.Eth1Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6032,7 +8856,7 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
+func (m *ListDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6042,163 +8866,30 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowServices
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Committee = append(m.Committee, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthServices
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthServices
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Committee) == 0 {
-					m.Committee = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowServices
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Committee = append(m.Committee, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
-			}
-			m.CommitteeIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
-			}
-			m.AttesterSlot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AttesterSlot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlot", wireType)
-			}
-			m.ProposerSlot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposerSlot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6208,45 +8899,26 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.Deposits = append(m.Deposits, &DepositInfo{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6272,7 +8944,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *DepositInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6295,17 +8967,17 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Status = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6315,16 +8987,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.Eth1DepositBlockNumber = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6334,16 +9006,31 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentials", wireType)
 			}
-			m.DepositInclusionSlot = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6353,16 +9040,31 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentials = append(m.WithdrawalCredentials[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentials == nil {
+				m.WithdrawalCredentials = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ActivationEpoch = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6372,16 +9074,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
 			}
-			m.PositionInActivationQueue = 0
+			m.Classification = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6391,70 +9093,35 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				m.Classification |= DepositInfo_Classification(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DomainRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockNumber", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Eth1BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1TxHash", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6464,14 +9131,29 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1TxHash = append(m.Eth1TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1TxHash == nil {
+				m.Eth1TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6498,11 +9180,68 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
-			if m.Domain == nil {
-				m.Domain = []byte{}
+			m.Eth1Address = append(m.Eth1Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1Address == nil {
+				m.Eth1Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inclusion", wireType)
+			}
+			m.Inclusion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inclusion |= DepositInfo_Inclusion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorStatus", wireType)
+			}
+			m.ValidatorStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorStatus |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6528,7 +9267,7 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DomainResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorTimelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6551,17 +9290,17 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorTimelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.SignatureDomain = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6571,11 +9310,26 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignatureDomain |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6601,7 +9355,7 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorTimelineResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6624,15 +9378,54 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorTimelineResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasValidator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasValidator = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6659,8 +9452,8 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
-			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Milestones = append(m.Milestones, &ValidatorMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6689,7 +9482,7 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
+func (m *ValidatorMilestone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6712,17 +9505,17 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorMilestone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6732,33 +9525,16 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= ValidatorMilestone_Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Block == nil {
-				m.Block = &v1alpha1.BeaconBlock{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6768,31 +9544,16 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.ParticipatedVotes = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6802,16 +9563,16 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParticipatedVotes |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.TotalVotes = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6821,70 +9582,74 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotes |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
+			m.QueuePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuePosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approximate", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Approximate = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockNumber", wireType)
 			}
-			m.SlotFrom = 0
+			m.Eth1BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6894,16 +9659,16 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotFrom |= uint64(b&0x7F) << shift
+				m.Eth1BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1TxHash", wireType)
 			}
-			m.SlotTo = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -6913,11 +9678,26 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotTo |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1TxHash = append(m.Eth1TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1TxHash == nil {
+				m.Eth1TxHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -6946,6 +9726,7 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 func skipServices(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6977,10 +9758,8 @@ func skipServices(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -7001,55 +9780,30 @@ func skipServices(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthServices
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthServices
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipServices(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthServices
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupServices
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthServices
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthServices = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowServices   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthServices        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowServices          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupServices = fmt.Errorf("proto: unexpected end of group")
 )
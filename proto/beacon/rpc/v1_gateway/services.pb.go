@@ -101,6 +101,108 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type DepositInfo_Classification int32
+
+const (
+	DepositInfo_NEW_VALIDATOR                  DepositInfo_Classification = 0
+	DepositInfo_TOP_UP                         DepositInfo_Classification = 1
+	DepositInfo_INVALID_SIGNATURE              DepositInfo_Classification = 2
+	DepositInfo_INVALID_WITHDRAWAL_CREDENTIALS DepositInfo_Classification = 3
+)
+
+var DepositInfo_Classification_name = map[int32]string{
+	0: "NEW_VALIDATOR",
+	1: "TOP_UP",
+	2: "INVALID_SIGNATURE",
+	3: "INVALID_WITHDRAWAL_CREDENTIALS",
+}
+
+var DepositInfo_Classification_value = map[string]int32{
+	"NEW_VALIDATOR":                  0,
+	"TOP_UP":                         1,
+	"INVALID_SIGNATURE":              2,
+	"INVALID_WITHDRAWAL_CREDENTIALS": 3,
+}
+
+func (x DepositInfo_Classification) String() string {
+	return proto.EnumName(DepositInfo_Classification_name, int32(x))
+}
+
+func (DepositInfo_Classification) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25, 0}
+}
+
+type DepositInfo_Inclusion int32
+
+const (
+	DepositInfo_PENDING  DepositInfo_Inclusion = 0
+	DepositInfo_INCLUDED DepositInfo_Inclusion = 1
+)
+
+var DepositInfo_Inclusion_name = map[int32]string{
+	0: "PENDING",
+	1: "INCLUDED",
+}
+
+var DepositInfo_Inclusion_value = map[string]int32{
+	"PENDING":  0,
+	"INCLUDED": 1,
+}
+
+func (x DepositInfo_Inclusion) String() string {
+	return proto.EnumName(DepositInfo_Inclusion_name, int32(x))
+}
+
+func (DepositInfo_Inclusion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25, 1}
+}
+
+type ValidatorMilestone_Type int32
+
+const (
+	ValidatorMilestone_DEPOSITED      ValidatorMilestone_Type = 0
+	ValidatorMilestone_INCLUDED       ValidatorMilestone_Type = 1
+	ValidatorMilestone_ELIGIBLE       ValidatorMilestone_Type = 2
+	ValidatorMilestone_QUEUED         ValidatorMilestone_Type = 3
+	ValidatorMilestone_ACTIVATED      ValidatorMilestone_Type = 4
+	ValidatorMilestone_EXIT_INITIATED ValidatorMilestone_Type = 5
+	ValidatorMilestone_EXITED         ValidatorMilestone_Type = 6
+	ValidatorMilestone_SLASHED        ValidatorMilestone_Type = 7
+	ValidatorMilestone_WITHDRAWABLE   ValidatorMilestone_Type = 8
+)
+
+var ValidatorMilestone_Type_name = map[int32]string{
+	0: "DEPOSITED",
+	1: "INCLUDED",
+	2: "ELIGIBLE",
+	3: "QUEUED",
+	4: "ACTIVATED",
+	5: "EXIT_INITIATED",
+	6: "EXITED",
+	7: "SLASHED",
+	8: "WITHDRAWABLE",
+}
+
+var ValidatorMilestone_Type_value = map[string]int32{
+	"DEPOSITED":      0,
+	"INCLUDED":       1,
+	"ELIGIBLE":       2,
+	"QUEUED":         3,
+	"ACTIVATED":      4,
+	"EXIT_INITIATED": 5,
+	"EXITED":         6,
+	"SLASHED":        7,
+	"WITHDRAWABLE":   8,
+}
+
+func (x ValidatorMilestone_Type) String() string {
+	return proto.EnumName(ValidatorMilestone_Type_name, int32(x))
+}
+
+func (ValidatorMilestone_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28, 0}
+}
+
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
@@ -1327,9 +1429,501 @@ func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
 	return 0
 }
 
+type ChainReorg struct {
+	OldHeadRoot          []byte   `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,2,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,3,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,6,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainReorg) Reset()         { *m = ChainReorg{} }
+func (m *ChainReorg) String() string { return proto.CompactTextString(m) }
+func (*ChainReorg) ProtoMessage()    {}
+func (*ChainReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+
+func (m *ChainReorg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainReorg.Unmarshal(m, b)
+}
+func (m *ChainReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainReorg.Marshal(b, m, deterministic)
+}
+func (m *ChainReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorg.Merge(m, src)
+}
+func (m *ChainReorg) XXX_Size() int {
+	return xxx_messageInfo_ChainReorg.Size(m)
+}
+func (m *ChainReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReorg proto.InternalMessageInfo
+
+func (m *ChainReorg) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
+}
+
+func (m *ChainReorg) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
+	}
+	return 0
+}
+
+func (m *ChainReorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
+}
+
+func (m *ChainReorg) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
+	}
+	return 0
+}
+
+func (m *ChainReorg) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
+	}
+	return nil
+}
+
+func (m *ChainReorg) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
+	}
+	return 0
+}
+
+func (m *ChainReorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type ListDepositsRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Eth1Address          []byte   `protobuf:"bytes,2,opt,name=eth1_address,json=eth1Address,proto3" json:"eth1_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDepositsRequest) Reset()         { *m = ListDepositsRequest{} }
+func (m *ListDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDepositsRequest) ProtoMessage()    {}
+func (*ListDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+
+func (m *ListDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDepositsRequest.Unmarshal(m, b)
+}
+func (m *ListDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDepositsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDepositsRequest.Merge(m, src)
+}
+func (m *ListDepositsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDepositsRequest.Size(m)
+}
+func (m *ListDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDepositsRequest proto.InternalMessageInfo
+
+func (m *ListDepositsRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ListDepositsRequest) GetEth1Address() []byte {
+	if m != nil {
+		return m.Eth1Address
+	}
+	return nil
+}
+
+type ListDepositsResponse struct {
+	Deposits             []*DepositInfo `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListDepositsResponse) Reset()         { *m = ListDepositsResponse{} }
+func (m *ListDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDepositsResponse) ProtoMessage()    {}
+func (*ListDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
+}
+
+func (m *ListDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDepositsResponse.Unmarshal(m, b)
+}
+func (m *ListDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDepositsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDepositsResponse.Merge(m, src)
+}
+func (m *ListDepositsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDepositsResponse.Size(m)
+}
+func (m *ListDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDepositsResponse proto.InternalMessageInfo
+
+func (m *ListDepositsResponse) GetDeposits() []*DepositInfo {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+type DepositInfo struct {
+	Index                 uint64                     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey             []byte                     `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentials []byte                     `protobuf:"bytes,3,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	Amount                uint64                     `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Classification        DepositInfo_Classification `protobuf:"varint,5,opt,name=classification,proto3,enum=ethereum.beacon.rpc.v1.DepositInfo_Classification" json:"classification,omitempty"`
+	Eth1BlockNumber       uint64                     `protobuf:"varint,6,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	Eth1TxHash            []byte                     `protobuf:"bytes,7,opt,name=eth1_tx_hash,json=eth1TxHash,proto3" json:"eth1_tx_hash,omitempty"`
+	Eth1Address           []byte                     `protobuf:"bytes,8,opt,name=eth1_address,json=eth1Address,proto3" json:"eth1_address,omitempty"`
+	Inclusion             DepositInfo_Inclusion      `protobuf:"varint,9,opt,name=inclusion,proto3,enum=ethereum.beacon.rpc.v1.DepositInfo_Inclusion" json:"inclusion,omitempty"`
+	ValidatorIndex        uint64                     `protobuf:"varint,10,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ValidatorStatus       ValidatorStatus            `protobuf:"varint,11,opt,name=validator_status,json=validatorStatus,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"validator_status,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
+	XXX_unrecognized      []byte                     `json:"-"`
+	XXX_sizecache         int32                      `json:"-"`
+}
+
+func (m *DepositInfo) Reset()         { *m = DepositInfo{} }
+func (m *DepositInfo) String() string { return proto.CompactTextString(m) }
+func (*DepositInfo) ProtoMessage()    {}
+func (*DepositInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
+}
+
+func (m *DepositInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositInfo.Unmarshal(m, b)
+}
+func (m *DepositInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositInfo.Marshal(b, m, deterministic)
+}
+func (m *DepositInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositInfo.Merge(m, src)
+}
+func (m *DepositInfo) XXX_Size() int {
+	return xxx_messageInfo_DepositInfo.Size(m)
+}
+func (m *DepositInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositInfo proto.InternalMessageInfo
+
+func (m *DepositInfo) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositInfo) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DepositInfo) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (m *DepositInfo) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *DepositInfo) GetClassification() DepositInfo_Classification {
+	if m != nil {
+		return m.Classification
+	}
+	return DepositInfo_NEW_VALIDATOR
+}
+
+func (m *DepositInfo) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
+	}
+	return 0
+}
+
+func (m *DepositInfo) GetEth1TxHash() []byte {
+	if m != nil {
+		return m.Eth1TxHash
+	}
+	return nil
+}
+
+func (m *DepositInfo) GetEth1Address() []byte {
+	if m != nil {
+		return m.Eth1Address
+	}
+	return nil
+}
+
+func (m *DepositInfo) GetInclusion() DepositInfo_Inclusion {
+	if m != nil {
+		return m.Inclusion
+	}
+	return DepositInfo_PENDING
+}
+
+func (m *DepositInfo) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *DepositInfo) GetValidatorStatus() ValidatorStatus {
+	if m != nil {
+		return m.ValidatorStatus
+	}
+	return ValidatorStatus_UNKNOWN_STATUS
+}
+
+type ValidatorTimelineRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorTimelineRequest) Reset()         { *m = ValidatorTimelineRequest{} }
+func (m *ValidatorTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTimelineRequest) ProtoMessage()    {}
+func (*ValidatorTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26}
+}
+
+func (m *ValidatorTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorTimelineRequest.Unmarshal(m, b)
+}
+func (m *ValidatorTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorTimelineRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTimelineRequest.Merge(m, src)
+}
+func (m *ValidatorTimelineRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorTimelineRequest.Size(m)
+}
+func (m *ValidatorTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTimelineRequest proto.InternalMessageInfo
+
+func (m *ValidatorTimelineRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type ValidatorTimelineResponse struct {
+	ValidatorIndex       uint64                `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	HasValidator         bool                  `protobuf:"varint,2,opt,name=has_validator,json=hasValidator,proto3" json:"has_validator,omitempty"`
+	Milestones           []*ValidatorMilestone `protobuf:"bytes,3,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ValidatorTimelineResponse) Reset()         { *m = ValidatorTimelineResponse{} }
+func (m *ValidatorTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTimelineResponse) ProtoMessage()    {}
+func (*ValidatorTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
+}
+
+func (m *ValidatorTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorTimelineResponse.Unmarshal(m, b)
+}
+func (m *ValidatorTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorTimelineResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTimelineResponse.Merge(m, src)
+}
+func (m *ValidatorTimelineResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorTimelineResponse.Size(m)
+}
+func (m *ValidatorTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTimelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTimelineResponse proto.InternalMessageInfo
+
+func (m *ValidatorTimelineResponse) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorTimelineResponse) GetHasValidator() bool {
+	if m != nil {
+		return m.HasValidator
+	}
+	return false
+}
+
+func (m *ValidatorTimelineResponse) GetMilestones() []*ValidatorMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+type ValidatorMilestone struct {
+	Type                 ValidatorMilestone_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ethereum.beacon.rpc.v1.ValidatorMilestone_Type" json:"type,omitempty"`
+	Epoch                uint64                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Timestamp            uint64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	QueuePosition        uint64                  `protobuf:"varint,5,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	Approximate          bool                    `protobuf:"varint,6,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	DepositIndex         uint64                  `protobuf:"varint,7,opt,name=deposit_index,json=depositIndex,proto3" json:"deposit_index,omitempty"`
+	Eth1BlockNumber      uint64                  `protobuf:"varint,8,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	Eth1TxHash           []byte                  `protobuf:"bytes,9,opt,name=eth1_tx_hash,json=eth1TxHash,proto3" json:"eth1_tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ValidatorMilestone) Reset()         { *m = ValidatorMilestone{} }
+func (m *ValidatorMilestone) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMilestone) ProtoMessage()    {}
+func (*ValidatorMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
+}
+
+func (m *ValidatorMilestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorMilestone.Unmarshal(m, b)
+}
+func (m *ValidatorMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorMilestone.Marshal(b, m, deterministic)
+}
+func (m *ValidatorMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMilestone.Merge(m, src)
+}
+func (m *ValidatorMilestone) XXX_Size() int {
+	return xxx_messageInfo_ValidatorMilestone.Size(m)
+}
+func (m *ValidatorMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMilestone proto.InternalMessageInfo
+
+func (m *ValidatorMilestone) GetType() ValidatorMilestone_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ValidatorMilestone_DEPOSITED
+}
+
+func (m *ValidatorMilestone) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorMilestone) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ValidatorMilestone) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ValidatorMilestone) GetQueuePosition() uint64 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
+}
+
+func (m *ValidatorMilestone) GetApproximate() bool {
+	if m != nil {
+		return m.Approximate
+	}
+	return false
+}
+
+func (m *ValidatorMilestone) GetDepositIndex() uint64 {
+	if m != nil {
+		return m.DepositIndex
+	}
+	return 0
+}
+
+func (m *ValidatorMilestone) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
+	}
+	return 0
+}
+
+func (m *ValidatorMilestone) GetEth1TxHash() []byte {
+	if m != nil {
+		return m.Eth1TxHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.DepositInfo_Classification", DepositInfo_Classification_name, DepositInfo_Classification_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.DepositInfo_Inclusion", DepositInfo_Inclusion_name, DepositInfo_Inclusion_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorMilestone_Type", ValidatorMilestone_Type_name, ValidatorMilestone_Type_value)
 	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
 	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
 	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
@@ -1355,117 +1949,171 @@ func init() {
 	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
 	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
 	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*ChainReorg)(nil), "ethereum.beacon.rpc.v1.ChainReorg")
+	proto.RegisterType((*ListDepositsRequest)(nil), "ethereum.beacon.rpc.v1.ListDepositsRequest")
+	proto.RegisterType((*ListDepositsResponse)(nil), "ethereum.beacon.rpc.v1.ListDepositsResponse")
+	proto.RegisterType((*DepositInfo)(nil), "ethereum.beacon.rpc.v1.DepositInfo")
+	proto.RegisterType((*ValidatorTimelineRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorTimelineRequest")
+	proto.RegisterType((*ValidatorTimelineResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorTimelineResponse")
+	proto.RegisterType((*ValidatorMilestone)(nil), "ethereum.beacon.rpc.v1.ValidatorMilestone")
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285)
+}
 
 var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
-	0x15, 0x0f, 0x25, 0x59, 0xd6, 0x3e, 0xc9, 0x32, 0x3d, 0xf6, 0xda, 0x8a, 0x76, 0xb7, 0x71, 0x99,
-	0xa4, 0xb1, 0x5d, 0x44, 0xb2, 0x95, 0x60, 0xd1, 0x26, 0x48, 0x03, 0xd9, 0xe2, 0xda, 0x82, 0x17,
-	0xb2, 0x42, 0x69, 0xed, 0x14, 0x39, 0x10, 0x23, 0x6a, 0x2c, 0x11, 0x91, 0x38, 0x34, 0x39, 0x12,
-	0xe2, 0x4b, 0x81, 0x5e, 0x5a, 0xf4, 0xd6, 0x1e, 0x8a, 0x1e, 0x8b, 0x7e, 0x84, 0xa2, 0x87, 0x7e,
-	0x85, 0x7e, 0x88, 0x7e, 0x98, 0x80, 0x33, 0x43, 0x8a, 0xfa, 0x67, 0xcb, 0x7b, 0xe3, 0xbc, 0xf7,
-	0x7e, 0xef, 0xef, 0xcc, 0x9b, 0x37, 0x04, 0xcd, 0xf5, 0x28, 0xa3, 0xe5, 0x0e, 0xc1, 0x16, 0x75,
-	0xca, 0x9e, 0x6b, 0x95, 0xc7, 0x27, 0x65, 0x9f, 0x78, 0x63, 0xdb, 0x22, 0x7e, 0x89, 0x33, 0xd1,
-	0x2e, 0x61, 0x7d, 0xe2, 0x91, 0xd1, 0xb0, 0x24, 0xc4, 0x4a, 0x9e, 0x6b, 0x95, 0xc6, 0x27, 0xc5,
-	0x17, 0x3d, 0x4a, 0x7b, 0x03, 0x52, 0xe6, 0x52, 0x9d, 0xd1, 0x6d, 0x99, 0x0c, 0x5d, 0x76, 0x2f,
-	0x40, 0xc5, 0x8f, 0x08, 0xeb, 0x97, 0xc7, 0x27, 0x78, 0xe0, 0xf6, 0xf1, 0x89, 0xd4, 0x6f, 0x76,
-	0x06, 0xd4, 0xfa, 0x51, 0x0a, 0xfc, 0x62, 0x4a, 0x00, 0x33, 0x46, 0x7c, 0x86, 0x99, 0x4d, 0x1d,
-	0xc1, 0xd7, 0x2c, 0xc8, 0x9d, 0x06, 0xe2, 0x06, 0xb9, 0x1b, 0x11, 0x9f, 0x21, 0x04, 0x29, 0x7f,
-	0x40, 0x59, 0x41, 0xd9, 0x57, 0x0e, 0x52, 0x06, 0xff, 0x46, 0x1f, 0xc3, 0x86, 0x87, 0x9d, 0x2e,
-	0xa6, 0xa6, 0x47, 0xc6, 0x04, 0x0f, 0x0a, 0x89, 0x7d, 0xe5, 0x20, 0x67, 0xe4, 0x04, 0xd1, 0xe0,
-	0x34, 0x54, 0x84, 0x4c, 0xcf, 0xc3, 0xb7, 0xb7, 0x36, 0xb3, 0x0b, 0x49, 0xce, 0x8f, 0xd6, 0xda,
-	0x31, 0x6c, 0x36, 0x3d, 0xea, 0x52, 0x9f, 0x18, 0xc4, 0x77, 0xa9, 0xe3, 0x13, 0xf4, 0x0a, 0x80,
-	0xbb, 0x69, 0x7a, 0x54, 0x5a, 0xcb, 0x19, 0xcf, 0x38, 0xc5, 0xa0, 0x94, 0x69, 0x7f, 0x51, 0x00,
-	0x55, 0x27, 0xce, 0x86, 0xde, 0xbd, 0x02, 0x70, 0x47, 0x9d, 0x81, 0x6d, 0x99, 0x3f, 0x92, 0xfb,
-	0x10, 0x25, 0x28, 0x97, 0xe4, 0x1e, 0xed, 0xc1, 0xba, 0x4b, 0x2d, 0xb3, 0x63, 0x33, 0xe9, 0x62,
-	0xda, 0xa5, 0xd6, 0xa9, 0x3d, 0x89, 0x2a, 0x19, 0x8b, 0xea, 0x33, 0xd8, 0xb4, 0xe8, 0x70, 0x68,
-	0x33, 0x46, 0x88, 0x69, 0x3b, 0x5d, 0xf2, 0x53, 0x21, 0xc5, 0xd9, 0xf9, 0x88, 0x5c, 0x0f, 0xa8,
-	0xda, 0x27, 0x90, 0x17, 0xae, 0x44, 0xce, 0x23, 0x48, 0xc5, 0xdc, 0xe6, 0xdf, 0xda, 0x3f, 0x02,
-	0x8f, 0x7b, 0x3d, 0x8f, 0xf4, 0xa6, 0x3c, 0x5e, 0x94, 0xcf, 0x05, 0x96, 0x13, 0x8b, 0x2c, 0xcf,
-	0x84, 0x9b, 0x9c, 0x0d, 0xf7, 0x53, 0xc8, 0x07, 0xfa, 0x4c, 0xdf, 0xee, 0x39, 0x98, 0x8d, 0x3c,
-	0xc2, 0x03, 0xc8, 0x19, 0x1b, 0x01, 0xb5, 0x15, 0x12, 0xb5, 0x43, 0xd8, 0x9e, 0x72, 0xec, 0x81,
-	0x20, 0x0c, 0x78, 0x71, 0x8d, 0x07, 0x76, 0x17, 0x33, 0xea, 0x35, 0x89, 0x77, 0x4b, 0xbd, 0x21,
-	0x76, 0x2c, 0xf2, 0x50, 0x30, 0x1f, 0x41, 0x76, 0xe2, 0xa3, 0x5f, 0x48, 0xec, 0x27, 0x0f, 0x72,
-	0x06, 0x44, 0x4e, 0xfa, 0xda, 0xdf, 0x13, 0xf0, 0x72, 0xb1, 0x52, 0xe9, 0x48, 0x11, 0x32, 0x1d,
-	0x3c, 0x08, 0x48, 0x7e, 0x41, 0xd9, 0x4f, 0x1e, 0xa4, 0x8c, 0x68, 0x8d, 0x0e, 0x41, 0x65, 0x94,
-	0xe1, 0x81, 0x39, 0x0e, 0x35, 0xf8, 0x32, 0x57, 0x9b, 0x9c, 0x1e, 0x29, 0xf6, 0xd1, 0x6b, 0xd8,
-	0x13, 0xa2, 0xd8, 0x62, 0xf6, 0x98, 0xc4, 0x11, 0xa2, 0xec, 0xcf, 0x39, 0xbb, 0xca, 0xb9, 0x31,
-	0xdc, 0xe7, 0x80, 0x86, 0xb6, 0xef, 0xdb, 0x4e, 0x2f, 0x0e, 0x49, 0xf1, 0x38, 0xb6, 0x24, 0x27,
-	0x26, 0x7e, 0x0e, 0xfb, 0x78, 0x4c, 0x3c, 0xdc, 0x23, 0x73, 0x86, 0x4c, 0xe9, 0x76, 0x61, 0x6d,
-	0x5f, 0x39, 0x48, 0x18, 0xaf, 0xa4, 0xdc, 0x8c, 0xc5, 0x53, 0x21, 0xa4, 0x7d, 0x03, 0xc5, 0x88,
-	0xc6, 0x45, 0xa6, 0xf6, 0xcd, 0x4c, 0x5a, 0x95, 0xb9, 0xb4, 0xfe, 0x33, 0x11, 0xab, 0x55, 0x1c,
-	0x2f, 0xb3, 0xfa, 0x1a, 0x9e, 0x63, 0x41, 0x25, 0x5d, 0x73, 0x4e, 0xd5, 0x69, 0xa2, 0xa0, 0x18,
-	0xdb, 0x91, 0x40, 0x33, 0xd2, 0x8b, 0xae, 0x21, 0x13, 0x1c, 0xba, 0x91, 0x4f, 0x44, 0x31, 0xb3,
-	0x95, 0xaf, 0x4a, 0x8b, 0x3b, 0x53, 0xe9, 0x01, 0xf3, 0xa5, 0x16, 0xd7, 0x61, 0x44, 0xba, 0x8a,
-	0x2e, 0xa4, 0x05, 0xed, 0xb1, 0x43, 0x7c, 0x0e, 0x69, 0x01, 0xe2, 0x85, 0xce, 0x56, 0xca, 0x8f,
-	0x9a, 0x97, 0xb6, 0xa4, 0x69, 0x43, 0xc2, 0xb5, 0xaf, 0x60, 0x4f, 0xff, 0xc9, 0x66, 0xa4, 0x3b,
-	0xa9, 0xde, 0xca, 0xd9, 0xfd, 0x1a, 0x0a, 0xf3, 0x58, 0x99, 0xd9, 0x47, 0xc1, 0xdf, 0x01, 0x3a,
-	0xeb, 0x63, 0xdb, 0x69, 0x31, 0xec, 0x4d, 0x9a, 0x46, 0x01, 0xd6, 0xfd, 0x80, 0x40, 0xba, 0x3c,
-	0xe6, 0x8c, 0x11, 0x2e, 0xd1, 0x2f, 0x21, 0xd7, 0x23, 0x0e, 0xf1, 0x6d, 0xdf, 0x64, 0xf6, 0x90,
-	0xc8, 0x0d, 0x9e, 0x95, 0xb4, 0xb6, 0x3d, 0x24, 0xda, 0x6b, 0x78, 0x1e, 0x79, 0xc2, 0x7b, 0xc3,
-	0x6a, 0x1d, 0x51, 0x2b, 0xc1, 0xee, 0x2c, 0x4e, 0xba, 0xb3, 0x03, 0x6b, 0xa2, 0xf5, 0x88, 0xc3,
-	0x2c, 0x16, 0xda, 0x3b, 0xd8, 0xaa, 0xfa, 0x41, 0x3f, 0x19, 0x12, 0x87, 0xc5, 0xb2, 0x45, 0x5c,
-	0x6a, 0xf5, 0x4d, 0xee, 0xb0, 0x04, 0x00, 0x27, 0xf1, 0x10, 0x1f, 0xef, 0x01, 0x7f, 0x4d, 0x02,
-	0x8a, 0xeb, 0x95, 0x3e, 0xdc, 0xc1, 0xce, 0xe4, 0xf0, 0xe0, 0x88, 0xcf, 0x53, 0x9a, 0xad, 0xfc,
-	0x6e, 0x59, 0xe1, 0xe7, 0x35, 0xc5, 0xb6, 0xe2, 0x84, 0xb7, 0x3d, 0x9e, 0x27, 0x16, 0xff, 0x94,
-	0x80, 0xed, 0x05, 0xc2, 0xe8, 0x25, 0x3c, 0x8b, 0x9a, 0xaf, 0xec, 0x42, 0x13, 0xc2, 0xea, 0x1d,
-	0xfb, 0x63, 0xd8, 0x10, 0x77, 0x2c, 0xf1, 0xcc, 0xd8, 0x8d, 0x93, 0x0b, 0x89, 0x2d, 0x79, 0x9f,
-	0xba, 0xe2, 0x3a, 0x94, 0x42, 0xe2, 0xde, 0xc9, 0x85, 0x44, 0x2e, 0x34, 0x5d, 0xd8, 0xb5, 0xd9,
-	0x53, 0xf2, 0x6d, 0x74, 0x4a, 0xd2, 0xfb, 0xca, 0x41, 0xbe, 0xf2, 0xd9, 0xaa, 0xa7, 0x24, 0x3c,
-	0x1d, 0xff, 0x4d, 0xc0, 0xde, 0x92, 0x13, 0x14, 0x53, 0xae, 0xbc, 0x97, 0x72, 0xf4, 0x5b, 0xf8,
-	0x90, 0xb0, 0xfe, 0x89, 0xd9, 0x25, 0x2e, 0xf5, 0x6d, 0x26, 0x26, 0x12, 0xd3, 0x19, 0x0d, 0x3b,
-	0xc4, 0x93, 0x99, 0x0b, 0xc6, 0x9d, 0x93, 0x9a, 0xe0, 0xf3, 0x09, 0xa4, 0xc1, 0xb9, 0xe8, 0x4b,
-	0xd8, 0x0d, 0x51, 0xb6, 0x63, 0x0d, 0x46, 0xbe, 0x4d, 0x9d, 0x78, 0x2a, 0x77, 0x24, 0xb7, 0x1e,
-	0x32, 0x79, 0xb6, 0x0e, 0x41, 0xc5, 0x51, 0x13, 0x32, 0xf9, 0xd6, 0x94, 0x59, 0xdd, 0x9c, 0xd0,
-	0xf5, 0x80, 0x8c, 0xbe, 0x85, 0x97, 0x5c, 0x41, 0x20, 0x68, 0x3b, 0x66, 0x0c, 0x76, 0x37, 0x22,
-	0x23, 0xd1, 0xbc, 0x53, 0xc6, 0x87, 0xa1, 0x4c, 0xdd, 0x99, 0x74, 0xb7, 0xef, 0x02, 0x01, 0xed,
-	0x1b, 0xd8, 0xa8, 0xd1, 0x21, 0xb6, 0xa3, 0x5e, 0xbd, 0x03, 0x6b, 0xc2, 0xa2, 0x3c, 0x4a, 0x7c,
-	0x81, 0x76, 0x21, 0xdd, 0xe5, 0x62, 0xe1, 0x2c, 0x22, 0x56, 0xda, 0xd7, 0x90, 0x0f, 0xe1, 0x32,
-	0xdd, 0x87, 0xa0, 0x46, 0x57, 0xb8, 0x29, 0x31, 0x42, 0xd5, 0x66, 0x44, 0x17, 0x10, 0xed, 0x6f,
-	0x09, 0xd8, 0xe2, 0xd9, 0x6a, 0x7b, 0x64, 0x72, 0x83, 0xbe, 0x81, 0x14, 0xf3, 0xe4, 0xbe, 0xcd,
-	0x56, 0x2a, 0xcb, 0xaa, 0x35, 0x07, 0x2c, 0x05, 0x8b, 0x06, 0xed, 0x12, 0x83, 0xe3, 0x8b, 0xff,
-	0x51, 0x20, 0x13, 0x92, 0xd0, 0x6f, 0x60, 0x8d, 0x97, 0x8d, 0xbb, 0x92, 0xad, 0x68, 0x13, 0xad,
-	0x84, 0xf5, 0x4b, 0xe1, 0x48, 0x59, 0x3a, 0xe5, 0x26, 0xc4, 0x0c, 0x29, 0x00, 0x33, 0xb3, 0x5d,
-	0x62, 0x66, 0xb6, 0x0b, 0x2e, 0x5c, 0x17, 0x7b, 0xcc, 0xb6, 0x6c, 0x97, 0x5f, 0x4e, 0x63, 0xca,
-	0x48, 0x78, 0x47, 0x6f, 0xc5, 0x39, 0xd7, 0x01, 0x23, 0x68, 0x2e, 0x72, 0x04, 0xe0, 0x72, 0xa2,
-	0xaa, 0x20, 0x6e, 0xff, 0x80, 0xa2, 0xbd, 0x85, 0x9d, 0xc0, 0x69, 0xee, 0x42, 0xb0, 0x19, 0xc2,
-	0xb2, 0xbc, 0x80, 0x67, 0x7c, 0x3c, 0xba, 0xf5, 0xe8, 0x50, 0xe6, 0x33, 0x13, 0x10, 0xde, 0x78,
-	0x74, 0x18, 0x8c, 0x8a, 0x9c, 0xc9, 0xa8, 0xdc, 0x8f, 0xe9, 0x60, 0xd9, 0xa6, 0x47, 0x17, 0xb0,
-	0x11, 0xed, 0x6a, 0x83, 0x0e, 0x08, 0xca, 0xc2, 0xfa, 0xbb, 0xc6, 0x65, 0xe3, 0xea, 0xa6, 0xa1,
-	0x7e, 0x80, 0x72, 0x90, 0xa9, 0xb6, 0xdb, 0x7a, 0xab, 0xad, 0x1b, 0xaa, 0x12, 0xac, 0x9a, 0xc6,
-	0x55, 0xf3, 0xaa, 0xa5, 0x1b, 0x6a, 0x02, 0xe5, 0x01, 0xaa, 0xe7, 0xe7, 0x86, 0x7e, 0x5e, 0x6d,
-	0x5f, 0x19, 0x6a, 0xf2, 0xe8, 0x5f, 0x0a, 0x6c, 0xce, 0x1c, 0x10, 0x84, 0x20, 0x2f, 0x95, 0x99,
-	0xad, 0x76, 0xb5, 0xfd, 0xae, 0xa5, 0x7e, 0x80, 0x76, 0x40, 0xad, 0xe9, 0xcd, 0xab, 0x56, 0xbd,
-	0x6d, 0x1a, 0xfa, 0x99, 0x5e, 0xbf, 0xd6, 0x6b, 0xaa, 0x12, 0x48, 0x36, 0xf5, 0x46, 0xad, 0xde,
-	0x38, 0x37, 0xab, 0x67, 0xed, 0xfa, 0xb5, 0xae, 0x26, 0x10, 0x40, 0x5a, 0x7e, 0x27, 0x03, 0x7e,
-	0xbd, 0x51, 0x6f, 0xd7, 0xab, 0x6d, 0xbd, 0x66, 0xea, 0xdf, 0xd7, 0xdb, 0x6a, 0x0a, 0xa9, 0x90,
-	0xbb, 0xa9, 0xb7, 0x2f, 0x6a, 0x46, 0xf5, 0xa6, 0x7a, 0xfa, 0x56, 0x57, 0xd7, 0x02, 0x44, 0xc0,
-	0xd3, 0x6b, 0x6a, 0x3a, 0x40, 0x88, 0x6f, 0xb3, 0xf5, 0xb6, 0xda, 0xba, 0xd0, 0x6b, 0xea, 0x7a,
-	0xe5, 0xff, 0x0a, 0x6c, 0x56, 0xc3, 0xde, 0x24, 0xde, 0x23, 0xa8, 0x0f, 0x48, 0xa6, 0x30, 0x36,
-	0x81, 0xa3, 0xa3, 0xa5, 0xdd, 0x78, 0x6e, 0x4c, 0x2f, 0xfe, 0x6a, 0xc9, 0x5e, 0x89, 0x89, 0xd6,
-	0x30, 0xc3, 0xc8, 0x84, 0xad, 0xd6, 0xa8, 0x33, 0xb4, 0xa7, 0x0c, 0x69, 0x8f, 0x83, 0xe3, 0x06,
-	0x16, 0x39, 0x13, 0xee, 0xef, 0xca, 0xff, 0x94, 0xe8, 0xe5, 0x11, 0x85, 0xf7, 0x3d, 0xe4, 0xa4,
-	0x9f, 0x7c, 0xc7, 0xa0, 0x4f, 0x1e, 0x3c, 0x2e, 0x61, 0x48, 0x2b, 0x6c, 0x7f, 0xf4, 0x03, 0xe4,
-	0xa4, 0x31, 0xb1, 0x5e, 0x01, 0x53, 0x5c, 0xda, 0x5a, 0x67, 0x1e, 0x4c, 0x95, 0x3f, 0x2b, 0xb0,
-	0x15, 0x8e, 0xf1, 0x34, 0x0a, 0xc6, 0x83, 0x3d, 0x99, 0x41, 0xc9, 0x22, 0x55, 0xa7, 0xdb, 0xf4,
-	0x28, 0xbd, 0x7d, 0xa0, 0x60, 0x73, 0xaf, 0x94, 0xe2, 0xaf, 0x57, 0x92, 0x95, 0x9e, 0xfc, 0x3b,
-	0x03, 0xea, 0x64, 0x5f, 0x4b, 0x47, 0x7e, 0x00, 0x10, 0x2d, 0x8a, 0x17, 0xf6, 0xd3, 0x65, 0xfa,
-	0xa6, 0x1a, 0xe7, 0xf2, 0x32, 0xce, 0x34, 0xc8, 0x3f, 0xc0, 0xd6, 0x0d, 0xb6, 0xd9, 0x9b, 0xf8,
-	0xa4, 0x89, 0x2a, 0x4f, 0x1a, 0x4b, 0x85, 0xc1, 0x2f, 0xde, 0x63, 0x94, 0x3d, 0x56, 0x10, 0x85,
-	0xfc, 0xf4, 0x14, 0x85, 0x3e, 0x7f, 0x54, 0x51, 0x7c, 0x4a, 0x2b, 0x96, 0x56, 0x15, 0x97, 0x01,
-	0x0f, 0x60, 0xfb, 0x2c, 0x1c, 0x2c, 0x62, 0x43, 0xca, 0xe1, 0x2a, 0x13, 0x91, 0xb0, 0x78, 0xb4,
-	0xfa, 0xf0, 0x84, 0xee, 0xe6, 0xfb, 0xd4, 0x13, 0xe3, 0x7b, 0xea, 0x8c, 0x8e, 0xfe, 0xa8, 0xc0,
-	0xce, 0xa2, 0x47, 0x21, 0x7a, 0xbc, 0x42, 0xf3, 0xef, 0xd2, 0xe2, 0x97, 0x4f, 0x03, 0x49, 0x1f,
-	0x46, 0xa0, 0xce, 0xce, 0xf8, 0x68, 0x69, 0x20, 0x4b, 0x5e, 0x12, 0xc5, 0xe3, 0xd5, 0x01, 0xd2,
-	0xec, 0xef, 0xa3, 0xcd, 0x3c, 0x79, 0x24, 0xa0, 0xdd, 0x92, 0xf8, 0xcb, 0x53, 0x0a, 0xff, 0xf2,
-	0x94, 0xf4, 0xa1, 0xcb, 0xee, 0x97, 0x97, 0x71, 0xfe, 0x81, 0x71, 0xac, 0xa0, 0x4b, 0xd8, 0x38,
-	0xc3, 0x0e, 0x75, 0x6c, 0x0b, 0x0f, 0x2e, 0x08, 0xee, 0x2e, 0x55, 0xbb, 0x4a, 0x37, 0xbb, 0x84,
-	0xac, 0xec, 0x41, 0x41, 0x28, 0xf1, 0x36, 0x39, 0x05, 0xb9, 0xa6, 0x83, 0x91, 0xc3, 0xb0, 0x77,
-	0x1f, 0x48, 0x15, 0x97, 0x18, 0xec, 0xa4, 0xf9, 0xfa, 0x8b, 0x9f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x69, 0x2d, 0xc1, 0x24, 0x09, 0x13, 0x00, 0x00,
+	// 2394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x19, 0x5b, 0x73, 0x1b, 0x57,
+	0xb9, 0x2b, 0xcb, 0x8e, 0xfc, 0xe9, 0x62, 0xe9, 0xd8, 0x71, 0x54, 0x25, 0x6d, 0xdd, 0x4d, 0x9a,
+	0x3a, 0x81, 0xc8, 0xb6, 0x5a, 0x32, 0xb4, 0x9d, 0xd2, 0x91, 0xad, 0x8d, 0x23, 0x62, 0x64, 0x65,
+	0x25, 0xdb, 0x85, 0x3e, 0xec, 0x1c, 0x4b, 0xc7, 0xd2, 0xd2, 0xd5, 0x9e, 0xcd, 0xee, 0x4a, 0xb1,
+	0x87, 0x19, 0x66, 0x78, 0x81, 0x61, 0xe0, 0x01, 0x1e, 0x80, 0x47, 0x86, 0x9f, 0xc0, 0x30, 0x0c,
+	0x2f, 0xfc, 0x00, 0x7e, 0x04, 0xff, 0x80, 0x3f, 0xc1, 0x9c, 0xcb, 0x5e, 0x74, 0xb3, 0xe5, 0xbe,
+	0xe9, 0x7c, 0xb7, 0xf3, 0x5d, 0xce, 0x77, 0xd9, 0x4f, 0xa0, 0x3a, 0x2e, 0xf5, 0xe9, 0xce, 0x39,
+	0xc1, 0x1d, 0x6a, 0xef, 0xb8, 0x4e, 0x67, 0x67, 0xb4, 0xb7, 0xe3, 0x11, 0x77, 0x64, 0x76, 0x88,
+	0x57, 0xe6, 0x48, 0xb4, 0x49, 0xfc, 0x3e, 0x71, 0xc9, 0x70, 0x50, 0x16, 0x64, 0x65, 0xd7, 0xe9,
+	0x94, 0x47, 0x7b, 0xa5, 0xfb, 0x3d, 0x4a, 0x7b, 0x16, 0xd9, 0xe1, 0x54, 0xe7, 0xc3, 0x8b, 0x1d,
+	0x32, 0x70, 0xfc, 0x2b, 0xc1, 0x54, 0xfa, 0x80, 0xf8, 0xfd, 0x9d, 0xd1, 0x1e, 0xb6, 0x9c, 0x3e,
+	0xde, 0x93, 0xf2, 0x8d, 0x73, 0x8b, 0x76, 0xbe, 0x95, 0x04, 0xef, 0x8f, 0x11, 0x60, 0xdf, 0x27,
+	0x9e, 0x8f, 0x7d, 0x93, 0xda, 0x02, 0xaf, 0x76, 0x20, 0xb3, 0xcf, 0xc8, 0x75, 0xf2, 0x66, 0x48,
+	0x3c, 0x1f, 0x21, 0x48, 0x7a, 0x16, 0xf5, 0x8b, 0xca, 0x96, 0xb2, 0x9d, 0xd4, 0xf9, 0x6f, 0xf4,
+	0x10, 0xb2, 0x2e, 0xb6, 0xbb, 0x98, 0x1a, 0x2e, 0x19, 0x11, 0x6c, 0x15, 0x13, 0x5b, 0xca, 0x76,
+	0x46, 0xcf, 0x08, 0xa0, 0xce, 0x61, 0xa8, 0x04, 0xa9, 0x9e, 0x8b, 0x2f, 0x2e, 0x4c, 0xdf, 0x2c,
+	0x2e, 0x71, 0x7c, 0x78, 0x56, 0x77, 0x61, 0xad, 0xe9, 0x52, 0x87, 0x7a, 0x44, 0x27, 0x9e, 0x43,
+	0x6d, 0x8f, 0xa0, 0xf7, 0x00, 0xb8, 0x9a, 0x86, 0x4b, 0xe5, 0x6d, 0x19, 0x7d, 0x95, 0x43, 0x74,
+	0x4a, 0x7d, 0xf5, 0xb7, 0x0a, 0xa0, 0x6a, 0xa4, 0x6c, 0xa0, 0xdd, 0x7b, 0x00, 0xce, 0xf0, 0xdc,
+	0x32, 0x3b, 0xc6, 0xb7, 0xe4, 0x2a, 0xe0, 0x12, 0x90, 0x57, 0xe4, 0x0a, 0xdd, 0x83, 0x3b, 0x0e,
+	0xed, 0x18, 0xe7, 0xa6, 0x2f, 0x55, 0x5c, 0x71, 0x68, 0x67, 0xdf, 0x8c, 0xac, 0x5a, 0x8a, 0x59,
+	0xf5, 0x31, 0xac, 0x75, 0xe8, 0x60, 0x60, 0xfa, 0x3e, 0x21, 0x86, 0x69, 0x77, 0xc9, 0x65, 0x31,
+	0xc9, 0xd1, 0xb9, 0x10, 0x5c, 0x67, 0x50, 0xf5, 0x11, 0xe4, 0x84, 0x2a, 0xa1, 0xf2, 0x08, 0x92,
+	0x31, 0xb5, 0xf9, 0x6f, 0xf5, 0x2f, 0x4c, 0xe3, 0x5e, 0xcf, 0x25, 0xbd, 0x31, 0x8d, 0x67, 0xf9,
+	0x73, 0xc6, 0xcd, 0x89, 0x59, 0x37, 0x4f, 0x98, 0xbb, 0x34, 0x69, 0xee, 0x47, 0x90, 0x63, 0xf2,
+	0x0c, 0xcf, 0xec, 0xd9, 0xd8, 0x1f, 0xba, 0x84, 0x1b, 0x90, 0xd1, 0xb3, 0x0c, 0xda, 0x0a, 0x80,
+	0xea, 0x13, 0x58, 0x1f, 0x53, 0xec, 0x1a, 0x23, 0x74, 0xb8, 0x7f, 0x8a, 0x2d, 0xb3, 0x8b, 0x7d,
+	0xea, 0x36, 0x89, 0x7b, 0x41, 0xdd, 0x01, 0xb6, 0x3b, 0xe4, 0x3a, 0x63, 0x3e, 0x80, 0x74, 0xa4,
+	0xa3, 0x57, 0x4c, 0x6c, 0x2d, 0x6d, 0x67, 0x74, 0x08, 0x95, 0xf4, 0xd4, 0x3f, 0x25, 0xe0, 0xc1,
+	0x6c, 0xa1, 0x52, 0x91, 0x12, 0xa4, 0xce, 0xb1, 0xc5, 0x40, 0x5e, 0x51, 0xd9, 0x5a, 0xda, 0x4e,
+	0xea, 0xe1, 0x19, 0x3d, 0x81, 0xbc, 0x4f, 0x7d, 0x6c, 0x19, 0xa3, 0x40, 0x82, 0x27, 0x7d, 0xb5,
+	0xc6, 0xe1, 0xa1, 0x60, 0x0f, 0x3d, 0x87, 0x7b, 0x82, 0x14, 0x77, 0x7c, 0x73, 0x44, 0xe2, 0x1c,
+	0x22, 0xec, 0x77, 0x39, 0xba, 0xca, 0xb1, 0x31, 0xbe, 0x67, 0x80, 0x06, 0xa6, 0xe7, 0x99, 0x76,
+	0x2f, 0xce, 0x92, 0xe4, 0x76, 0x14, 0x24, 0x26, 0x46, 0x7e, 0x08, 0x5b, 0x78, 0x44, 0x5c, 0xdc,
+	0x23, 0x53, 0x17, 0x19, 0x52, 0xed, 0xe2, 0xf2, 0x96, 0xb2, 0x9d, 0xd0, 0xdf, 0x93, 0x74, 0x13,
+	0x37, 0xee, 0x0b, 0x22, 0xf5, 0x4b, 0x28, 0x85, 0x30, 0x4e, 0x32, 0xf6, 0x6e, 0x26, 0xdc, 0xaa,
+	0x4c, 0xb9, 0xf5, 0xaf, 0x09, 0xb8, 0x3f, 0x93, 0x5f, 0x7a, 0xf5, 0x39, 0xdc, 0xc5, 0x02, 0x4a,
+	0xba, 0xc6, 0x94, 0xa8, 0xfd, 0x44, 0x51, 0xd1, 0xd7, 0x43, 0x82, 0x66, 0x28, 0x17, 0x9d, 0x42,
+	0x8a, 0x25, 0xdd, 0xd0, 0x23, 0x22, 0x98, 0xe9, 0xca, 0xe7, 0xe5, 0xd9, 0x95, 0xa9, 0x7c, 0xcd,
+	0xf5, 0xe5, 0x16, 0x97, 0xa1, 0x87, 0xb2, 0x4a, 0x0e, 0xac, 0x08, 0xd8, 0x4d, 0x49, 0x7c, 0x08,
+	0x2b, 0x82, 0x89, 0x07, 0x3a, 0x5d, 0xd9, 0xb9, 0xf1, 0x7a, 0x79, 0x97, 0xbc, 0x5a, 0x97, 0xec,
+	0xea, 0xe7, 0x70, 0x4f, 0xbb, 0x34, 0x7d, 0xd2, 0x8d, 0xa2, 0xb7, 0xb0, 0x77, 0xbf, 0x80, 0xe2,
+	0x34, 0xaf, 0xf4, 0xec, 0x8d, 0xcc, 0xaf, 0x01, 0x1d, 0xf4, 0xb1, 0x69, 0xb7, 0x7c, 0xec, 0x46,
+	0x45, 0xa3, 0x08, 0x77, 0x3c, 0x06, 0x20, 0x5d, 0x6e, 0x73, 0x4a, 0x0f, 0x8e, 0xe8, 0x43, 0xc8,
+	0xf4, 0x88, 0x4d, 0x3c, 0xd3, 0x33, 0x7c, 0x73, 0x40, 0xe4, 0x03, 0x4f, 0x4b, 0x58, 0xdb, 0x1c,
+	0x10, 0xf5, 0x39, 0xdc, 0x0d, 0x35, 0xe1, 0xb5, 0x61, 0xb1, 0x8a, 0xa8, 0x96, 0x61, 0x73, 0x92,
+	0x4f, 0xaa, 0xb3, 0x01, 0xcb, 0xa2, 0xf4, 0x88, 0x64, 0x16, 0x07, 0xf5, 0x04, 0x0a, 0x55, 0x8f,
+	0xd5, 0x93, 0x01, 0xb1, 0xfd, 0x98, 0xb7, 0x88, 0x43, 0x3b, 0x7d, 0x83, 0x2b, 0x2c, 0x19, 0x80,
+	0x83, 0xb8, 0x89, 0x37, 0xd7, 0x80, 0x3f, 0x2c, 0x01, 0x8a, 0xcb, 0x95, 0x3a, 0xbc, 0x81, 0x8d,
+	0x28, 0x79, 0x70, 0x88, 0xe7, 0x2e, 0x4d, 0x57, 0x7e, 0x34, 0x2f, 0xf0, 0xd3, 0x92, 0x62, 0x4f,
+	0x31, 0xc2, 0xad, 0x8f, 0xa6, 0x81, 0xa5, 0x5f, 0x27, 0x60, 0x7d, 0x06, 0x31, 0x7a, 0x00, 0xab,
+	0x61, 0xf1, 0x95, 0x55, 0x28, 0x02, 0x2c, 0x5e, 0xb1, 0x1f, 0x42, 0x56, 0xf4, 0x58, 0xe2, 0x1a,
+	0xb1, 0x8e, 0x93, 0x09, 0x80, 0x2d, 0xd9, 0x4f, 0x1d, 0xd1, 0x0e, 0x25, 0x91, 0xe8, 0x3b, 0x99,
+	0x00, 0xc8, 0x89, 0xc6, 0x03, 0xbb, 0x3c, 0x99, 0x25, 0x5f, 0x85, 0x59, 0xb2, 0xb2, 0xa5, 0x6c,
+	0xe7, 0x2a, 0x1f, 0x2f, 0x9a, 0x25, 0x41, 0x76, 0xfc, 0x2b, 0x01, 0xf7, 0xe6, 0x64, 0x50, 0x4c,
+	0xb8, 0xf2, 0x9d, 0x84, 0xa3, 0xcf, 0xe0, 0x5d, 0xe2, 0xf7, 0xf7, 0x8c, 0x2e, 0x71, 0xa8, 0x67,
+	0xfa, 0x62, 0x22, 0x31, 0xec, 0xe1, 0xe0, 0x9c, 0xb8, 0xd2, 0x73, 0x6c, 0xdc, 0xd9, 0xab, 0x09,
+	0x3c, 0x9f, 0x40, 0x1a, 0x1c, 0x8b, 0x3e, 0x85, 0xcd, 0x80, 0xcb, 0xb4, 0x3b, 0xd6, 0xd0, 0x33,
+	0xa9, 0x1d, 0x77, 0xe5, 0x86, 0xc4, 0xd6, 0x03, 0x24, 0xf7, 0xd6, 0x13, 0xc8, 0xe3, 0xb0, 0x08,
+	0x19, 0xfc, 0x69, 0x4a, 0xaf, 0xae, 0x45, 0x70, 0x8d, 0x81, 0xd1, 0x57, 0xf0, 0x80, 0x0b, 0x60,
+	0x84, 0xa6, 0x6d, 0xc4, 0xd8, 0xde, 0x0c, 0xc9, 0x50, 0x14, 0xef, 0xa4, 0xfe, 0x6e, 0x40, 0x53,
+	0xb7, 0xa3, 0xea, 0xf6, 0x9a, 0x11, 0xa8, 0x5f, 0x42, 0xb6, 0x46, 0x07, 0xd8, 0x0c, 0x6b, 0xf5,
+	0x06, 0x2c, 0x8b, 0x1b, 0x65, 0x2a, 0xf1, 0x03, 0xda, 0x84, 0x95, 0x2e, 0x27, 0x0b, 0x66, 0x11,
+	0x71, 0x52, 0xbf, 0x80, 0x5c, 0xc0, 0x2e, 0xdd, 0xfd, 0x04, 0xf2, 0x61, 0x0b, 0x37, 0x24, 0x8f,
+	0x10, 0xb5, 0x16, 0xc2, 0x05, 0x8b, 0xfa, 0xc7, 0x04, 0x14, 0xb8, 0xb7, 0xda, 0x2e, 0x89, 0x3a,
+	0xe8, 0x0b, 0x48, 0xfa, 0xae, 0x7c, 0xb7, 0xe9, 0x4a, 0x65, 0x5e, 0xb4, 0xa6, 0x18, 0xcb, 0xec,
+	0xd0, 0xa0, 0x5d, 0xa2, 0x73, 0xfe, 0xd2, 0x3f, 0x14, 0x48, 0x05, 0x20, 0xf4, 0x43, 0x58, 0xe6,
+	0x61, 0xe3, 0xaa, 0xa4, 0x2b, 0x6a, 0x24, 0x95, 0xf8, 0xfd, 0x72, 0x30, 0x52, 0x96, 0xf7, 0xf9,
+	0x15, 0x5c, 0xb4, 0x2e, 0x18, 0x26, 0x66, 0xbb, 0xc4, 0xc4, 0x6c, 0xc7, 0x1a, 0xae, 0x83, 0x5d,
+	0xdf, 0xec, 0x98, 0x0e, 0x6f, 0x4e, 0x23, 0xea, 0x93, 0xa0, 0x47, 0x17, 0xe2, 0x98, 0x53, 0x86,
+	0x60, 0xc5, 0x45, 0x8e, 0x00, 0x9c, 0x4e, 0x44, 0x15, 0x44, 0xf7, 0x67, 0x10, 0xf5, 0x08, 0x36,
+	0x98, 0xd2, 0x5c, 0x05, 0xf6, 0x18, 0x82, 0xb0, 0xdc, 0x87, 0x55, 0x3e, 0x1e, 0x5d, 0xb8, 0x74,
+	0x20, 0xfd, 0x99, 0x62, 0x80, 0x17, 0x2e, 0x1d, 0xb0, 0x51, 0x91, 0x23, 0x7d, 0x2a, 0xdf, 0xe3,
+	0x0a, 0x3b, 0xb6, 0xa9, 0xfa, 0xe7, 0x04, 0x00, 0xaf, 0xde, 0x3a, 0xa1, 0x6e, 0x0f, 0xa9, 0x90,
+	0xa5, 0x56, 0xd7, 0xe8, 0x13, 0xdc, 0x8d, 0x8f, 0xaa, 0x69, 0x6a, 0x75, 0x5f, 0x12, 0xdc, 0xe5,
+	0x06, 0xc5, 0x69, 0xf8, 0x4b, 0x95, 0x05, 0x5c, 0xd2, 0xf0, 0x07, 0xaa, 0x42, 0xd6, 0x26, 0x6f,
+	0x63, 0x72, 0xc4, 0x34, 0x97, 0xb6, 0xc9, 0xdb, 0xb8, 0x9c, 0x90, 0x26, 0x56, 0x17, 0x02, 0x1a,
+	0x2e, 0x67, 0x17, 0x36, 0x58, 0xc9, 0xa1, 0xb6, 0xc1, 0x07, 0x24, 0x56, 0x38, 0xb9, 0x38, 0x51,
+	0x20, 0x90, 0xc0, 0x55, 0x25, 0x4a, 0xa7, 0xb3, 0x39, 0xb8, 0xf0, 0x15, 0x2e, 0x7c, 0x82, 0x83,
+	0xdf, 0xb1, 0x01, 0xcb, 0x5d, 0xe2, 0xf8, 0xfd, 0xe2, 0x1d, 0xf1, 0x9e, 0xf9, 0x41, 0x3d, 0x83,
+	0xf5, 0x23, 0xd3, 0xf3, 0x65, 0xca, 0x7a, 0x0b, 0x8e, 0xe4, 0x1f, 0x42, 0x86, 0x57, 0x02, 0xdc,
+	0xed, 0xba, 0xc4, 0xf3, 0xe4, 0x6b, 0x48, 0x33, 0x58, 0x55, 0x80, 0xd4, 0x33, 0xd8, 0x18, 0x17,
+	0x1c, 0x56, 0xa1, 0x94, 0xcc, 0x75, 0x4f, 0xbe, 0xec, 0x87, 0xf3, 0x5e, 0x76, 0x2d, 0xa8, 0x09,
+	0x17, 0x54, 0x0f, 0x99, 0xd4, 0x7f, 0x2f, 0x43, 0x3a, 0x86, 0x99, 0xdd, 0xf2, 0x26, 0x0c, 0x48,
+	0x4c, 0x1a, 0xf0, 0x03, 0xd8, 0x7c, 0x6b, 0xfa, 0xfd, 0xae, 0x8b, 0xdf, 0x62, 0xcb, 0xe8, 0xb8,
+	0xa4, 0x4b, 0x6c, 0xdf, 0xc4, 0x96, 0x27, 0x23, 0x78, 0x37, 0xc2, 0x1e, 0x44, 0x48, 0x96, 0xfd,
+	0x78, 0x40, 0x87, 0x76, 0x10, 0x44, 0x79, 0x42, 0x3f, 0x83, 0x5c, 0xc7, 0x62, 0xad, 0xee, 0xc2,
+	0xec, 0xf0, 0x9a, 0xc2, 0x23, 0x97, 0x9b, 0x9f, 0xb4, 0x31, 0x03, 0xca, 0x07, 0x63, 0x9c, 0xfa,
+	0x84, 0x24, 0xf4, 0x14, 0x0a, 0xdc, 0xd7, 0x63, 0xd5, 0x56, 0x84, 0x79, 0x8d, 0x21, 0xe2, 0x65,
+	0x76, 0x4b, 0xc6, 0xc5, 0xbf, 0x34, 0xfa, 0xd8, 0x13, 0xa1, 0xce, 0xe8, 0xc0, 0x60, 0xed, 0xcb,
+	0x97, 0xd8, 0xeb, 0x4f, 0x45, 0x2e, 0x35, 0x15, 0x39, 0xf4, 0x0a, 0x56, 0xc3, 0x1a, 0x5d, 0x5c,
+	0xe5, 0x76, 0x3c, 0x5b, 0xc4, 0x8e, 0xb0, 0x76, 0xeb, 0x11, 0x3f, 0xeb, 0xb1, 0xd1, 0x30, 0x20,
+	0xe2, 0x04, 0xa2, 0xc7, 0x8e, 0xc6, 0x26, 0x18, 0xa4, 0x43, 0x3e, 0x22, 0x94, 0x7d, 0x2a, 0x7d,
+	0xbb, 0x3e, 0xb5, 0x36, 0x1a, 0x07, 0xa8, 0x3f, 0x87, 0xdc, 0xb8, 0x73, 0x51, 0x01, 0xb2, 0x0d,
+	0xed, 0xcc, 0x38, 0xad, 0x1e, 0xd5, 0x6b, 0xd5, 0xf6, 0xb1, 0x9e, 0x7f, 0x07, 0x01, 0xac, 0xb4,
+	0x8f, 0x9b, 0xc6, 0x49, 0x33, 0xaf, 0xa0, 0xbb, 0x50, 0xa8, 0x37, 0x38, 0xd2, 0x68, 0xd5, 0x0f,
+	0x1b, 0xd5, 0xf6, 0x89, 0xae, 0xe5, 0x13, 0x48, 0x85, 0xf7, 0x03, 0xf0, 0x59, 0xbd, 0xfd, 0xb2,
+	0xa6, 0x57, 0xcf, 0xaa, 0x47, 0xc6, 0x81, 0xae, 0xd5, 0xb4, 0x46, 0xbb, 0x5e, 0x3d, 0x6a, 0xe5,
+	0x97, 0xd4, 0xc7, 0xb0, 0x1a, 0x3a, 0x00, 0xa5, 0xe1, 0x4e, 0x53, 0x6b, 0xd4, 0xea, 0x8d, 0xc3,
+	0xfc, 0x3b, 0x28, 0x03, 0xa9, 0x7a, 0xe3, 0xe0, 0xe8, 0xa4, 0xa6, 0xd5, 0xf2, 0x8a, 0xfa, 0x19,
+	0x14, 0x43, 0xbd, 0xd9, 0x10, 0x68, 0x99, 0x36, 0x59, 0x70, 0xec, 0xfb, 0xa7, 0x02, 0xef, 0xce,
+	0xe0, 0x95, 0x89, 0x35, 0xc3, 0xd3, 0xca, 0x4c, 0x4f, 0x3f, 0x84, 0x6c, 0x1f, 0x7b, 0xd1, 0x07,
+	0x0e, 0xcf, 0x8e, 0x94, 0x9e, 0xe9, 0x63, 0x2f, 0x94, 0x8e, 0x7e, 0x0c, 0x30, 0x30, 0x2d, 0x56,
+	0x3d, 0x6c, 0x5e, 0xc6, 0x59, 0xa2, 0x3e, 0xbd, 0x31, 0x10, 0x3f, 0x09, 0x58, 0xf4, 0x18, 0xb7,
+	0xfa, 0xbf, 0x25, 0x40, 0xd3, 0x24, 0xe8, 0x00, 0x92, 0xfe, 0x95, 0x43, 0xe4, 0x34, 0xb2, 0xb3,
+	0xb8, 0xf0, 0x72, 0xfb, 0xca, 0x61, 0xcd, 0xed, 0xca, 0x21, 0x51, 0x97, 0x4e, 0xc4, 0xbb, 0xf4,
+	0xac, 0xcd, 0xc0, 0x03, 0x58, 0x65, 0x73, 0xb8, 0xe7, 0xe3, 0x81, 0x23, 0xd3, 0x37, 0x02, 0xb0,
+	0xaf, 0x6e, 0x3e, 0x28, 0x18, 0xc1, 0x84, 0x20, 0x27, 0x86, 0x2c, 0x87, 0x36, 0x25, 0x10, 0x6d,
+	0x41, 0x1a, 0x3b, 0x8e, 0x4b, 0x2f, 0xcd, 0x01, 0xf6, 0x09, 0x4f, 0xc3, 0x94, 0x1e, 0x07, 0x31,
+	0xef, 0x46, 0x93, 0x0e, 0x0b, 0x82, 0x28, 0xb7, 0x99, 0x70, 0xc0, 0x61, 0x21, 0x98, 0x99, 0xd3,
+	0xa9, 0xc5, 0x72, 0x7a, 0x75, 0x32, 0xa7, 0xd5, 0xdf, 0x29, 0x90, 0x64, 0x2e, 0x41, 0x59, 0x58,
+	0xad, 0x69, 0xcd, 0xe3, 0x56, 0xbd, 0xad, 0xd5, 0x26, 0x1f, 0x1e, 0x3b, 0x69, 0x47, 0xf5, 0xc3,
+	0xfa, 0xfe, 0x11, 0x7b, 0xd2, 0x00, 0x2b, 0xaf, 0x4f, 0xb4, 0x13, 0xad, 0x96, 0x5f, 0x62, 0x6c,
+	0xd5, 0x83, 0x76, 0xfd, 0xb4, 0xca, 0xd8, 0x92, 0x08, 0x41, 0x4e, 0xfb, 0xba, 0xde, 0x36, 0xea,
+	0x8d, 0x7a, 0xbb, 0xce, 0x61, 0xcb, 0x8c, 0x9c, 0xc1, 0xb4, 0x5a, 0x7e, 0x85, 0x3d, 0xee, 0xd6,
+	0x51, 0xb5, 0xf5, 0x52, 0xab, 0xe5, 0xef, 0xa0, 0x3c, 0x64, 0xc2, 0x94, 0x60, 0x92, 0x53, 0x4f,
+	0x5f, 0x42, 0x36, 0x0c, 0x99, 0x4e, 0x2d, 0xc2, 0xe8, 0x4f, 0x1a, 0xaf, 0x1a, 0xc7, 0x67, 0x0d,
+	0xa1, 0x53, 0xb5, 0xdd, 0xd6, 0x5a, 0x6d, 0x4d, 0x17, 0x3a, 0x35, 0xf5, 0xe3, 0xe6, 0x71, 0x4b,
+	0xd3, 0xf3, 0x09, 0x94, 0x03, 0xa8, 0x1e, 0x1e, 0xea, 0xda, 0x21, 0xcf, 0xcc, 0xa5, 0xa7, 0x7f,
+	0x53, 0x60, 0x6d, 0x22, 0xc7, 0x99, 0x72, 0x52, 0x98, 0xd1, 0x6a, 0x57, 0xdb, 0x27, 0xad, 0xfc,
+	0x3b, 0x68, 0x03, 0xf2, 0xd2, 0x6c, 0x43, 0xd7, 0x0e, 0xb4, 0xfa, 0x29, 0xb7, 0x17, 0x41, 0x4e,
+	0xe6, 0xa0, 0xc1, 0xad, 0x93, 0x56, 0xcb, 0xdf, 0x4b, 0x0c, 0x1f, 0x5a, 0x68, 0x30, 0xe3, 0xf2,
+	0xc9, 0x29, 0x6b, 0xc6, 0x0d, 0x97, 0x8e, 0xd1, 0x6a, 0x46, 0x68, 0x7f, 0xe5, 0xbf, 0x0a, 0xac,
+	0x55, 0x83, 0xcf, 0x00, 0xb1, 0xfa, 0x43, 0x7d, 0x40, 0x32, 0xa3, 0x63, 0xcb, 0x2e, 0x34, 0x37,
+	0x7b, 0xa6, 0x37, 0x62, 0xa5, 0xc7, 0x73, 0xc6, 0xb2, 0x18, 0x69, 0x0d, 0xfb, 0x18, 0x19, 0x50,
+	0x68, 0x0d, 0xcf, 0x07, 0xe6, 0xd8, 0x45, 0xea, 0xcd, 0xcc, 0xa5, 0xc7, 0xd7, 0x2b, 0x13, 0x14,
+	0x95, 0xca, 0x7f, 0x94, 0x70, 0xc9, 0x17, 0x9a, 0xf7, 0x35, 0x64, 0xa4, 0x9e, 0xfc, 0x99, 0xa2,
+	0x47, 0xd7, 0x4e, 0xa6, 0x81, 0x49, 0x0b, 0x4c, 0x9a, 0xe8, 0x1b, 0xc8, 0xc8, 0xcb, 0xc4, 0x79,
+	0x01, 0x9e, 0xd2, 0xdc, 0xee, 0x30, 0xb1, 0x9b, 0xac, 0xfc, 0x46, 0x81, 0x42, 0xb0, 0x31, 0xa3,
+	0xa1, 0x31, 0x2e, 0xdc, 0x93, 0x1e, 0x94, 0x28, 0x52, 0xb5, 0xbb, 0x4d, 0x97, 0xd2, 0x8b, 0x6b,
+	0x02, 0x36, 0xb5, 0x10, 0x2c, 0x7d, 0x6f, 0x21, 0x5a, 0xa9, 0xc9, 0xdf, 0x53, 0x90, 0x8f, 0xde,
+	0xb5, 0x54, 0xe4, 0x1b, 0x00, 0xf1, 0x35, 0xc0, 0x03, 0xfb, 0xd1, 0xdc, 0x86, 0x1b, 0xff, 0x46,
+	0x29, 0x3d, 0xbe, 0x89, 0x4c, 0xf6, 0x86, 0x5f, 0x42, 0xe1, 0x0c, 0x9b, 0xfe, 0x8b, 0xf8, 0x52,
+	0x07, 0x55, 0x6e, 0xb5, 0x01, 0x12, 0x17, 0x7e, 0xf2, 0x1d, 0xb6, 0x46, 0xbb, 0x0a, 0xa2, 0x90,
+	0x1b, 0x5f, 0x58, 0xa0, 0x67, 0x37, 0x0a, 0x8a, 0x2f, 0x44, 0x4a, 0xe5, 0x45, 0xc9, 0xa5, 0xc1,
+	0x16, 0xac, 0x1f, 0x04, 0xdf, 0xf0, 0xb1, 0x7d, 0xc0, 0x93, 0x45, 0x96, 0x0f, 0xe2, 0xc6, 0xa7,
+	0x8b, 0xef, 0x29, 0xd0, 0x9b, 0xe9, 0x3a, 0x75, 0x4b, 0xfb, 0x6e, 0xbb, 0x0e, 0x43, 0xbf, 0x52,
+	0x60, 0x63, 0xd6, 0xfe, 0x15, 0xdd, 0x1c, 0xa1, 0xe9, 0x15, 0x70, 0xe9, 0xd3, 0xdb, 0x31, 0x49,
+	0x1d, 0x86, 0x90, 0x9f, 0x5c, 0xa7, 0xa1, 0xb9, 0x86, 0xcc, 0x59, 0xda, 0x95, 0x76, 0x17, 0x67,
+	0x90, 0xd7, 0xfe, 0x34, 0x7c, 0xcc, 0xd1, 0x3e, 0x0e, 0x6d, 0x96, 0xc5, 0x1f, 0x2a, 0xe5, 0xe0,
+	0x0f, 0x95, 0xb2, 0xc6, 0xfe, 0x50, 0x99, 0x1f, 0xc6, 0xe9, 0x5d, 0xde, 0xae, 0x82, 0x5e, 0x41,
+	0xf6, 0x00, 0xdb, 0xd4, 0x36, 0x3b, 0xd8, 0x62, 0x1f, 0x67, 0x73, 0xc5, 0x2e, 0x52, 0xcd, 0x5e,
+	0x41, 0x5a, 0xd6, 0x20, 0x66, 0x0a, 0x7a, 0x34, 0x87, 0xe5, 0x94, 0x5a, 0x43, 0xdb, 0xc7, 0xee,
+	0x15, 0xa3, 0x2a, 0xcd, 0xb9, 0xb0, 0x82, 0x21, 0x23, 0x34, 0x96, 0xe5, 0xe2, 0x35, 0x14, 0x5a,
+	0xbe, 0x4b, 0xf0, 0x20, 0xfa, 0xaa, 0xf5, 0x16, 0xd1, 0x76, 0x86, 0x13, 0x38, 0xf3, 0xae, 0x52,
+	0xf9, 0x05, 0xe4, 0xe4, 0x38, 0x1f, 0x5c, 0x62, 0x42, 0x26, 0xfe, 0x0d, 0x87, 0xe6, 0x56, 0xb9,
+	0x19, 0x9f, 0x90, 0xa5, 0xef, 0x2f, 0x46, 0x2c, 0x6b, 0xe2, 0xef, 0x15, 0xc8, 0x1f, 0x99, 0x17,
+	0xa4, 0x73, 0xd5, 0xb1, 0x48, 0x70, 0xff, 0x25, 0x14, 0xa6, 0xe6, 0x5d, 0xb4, 0x7b, 0xe3, 0x5b,
+	0x9d, 0x18, 0xab, 0x4b, 0x7b, 0xb7, 0xe0, 0x10, 0xea, 0x9c, 0xaf, 0x70, 0x0f, 0x7e, 0xf2, 0xff,
+	0x01, 0x00, 0x4a, 0x3a, 0x16, 0x6a, 0xe3, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2214,3 +2862,246 @@ var _ValidatorService_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
+
+// ChainServiceClient is the client API for ChainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChainServiceClient interface {
+	StreamChainReorgs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ChainService_StreamChainReorgsClient, error)
+}
+
+type chainServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChainServiceClient(cc *grpc.ClientConn) ChainServiceClient {
+	return &chainServiceClient{cc}
+}
+
+func (c *chainServiceClient) StreamChainReorgs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ChainService_StreamChainReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ChainService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ChainService/StreamChainReorgs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainServiceStreamChainReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainService_StreamChainReorgsClient interface {
+	Recv() (*ChainReorg, error)
+	grpc.ClientStream
+}
+
+type chainServiceStreamChainReorgsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainServiceStreamChainReorgsClient) Recv() (*ChainReorg, error) {
+	m := new(ChainReorg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChainServiceServer is the server API for ChainService service.
+type ChainServiceServer interface {
+	StreamChainReorgs(*empty.Empty, ChainService_StreamChainReorgsServer) error
+}
+
+// UnimplementedChainServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedChainServiceServer struct {
+}
+
+func (*UnimplementedChainServiceServer) StreamChainReorgs(req *empty.Empty, srv ChainService_StreamChainReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChainReorgs not implemented")
+}
+
+func RegisterChainServiceServer(s *grpc.Server, srv ChainServiceServer) {
+	s.RegisterService(&_ChainService_serviceDesc, srv)
+}
+
+func _ChainService_StreamChainReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainServiceServer).StreamChainReorgs(m, &chainServiceStreamChainReorgsServer{stream})
+}
+
+type ChainService_StreamChainReorgsServer interface {
+	Send(*ChainReorg) error
+	grpc.ServerStream
+}
+
+type chainServiceStreamChainReorgsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainServiceStreamChainReorgsServer) Send(m *ChainReorg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ChainService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ChainService",
+	HandlerType: (*ChainServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChainReorgs",
+			Handler:       _ChainService_StreamChainReorgs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// DepositServiceClient is the client API for DepositService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DepositServiceClient interface {
+	ListDeposits(ctx context.Context, in *ListDepositsRequest, opts ...grpc.CallOption) (*ListDepositsResponse, error)
+}
+
+type depositServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDepositServiceClient(cc *grpc.ClientConn) DepositServiceClient {
+	return &depositServiceClient{cc}
+}
+
+func (c *depositServiceClient) ListDeposits(ctx context.Context, in *ListDepositsRequest, opts ...grpc.CallOption) (*ListDepositsResponse, error) {
+	out := new(ListDepositsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DepositService/ListDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DepositServiceServer is the server API for DepositService service.
+type DepositServiceServer interface {
+	ListDeposits(context.Context, *ListDepositsRequest) (*ListDepositsResponse, error)
+}
+
+// UnimplementedDepositServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDepositServiceServer struct {
+}
+
+func (*UnimplementedDepositServiceServer) ListDeposits(ctx context.Context, req *ListDepositsRequest) (*ListDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeposits not implemented")
+}
+
+func RegisterDepositServiceServer(s *grpc.Server, srv DepositServiceServer) {
+	s.RegisterService(&_DepositService_serviceDesc, srv)
+}
+
+func _DepositService_ListDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositServiceServer).ListDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DepositService/ListDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).ListDeposits(ctx, req.(*ListDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DepositService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.DepositService",
+	HandlerType: (*DepositServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeposits",
+			Handler:    _DepositService_ListDeposits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// LifecycleServiceClient is the client API for LifecycleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LifecycleServiceClient interface {
+	ValidatorTimeline(ctx context.Context, in *ValidatorTimelineRequest, opts ...grpc.CallOption) (*ValidatorTimelineResponse, error)
+}
+
+type lifecycleServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLifecycleServiceClient(cc *grpc.ClientConn) LifecycleServiceClient {
+	return &lifecycleServiceClient{cc}
+}
+
+func (c *lifecycleServiceClient) ValidatorTimeline(ctx context.Context, in *ValidatorTimelineRequest, opts ...grpc.CallOption) (*ValidatorTimelineResponse, error) {
+	out := new(ValidatorTimelineResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.LifecycleService/ValidatorTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LifecycleServiceServer is the server API for LifecycleService service.
+type LifecycleServiceServer interface {
+	ValidatorTimeline(context.Context, *ValidatorTimelineRequest) (*ValidatorTimelineResponse, error)
+}
+
+// UnimplementedLifecycleServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLifecycleServiceServer struct {
+}
+
+func (*UnimplementedLifecycleServiceServer) ValidatorTimeline(ctx context.Context, req *ValidatorTimelineRequest) (*ValidatorTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTimeline not implemented")
+}
+
+func RegisterLifecycleServiceServer(s *grpc.Server, srv LifecycleServiceServer) {
+	s.RegisterService(&_LifecycleService_serviceDesc, srv)
+}
+
+func _LifecycleService_ValidatorTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifecycleServiceServer).ValidatorTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.LifecycleService/ValidatorTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifecycleServiceServer).ValidatorTimeline(ctx, req.(*ValidatorTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LifecycleService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.LifecycleService",
+	HandlerType: (*LifecycleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatorTimeline",
+			Handler:    _LifecycleService_ValidatorTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
@@ -0,0 +1,1034 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/validator/db/performance.proto
+
+package ethereum_validator_db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EpochPerformance struct {
+	Epoch                uint64      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StartTime            uint64      `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Accounted            bool        `protobuf:"varint,3,opt,name=accounted,proto3" json:"accounted,omitempty"`
+	AttestationIncluded  bool        `protobuf:"varint,4,opt,name=attestation_included,json=attestationIncluded,proto3" json:"attestation_included,omitempty"`
+	InclusionDistance    uint64      `protobuf:"varint,5,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	CorrectSource        bool        `protobuf:"varint,6,opt,name=correct_source,json=correctSource,proto3" json:"correct_source,omitempty"`
+	CorrectTarget        bool        `protobuf:"varint,7,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	CorrectHead          bool        `protobuf:"varint,8,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	BalanceBefore        uint64      `protobuf:"varint,9,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter         uint64      `protobuf:"varint,10,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Proposals            []*Proposal `protobuf:"bytes,11,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Aggregations         uint64      `protobuf:"varint,12,opt,name=aggregations,proto3" json:"aggregations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *EpochPerformance) Reset()         { *m = EpochPerformance{} }
+func (m *EpochPerformance) String() string { return proto.CompactTextString(m) }
+func (*EpochPerformance) ProtoMessage()    {}
+func (*EpochPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34baeb06e1216159, []int{0}
+}
+func (m *EpochPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPerformance.Merge(m, src)
+}
+func (m *EpochPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochPerformance proto.InternalMessageInfo
+
+func (m *EpochPerformance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetAccounted() bool {
+	if m != nil {
+		return m.Accounted
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetAttestationIncluded() bool {
+	if m != nil {
+		return m.AttestationIncluded
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetCorrectSource() bool {
+	if m != nil {
+		return m.CorrectSource
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetCorrectTarget() bool {
+	if m != nil {
+		return m.CorrectTarget
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetCorrectHead() bool {
+	if m != nil {
+		return m.CorrectHead
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetBalanceBefore() uint64 {
+	if m != nil {
+		return m.BalanceBefore
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetBalanceAfter() uint64 {
+	if m != nil {
+		return m.BalanceAfter
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *EpochPerformance) GetAggregations() uint64 {
+	if m != nil {
+		return m.Aggregations
+	}
+	return 0
+}
+
+type Proposal struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Orphaned             bool     `protobuf:"varint,3,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34baeb06e1216159, []int{1}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *Proposal) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *Proposal) GetOrphaned() bool {
+	if m != nil {
+		return m.Orphaned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EpochPerformance)(nil), "ethereum.validator.db.EpochPerformance")
+	proto.RegisterType((*Proposal)(nil), "ethereum.validator.db.Proposal")
+}
+
+func init() {
+	proto.RegisterFile("proto/validator/db/performance.proto", fileDescriptor_34baeb06e1216159)
+}
+
+var fileDescriptor_34baeb06e1216159 = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0x15, 0xda, 0x5d, 0x9a, 0x69, 0x16, 0x81, 0x59, 0x24, 0x0b, 0x41, 0x29, 0x05, 0xa4,
+	0x5e, 0x48, 0x05, 0x9c, 0x39, 0xb0, 0x02, 0x09, 0x6e, 0xab, 0xb0, 0x17, 0x4e, 0x91, 0x63, 0x4f,
+	0xdb, 0x88, 0x34, 0x13, 0xd9, 0x53, 0x9e, 0x91, 0x23, 0x8f, 0x80, 0x7a, 0xe1, 0x35, 0x50, 0x66,
+	0xdd, 0x3f, 0x48, 0xdc, 0xea, 0xdf, 0xf7, 0x1b, 0x8f, 0xd5, 0x2f, 0xf0, 0xb2, 0xf3, 0xc4, 0xb4,
+	0xf8, 0x61, 0x9a, 0xda, 0x19, 0x26, 0xbf, 0x70, 0xd5, 0xa2, 0x43, 0xbf, 0x24, 0xbf, 0x31, 0xad,
+	0xc5, 0x5c, 0x62, 0xf5, 0x08, 0x79, 0x8d, 0x1e, 0xb7, 0x9b, 0xfc, 0x20, 0xe6, 0xae, 0x9a, 0xfd,
+	0x19, 0xc0, 0xfd, 0x4f, 0x1d, 0xd9, 0xf5, 0xf5, 0x71, 0x42, 0x5d, 0xc2, 0x19, 0xf6, 0x4c, 0x27,
+	0xd3, 0x64, 0x3e, 0x2c, 0x6e, 0x0f, 0xea, 0x29, 0x40, 0x60, 0xe3, 0xb9, 0xe4, 0x7a, 0x83, 0xfa,
+	0x8e, 0x44, 0xa9, 0x90, 0x9b, 0x7a, 0x83, 0xea, 0x09, 0xa4, 0xc6, 0x5a, 0xda, 0xb6, 0x8c, 0x4e,
+	0x0f, 0xa6, 0xc9, 0x7c, 0x54, 0x1c, 0x81, 0x7a, 0x03, 0x97, 0x86, 0x19, 0x03, 0x1b, 0xae, 0xa9,
+	0x2d, 0xeb, 0xd6, 0x36, 0x5b, 0x87, 0x4e, 0x0f, 0x45, 0x7c, 0x78, 0x92, 0x7d, 0x89, 0x91, 0x7a,
+	0x0d, 0x4a, 0xb4, 0xd0, 0x0f, 0xb8, 0x3a, 0x70, 0xff, 0x36, 0x7d, 0x26, 0x7b, 0x1f, 0x1c, 0x92,
+	0x8f, 0x31, 0x50, 0xaf, 0xe0, 0x9e, 0x25, 0xef, 0xd1, 0x72, 0x19, 0x68, 0xeb, 0x2d, 0xea, 0x73,
+	0xb9, 0xfb, 0x22, 0xd2, 0xaf, 0x02, 0x4f, 0x35, 0x36, 0x7e, 0x85, 0xac, 0xef, 0xfe, 0xa3, 0xdd,
+	0x08, 0x54, 0xcf, 0x21, 0xdb, 0x6b, 0x6b, 0x34, 0x4e, 0x8f, 0x44, 0x1a, 0x47, 0xf6, 0x19, 0x8d,
+	0xeb, 0x6f, 0xaa, 0x4c, 0xd3, 0xef, 0x2e, 0x2b, 0x5c, 0x92, 0x47, 0x9d, 0xca, 0xdb, 0x2e, 0x22,
+	0xbd, 0x12, 0xa8, 0x5e, 0xc0, 0x1e, 0x94, 0x66, 0xc9, 0xe8, 0x35, 0x88, 0x95, 0x45, 0xf8, 0xa1,
+	0x67, 0xea, 0x3d, 0xa4, 0x9d, 0xa7, 0x8e, 0x82, 0x69, 0x82, 0x1e, 0x4f, 0x07, 0xf3, 0xf1, 0xdb,
+	0x67, 0xf9, 0x7f, 0x1b, 0xcb, 0xaf, 0xa3, 0x57, 0x1c, 0x27, 0xd4, 0x0c, 0x32, 0xb3, 0x5a, 0x79,
+	0x5c, 0xc9, 0x3f, 0x18, 0x74, 0x76, 0xbb, 0xe2, 0x94, 0xcd, 0xbe, 0xc1, 0x68, 0x3f, 0xaa, 0x14,
+	0x0c, 0x43, 0x43, 0x1c, 0xfb, 0x95, 0xdf, 0x7d, 0xbd, 0x55, 0x43, 0xf6, 0x7b, 0xe9, 0x89, 0x58,
+	0xea, 0xcd, 0x8a, 0x54, 0x48, 0x41, 0xc4, 0xea, 0x31, 0x8c, 0xc8, 0x77, 0x6b, 0xd3, 0x1e, 0xda,
+	0x3d, 0x9c, 0xaf, 0xb2, 0x9f, 0xbb, 0x49, 0xf2, 0x6b, 0x37, 0x49, 0x7e, 0xef, 0x26, 0x49, 0x75,
+	0x2e, 0x1f, 0xdc, 0xbb, 0xbf, 0x03, 0x00, 0x4d, 0x7e, 0xd5, 0x76, 0x98, 0x02, 0x00, 0x00,
+}
+
+func (m *EpochPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Aggregations != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Aggregations))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPerformance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.BalanceAfter != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.BalanceAfter))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BalanceBefore != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.BalanceBefore))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CorrectHead {
+		i--
+		if m.CorrectHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CorrectTarget {
+		i--
+		if m.CorrectTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CorrectSource {
+		i--
+		if m.CorrectSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AttestationIncluded {
+		i--
+		if m.AttestationIncluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Accounted {
+		i--
+		if m.Accounted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Orphaned {
+		i--
+		if m.Orphaned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintPerformance(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovPerformance(uint64(m.Epoch))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPerformance(uint64(m.StartTime))
+	}
+	if m.Accounted {
+		n += 2
+	}
+	if m.AttestationIncluded {
+		n += 2
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovPerformance(uint64(m.InclusionDistance))
+	}
+	if m.CorrectSource {
+		n += 2
+	}
+	if m.CorrectTarget {
+		n += 2
+	}
+	if m.CorrectHead {
+		n += 2
+	}
+	if m.BalanceBefore != 0 {
+		n += 1 + sovPerformance(uint64(m.BalanceBefore))
+	}
+	if m.BalanceAfter != 0 {
+		n += 1 + sovPerformance(uint64(m.BalanceAfter))
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovPerformance(uint64(l))
+		}
+	}
+	if m.Aggregations != 0 {
+		n += 1 + sovPerformance(uint64(m.Aggregations))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovPerformance(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovPerformance(uint64(l))
+	}
+	if m.Orphaned {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPerformance(x uint64) (n int) {
+	return sovPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accounted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationIncluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttestationIncluded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectSource = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectTarget = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectHead = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+			}
+			m.BalanceBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAfter", wireType)
+			}
+			m.BalanceAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
+			}
+			m.Aggregations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Aggregations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphaned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Orphaned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPerformance = fmt.Errorf("proto: unexpected end of group")
+)
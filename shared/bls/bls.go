@@ -219,6 +219,45 @@ func (s *Signature) VerifyAggregateCommon(pubKeys []*PublicKey, msg [32]byte, do
 	return s.s.VerifyHashWithDomain(aggregated.p, concatMsgAndDomain(msg[:], domain))
 }
 
+// VerifyMultipleSignatures verifies a non-singular set of signatures, each against its own
+// public key, message and domain, using a single multi-pairing. Every signature and public key
+// is multiplied by a random non-zero scalar beforehand so that a set of invalid signatures
+// cannot be crafted to cancel each other out.
+func VerifyMultipleSignatures(sigs []*Signature, msgs [][32]byte, domains []uint64, pubKeys []*PublicKey) (bool, error) {
+	if featureconfig.Get().SkipBLSVerify {
+		return true, nil
+	}
+	length := len(sigs)
+	if length == 0 {
+		return false, nil
+	}
+	if length != len(msgs) || length != len(domains) || length != len(pubKeys) {
+		return false, errors.Errorf("provided signatures, messages, domains and public keys have differing lengths: %d, %d, %d, %d",
+			length, len(msgs), len(domains), len(pubKeys))
+	}
+	hashWithDomains := make([]byte, 0, length*concatMsgDomainSize)
+	rawKeys := make([]bls12.PublicKey, length)
+	aggregated := new(bls12.G2)
+	for i := 0; i < length; i++ {
+		r := new(bls12.Fr)
+		for r.IsZero() {
+			r.SetByCSPRNG()
+		}
+		sig := new(bls12.G2)
+		bls12.G2Mul(sig, bls12.CastFromSign(sigs[i].s), r)
+		if i == 0 {
+			*aggregated = *sig
+		} else {
+			bls12.G2Add(aggregated, aggregated, sig)
+		}
+		pub := new(bls12.G1)
+		bls12.G1Mul(pub, bls12.CastFromPublicKey(pubKeys[i].p), r)
+		rawKeys[i] = *bls12.CastToPublicKey(pub)
+		hashWithDomains = append(hashWithDomains, concatMsgAndDomain(msgs[i][:], domains[i])...)
+	}
+	return bls12.CastToSign(aggregated).VerifyAggregateHashWithDomain(rawKeys, hashWithDomains), nil
+}
+
 // NewAggregateSignature creates a blank aggregate signature.
 func NewAggregateSignature() *Signature {
 	return &Signature{s: bls12.HashAndMapToSignature([]byte{'m', 'o', 'c', 'k'})}
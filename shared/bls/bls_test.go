@@ -83,6 +83,72 @@ func TestVerifyAggregate_ReturnsFalseOnEmptyPubKeyList(t *testing.T) {
 	}
 }
 
+func TestVerifyMultipleSignatures(t *testing.T) {
+	pubkeys := make([]*bls.PublicKey, 0, 10)
+	sigs := make([]*bls.Signature, 0, 10)
+	msgs := make([][32]byte, 0, 10)
+	domains := make([]uint64, 0, 10)
+	for i := 0; i < 10; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv := bls.RandKey()
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg[:], uint64(i)))
+		msgs = append(msgs, msg)
+		domains = append(domains, uint64(i))
+	}
+	verified, err := bls.VerifyMultipleSignatures(sigs, msgs, domains, pubkeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified {
+		t.Error("Signatures did not verify")
+	}
+
+	// Swapping two signatures keeps the aggregate identical but must fail the randomized check.
+	sigs[0], sigs[1] = sigs[1], sigs[0]
+	verified, err = bls.VerifyMultipleSignatures(sigs, msgs, domains, pubkeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified {
+		t.Error("Expected swapped signatures to fail verification")
+	}
+}
+
+func TestVerifyMultipleSignatures_DifferingLengths(t *testing.T) {
+	priv := bls.RandKey()
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	sigs := []*bls.Signature{priv.Sign(msg[:], 0)}
+	if _, err := bls.VerifyMultipleSignatures(sigs, [][32]byte{msg}, []uint64{}, []*bls.PublicKey{priv.PublicKey()}); err == nil {
+		t.Error("Expected error for differing lengths")
+	}
+}
+
+func TestSignatureSet_Verify(t *testing.T) {
+	set := bls.NewSet()
+	other := bls.NewSet()
+	for i := 0; i < 4; i++ {
+		msg := [32]byte{byte(i)}
+		priv := bls.RandKey()
+		if i%2 == 0 {
+			set.Add(priv.Sign(msg[:], 0), priv.PublicKey(), msg, 0)
+		} else {
+			other.Add(priv.Sign(msg[:], 0), priv.PublicKey(), msg, 0)
+		}
+	}
+	set.Join(other)
+	if set.Len() != 4 {
+		t.Fatalf("Wanted 4 signatures in set, received %d", set.Len())
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified {
+		t.Error("Signature set did not verify")
+	}
+}
+
 func TestComputeDomain_OK(t *testing.T) {
 	tests := []struct {
 		epoch      uint64
@@ -0,0 +1,52 @@
+package bls
+
+// SignatureSet refers to the defined set of signatures and their respective public keys,
+// messages and domains required to verify them.
+type SignatureSet struct {
+	Signatures []*Signature
+	PublicKeys []*PublicKey
+	Messages   [][32]byte
+	Domains    []uint64
+}
+
+// NewSet constructs an empty signature set object.
+func NewSet() *SignatureSet {
+	return &SignatureSet{
+		Signatures: []*Signature{},
+		PublicKeys: []*PublicKey{},
+		Messages:   [][32]byte{},
+		Domains:    []uint64{},
+	}
+}
+
+// Add appends a single signature with its public key, message and domain to the set.
+func (s *SignatureSet) Add(sig *Signature, pubKey *PublicKey, msg [32]byte, domain uint64) *SignatureSet {
+	s.Signatures = append(s.Signatures, sig)
+	s.PublicKeys = append(s.PublicKeys, pubKey)
+	s.Messages = append(s.Messages, msg)
+	s.Domains = append(s.Domains, domain)
+	return s
+}
+
+// Join merges the provided signature set to its current one.
+func (s *SignatureSet) Join(set *SignatureSet) *SignatureSet {
+	s.Signatures = append(s.Signatures, set.Signatures...)
+	s.PublicKeys = append(s.PublicKeys, set.PublicKeys...)
+	s.Messages = append(s.Messages, set.Messages...)
+	s.Domains = append(s.Domains, set.Domains...)
+	return s
+}
+
+// Len returns the number of signatures in the set.
+func (s *SignatureSet) Len() int {
+	return len(s.Signatures)
+}
+
+// Verify the current signature set using randomized batch verification. An empty set is
+// trivially valid.
+func (s *SignatureSet) Verify() (bool, error) {
+	if s.Len() == 0 {
+		return true, nil
+	}
+	return VerifyMultipleSignatures(s.Signatures, s.Messages, s.Domains, s.PublicKeys)
+}
@@ -63,6 +63,12 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies a set of peers to connect to explicitly and to always accept connections from.
+	TrustedPeers = cli.StringSliceFlag{
+		Name: "trusted-peer",
+		Usage: "Connect with this peer and accept its connections even when at the peer limits. " +
+			"This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = cli.StringFlag{
 		Name:  "bootstrap-node",
@@ -118,6 +124,28 @@ var (
 		Usage: "The max number of p2p peers to maintain.",
 		Value: 30,
 	}
+	// P2PMaxInboundPeers defines a flag to specify the max number of inbound peers in libp2p.
+	P2PMaxInboundPeers = cli.Int64Flag{
+		Name: "p2p-max-inbound-peers",
+		Usage: "The max number of inbound p2p peers to maintain, reserving the remaining slots for peers we dial. " +
+			"The default of 0 applies no direction specific limit.",
+	}
+	// P2PMaxOutboundPeers defines a flag to specify the max number of outbound peers in libp2p.
+	P2PMaxOutboundPeers = cli.Int64Flag{
+		Name: "p2p-max-outbound-peers",
+		Usage: "The max number of outbound p2p peers to maintain, reserving the remaining slots for peers dialing us. " +
+			"The default of 0 applies no direction specific limit.",
+	}
+	// P2PMaxPeersPerIP defines a flag to specify the max number of peers sharing a single IP address.
+	P2PMaxPeersPerIP = cli.Int64Flag{
+		Name:  "p2p-max-peers-per-ip",
+		Usage: "The max number of p2p peers connected from the same IP address. The default of 0 applies no limit.",
+	}
+	// P2PMaxPeersPerSubnet defines a flag to specify the max number of peers sharing a /24 subnet.
+	P2PMaxPeersPerSubnet = cli.Int64Flag{
+		Name:  "p2p-max-peers-per-subnet",
+		Usage: "The max number of p2p peers connected from the same /24 IP subnet. The default of 0 applies no limit.",
+	}
 	// P2PWhitelist defines a CIDR subnet to exclusively allow connections.
 	P2PWhitelist = cli.StringFlag{
 		Name: "p2p-whitelist",
@@ -42,6 +42,7 @@ type Flags struct {
 	ProtectAttester                            bool   // ProtectAttester prevents the validator client from signing any attestations that would be considered a slashable offense.
 	DisableStrictAttestationPubsubVerification bool   // DisableStrictAttestationPubsubVerification will disabling strict signature verification in pubsub.
 	DisableUpdateHeadPerAttestation            bool   // DisableUpdateHeadPerAttestation will disabling update head on per attestation basis.
+	EnableBatchVerification                    bool   // EnableBatchVerification verifies gossip attestation signatures in randomized batches.
 
 	// DisableForkChoice disables using LMD-GHOST fork choice to update
 	// the head of the chain based on attestations and instead accepts any valid received block
@@ -143,6 +144,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Disabled update head on per attestation basis")
 		cfg.DisableUpdateHeadPerAttestation = true
 	}
+	if ctx.GlobalBool(enableBatchGossipVerificationFlag.Name) {
+		log.Warn("Enabled batch signature verification of gossip attestations")
+		cfg.EnableBatchVerification = true
+	}
 
 	Init(cfg)
 }
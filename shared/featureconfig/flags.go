@@ -94,6 +94,11 @@ var (
 		Name:  "disable-update-head-attestation",
 		Usage: "Disable update fork choice head on per attestation. See PR 4802 for details.",
 	}
+	enableBatchGossipVerificationFlag = cli.BoolFlag{
+		Name: "enable-batch-gossip-verification",
+		Usage: "Verify the signatures of gossip attestations and aggregates in randomized batches " +
+			"instead of one at a time.",
+	}
 )
 
 // Deprecated flags list.
@@ -257,6 +262,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	cacheFilteredBlockTreeFlag,
 	disableStrictAttestationPubsubVerificationFlag,
 	disableUpdateHeadPerAttestation,
+	enableBatchGossipVerificationFlag,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -268,4 +274,5 @@ var E2EBeaconChainFlags = []string{
 	"--enable-eth1-data-vote-cache",
 	"--initial-sync-cache-state",
 	"--proto-array-forkchoice",
+	"--enable-batch-gossip-verification",
 }
@@ -29,3 +29,13 @@ func GetVersion() string {
 	}
 	return fmt.Sprintf("Prysm/Git commit: %s. Built at: %s", gitCommit, buildDate)
 }
+
+// GetShortVersion returns the version string of this build with the abbreviated git commit, short
+// enough to fit in the graffiti of a block.
+func GetShortVersion() string {
+	GetVersion() // Interpolates the git commit of local builds.
+	if len(gitCommit) < 8 || strings.Contains(gitCommit, " ") {
+		return "Prysm"
+	}
+	return "Prysm/" + gitCommit[:8]
+}
@@ -0,0 +1,94 @@
+/**
+ * Deposit status
+ *
+ * A gRPC client which lists the deposits made for a validator public key, or sent from an eth1
+ * address, and what became of them on the beacon chain.
+ *
+ * Example: deposit-status --beacon-rpc 127.0.0.1:4000 --public-key 0xa1b2...
+ */
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "deposit_status")
+
+func decodeHex(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(value, "0x"))
+}
+
+func main() {
+	beaconRPC := flag.String("beacon-rpc", "localhost:4000", "Beacon node gRPC endpoint")
+	publicKey := flag.String("public-key", "", "Hex encoded validator public key of the deposits")
+	eth1Address := flag.String("eth1-address", "", "Hex encoded eth1 address which sent the deposits")
+	timeout := flag.Duration("timeout", 10*time.Second, "Timeout of the request to the beacon node")
+	flag.Parse()
+
+	req := &pb.ListDepositsRequest{}
+	var err error
+	if *publicKey != "" {
+		if req.PublicKey, err = decodeHex(*publicKey); err != nil {
+			log.Fatalf("Invalid public key: %v", err)
+		}
+	}
+	if *eth1Address != "" {
+		if req.Eth1Address, err = decodeHex(*eth1Address); err != nil {
+			log.Fatalf("Invalid eth1 address: %v", err)
+		}
+	}
+	if len(req.PublicKey) == 0 && len(req.Eth1Address) == 0 {
+		log.Fatal("Must specify --public-key or --eth1-address")
+	}
+
+	conn, err := grpc.Dial(*beaconRPC, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Could not dial beacon node: %v", err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	res, err := pb.NewDepositServiceClient(conn).ListDeposits(ctx, req)
+	if err != nil {
+		log.Fatalf("Could not list deposits: %v", err)
+	}
+	if len(res.Deposits) == 0 {
+		fmt.Println("No deposits found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tPUBLIC KEY\tETH1 BLOCK\tETH1 TX\tFROM\tAMOUNT (GWEI)\tCLASSIFICATION\tINCLUSION\tVALIDATOR\tSTATUS")
+	for _, d := range res.Deposits {
+		validator := "-"
+		if d.ValidatorStatus != pb.ValidatorStatus_UNKNOWN_STATUS {
+			validator = fmt.Sprintf("%d", d.ValidatorIndex)
+		}
+		fmt.Fprintf(w, "%d\t%#x\t%d\t%#x\t%#x\t%d\t%s\t%s\t%s\t%s\n",
+			d.Index,
+			d.PublicKey,
+			d.Eth1BlockNumber,
+			d.Eth1TxHash,
+			d.Eth1Address,
+			d.Amount,
+			d.Classification,
+			d.Inclusion,
+			validator,
+			d.ValidatorStatus,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
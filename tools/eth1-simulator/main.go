@@ -0,0 +1,84 @@
+/**
+ * Eth1 simulator
+ *
+ * Runs a simulated eth1 chain with the deposit contract deployed, and serves it over the
+ * JSON-RPC and websocket APIs for beacon nodes started with --web3provider and
+ * --http-web3provider. Interop deposits can be sent on start up so that a local chain starts
+ * without a real eth1 node.
+ *
+ * Usage: Run eth1-simulator --help for flag options.
+ */
+package main
+
+import (
+	"flag"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/simulator"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/sirupsen/logrus"
+	_ "go.uber.org/automaxprocs"
+)
+
+var (
+	host        = flag.String("host", "127.0.0.1", "Host to serve the JSON-RPC and websocket APIs on")
+	port        = flag.Int("port", 8545, "Port to serve the JSON-RPC and websocket APIs on")
+	blockTime   = flag.Duration("block-time", 14*time.Second, "Time between blocks, zero to only mine blocks with deposits")
+	numDeposits = flag.Uint64("interop-num-deposits", 0, "Number of deterministic interop deposits to send on start up")
+	depositTime = flag.Duration("deposit-interval", 0, "Time between interop deposits, zero to include all of them in one block")
+
+	log = logrus.WithField("prefix", "eth1-simulator")
+)
+
+func main() {
+	flag.Parse()
+
+	sim, err := simulator.New(&simulator.Config{BlockTime: *blockTime})
+	if err != nil {
+		log.Fatalf("Could not create simulator: %v", err)
+	}
+	if err := sim.Start(net.JoinHostPort(*host, strconv.Itoa(*port))); err != nil {
+		log.Fatalf("Could not start simulator: %v", err)
+	}
+	if *numDeposits > 0 {
+		go sendDeposits(sim)
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	<-sigc
+	log.Info("Shutting down")
+	if err := sim.Stop(); err != nil {
+		log.Errorf("Could not stop simulator: %v", err)
+	}
+}
+
+// sendDeposits sends the interop deposits, the same ones used by --interop-num-validators.
+func sendDeposits(sim *simulator.Simulator) {
+	privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(0, *numDeposits)
+	if err != nil {
+		log.Fatalf("Could not generate interop keys: %v", err)
+	}
+	depositData, _, err := interop.DepositDataFromKeys(privKeys, pubKeys)
+	if err != nil {
+		log.Fatalf("Could not create deposit data: %v", err)
+	}
+	for i, data := range depositData {
+		if err := sim.Deposit(data); err != nil {
+			log.Fatalf("Could not send deposit %d: %v", i, err)
+		}
+		if *depositTime > 0 {
+			sim.Mine(1)
+			time.Sleep(*depositTime)
+		}
+	}
+	if *depositTime == 0 {
+		sim.Mine(1)
+	}
+	log.WithField("deposits", len(depositData)).Info("Sent interop deposits")
+}
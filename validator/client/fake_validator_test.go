@@ -14,6 +14,7 @@ type fakeValidator struct {
 	WaitForActivationCalled          bool
 	WaitForChainStartCalled          bool
 	WaitForSyncCalled                bool
+	CheckDoppelgangersCalled         bool
 	NextSlotRet                      <-chan uint64
 	NextSlotCalled                   bool
 	CanonicalHeadSlotCalled          bool
@@ -51,6 +52,11 @@ func (fv *fakeValidator) WaitForSync(_ context.Context) error {
 	return nil
 }
 
+func (fv *fakeValidator) CheckDoppelgangers(_ context.Context) error {
+	fv.CheckDoppelgangersCalled = true
+	return nil
+}
+
 func (fv *fakeValidator) CanonicalHeadSlot(_ context.Context) (uint64, error) {
 	fv.CanonicalHeadSlotCalled = true
 	return 0, nil
@@ -72,6 +78,8 @@ func (fv *fakeValidator) UpdateDuties(_ context.Context, slot uint64) error {
 	return fv.UpdateDutiesRet
 }
 
+func (fv *fakeValidator) PrefetchDuties(_ context.Context, slot uint64) {}
+
 func (fv *fakeValidator) LogValidatorGainsAndLosses(_ context.Context, slot uint64) error {
 	fv.LogValidatorGainsAndLossesCalled = true
 	return nil
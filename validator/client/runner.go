@@ -18,11 +18,13 @@ type Validator interface {
 	WaitForChainStart(ctx context.Context) error
 	WaitForActivation(ctx context.Context) error
 	WaitForSync(ctx context.Context) error
+	CheckDoppelgangers(ctx context.Context) error
 	CanonicalHeadSlot(ctx context.Context) (uint64, error)
 	NextSlot() <-chan uint64
 	SlotDeadline(slot uint64) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error
 	UpdateDuties(ctx context.Context, slot uint64) error
+	PrefetchDuties(ctx context.Context, slot uint64)
 	RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb.ValidatorRole, error) // validator pubKey -> roles
 	SubmitAttestation(ctx context.Context, slot uint64, pubKey [48]byte)
 	ProposeBlock(ctx context.Context, slot uint64, pubKey [48]byte)
@@ -36,10 +38,11 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 3 - Watch for doppelgangers, if enabled
+// 4 - Wait for the next slot start
+// 5 - Update assignments
+// 6 - Determine role at current slot
+// 7 - Perform assigned role, if any, and prefetch the duties of the next epoch
 func run(ctx context.Context, v Validator) {
 	defer v.Done()
 	if err := v.WaitForChainStart(ctx); err != nil {
@@ -51,6 +54,9 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	if err := v.CheckDoppelgangers(ctx); err != nil {
+		log.Fatalf("Could not check for doppelgangers: %v", err)
+	}
 	headSlot, err := v.CanonicalHeadSlot(ctx)
 	if err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
@@ -110,6 +116,8 @@ func run(ctx context.Context, v Validator) {
 					wg.Done()
 				}(roles, id)
 			}
+			// Prepare the duties of the next epoch while the roles are performed.
+			go v.PrefetchDuties(slotCtx, slot)
 			// Wait for all processes to complete, then report span complete.
 			go func() {
 				wg.Wait()
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -9,7 +10,12 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/validator/db/sqlstore"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/ha"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
@@ -25,7 +31,7 @@ type ValidatorService struct {
 	ctx                  context.Context
 	cancel               context.CancelFunc
 	validator            Validator
-	graffiti             []byte
+	graffitiSource       *graffiti.Source
 	conn                 *grpc.ClientConn
 	endpoint             string
 	withCert             string
@@ -33,7 +39,16 @@ type ValidatorService struct {
 	keyManager           keymanager.KeyManager
 	logValidatorBalances bool
 	emitAccountMetrics   bool
+	recordPerformance    bool
 	maxCallRecvMsgSize   int
+	keyReloadInterval    time.Duration
+	doppelgangerEpochs   uint64
+	haDir                string
+	haInstance           string
+	haLease              time.Duration
+	protectionDBURL      string
+	db                   iface.ValidatorDB
+	pausedKeys           *keySet
 }
 
 // Config for the validator service.
@@ -42,15 +57,27 @@ type Config struct {
 	DataDir                    string
 	CertFlag                   string
 	GraffitiFlag               string
+	GraffitiFile               string
 	KeyManager                 keymanager.KeyManager
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
+	RecordPerformance          bool
 	GrpcMaxCallRecvMsgSizeFlag int
+	KeyReloadInterval          time.Duration
+	DoppelgangerEpochs         uint64
+	HADir                      string
+	HAInstance                 string
+	HALease                    time.Duration
+	ProtectionDBURL            string
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
+	graffitiSource, err := graffiti.NewSource(cfg.GraffitiFile, cfg.GraffitiFlag)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load graffiti")
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &ValidatorService{
 		ctx:                  ctx,
@@ -58,11 +85,19 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		endpoint:             cfg.Endpoint,
 		withCert:             cfg.CertFlag,
 		dataDir:              cfg.DataDir,
-		graffiti:             []byte(cfg.GraffitiFlag),
+		graffitiSource:       graffitiSource,
 		keyManager:           cfg.KeyManager,
 		logValidatorBalances: cfg.LogValidatorBalances,
 		emitAccountMetrics:   cfg.EmitAccountMetrics,
+		recordPerformance:    cfg.RecordPerformance,
 		maxCallRecvMsgSize:   cfg.GrpcMaxCallRecvMsgSizeFlag,
+		keyReloadInterval:    cfg.KeyReloadInterval,
+		doppelgangerEpochs:   cfg.DoppelgangerEpochs,
+		haDir:                cfg.HADir,
+		haInstance:           cfg.HAInstance,
+		haLease:              cfg.HALease,
+		protectionDBURL:      cfg.ProtectionDBURL,
+		pausedKeys:           newKeySet(),
 	}, nil
 }
 
@@ -124,19 +159,78 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	// The slashing protection histories are kept in the SQL database if there is one, or else in
+	// the shared directory of the high-availability group if there is one, and the performance of
+	// the keys in the local database when it is recorded.
+	var protectionDB iface.ValidatorDB = valDB
+	var sharedDB iface.SharedValidatorDB
+	if v.protectionDBURL != "" {
+		sqlDB, err := sqlstore.NewStore(sqlstore.Postgres, v.protectionDBURL, pubkeys)
+		if err != nil {
+			log.Errorf("Could not initialize slashing protection db: %v", err)
+			return
+		}
+		log.WithField("db", sqlDB.DatabasePath()).Info("Using shared slashing protection database")
+		sharedDB = sqlDB
+	}
+	var kv ha.KV
+	if v.haDir != "" {
+		kv, err = ha.NewFileKV(v.haDir)
+		if err != nil {
+			log.Errorf("Could not open high-availability directory: %v", err)
+			return
+		}
+		if sharedDB == nil {
+			sharedDB = ha.NewProtectionDB(kv, v.haDir)
+		}
+	}
+	if sharedDB != nil {
+		if err := importHistories(v.ctx, sharedDB, valDB, pubkeys); err != nil {
+			log.Errorf("Could not import slashing protection histories: %v", err)
+			return
+		}
+		protectionDB = sharedDB
+	}
+	var elector *ha.Elector
+	if kv != nil {
+		elector = ha.NewKVElector(kv, v.haInstance, v.haLease)
+		log.WithFields(logrus.Fields{
+			"dir":      v.haDir,
+			"instance": v.haInstance,
+		}).Info("Standing by until elected leader of the high-availability group")
+		go elector.Run(v.ctx)
+	}
+
+	var performanceDB *db.Store
+	if v.recordPerformance {
+		performanceDB = valDB
+	}
+
 	v.conn = conn
+	v.db = protectionDB
 	v.validator = &validator{
-		db:                   valDB,
+		db:                   protectionDB,
+		performanceDB:        performanceDB,
+		elector:              elector,
 		validatorClient:      ethpb.NewBeaconNodeValidatorClient(v.conn),
 		beaconClient:         ethpb.NewBeaconChainClient(v.conn),
 		aggregatorClient:     pb.NewAggregatorServiceClient(v.conn),
 		node:                 ethpb.NewNodeClient(v.conn),
 		keyManager:           v.keyManager,
-		graffiti:             v.graffiti,
+		graffitiSource:       v.graffitiSource,
 		logValidatorBalances: v.logValidatorBalances,
 		emitAccountMetrics:   v.emitAccountMetrics,
 		prevBalance:          make(map[[48]byte]uint64),
 		attLogs:              make(map[[32]byte]*attSubmitted),
+		pausedKeys:           v.pausedKeys,
+		doppelgangerEpochs:   v.doppelgangerEpochs,
+		doppelgangers:        newKeySet(),
+		watchedKeys:          newKeySet(),
+		selectionProofs:      newSelectionProofCache(),
+	}
+	go v.graffitiSource.Watch(v.ctx, graffiti.ReloadInterval)
+	if w, ok := v.keyManager.(keymanager.Watcher); ok && v.keyReloadInterval > 0 {
+		go w.Watch(v.ctx, v.keyReloadInterval)
 	}
 	go run(v.ctx, v.validator)
 }
@@ -160,3 +254,67 @@ func (v *ValidatorService) Status() error {
 	}
 	return nil
 }
+
+// Connection returns the connection to the beacon node, or nil if the service has not started.
+func (v *ValidatorService) Connection() *grpc.ClientConn {
+	return v.conn
+}
+
+// ValidatorDB returns the slashing protection database, or nil if the service has not started.
+func (v *ValidatorService) ValidatorDB() iface.ValidatorDB {
+	return v.db
+}
+
+// Graffiti returns the source of the graffiti of the validating keys.
+func (v *ValidatorService) Graffiti() *graffiti.Source {
+	return v.graffitiSource
+}
+
+// PauseKey stops performing the duties of the key, until it is resumed.
+func (v *ValidatorService) PauseKey(pubKey [48]byte) {
+	v.pausedKeys.add(pubKey)
+}
+
+// ResumeKey performs the duties of a paused key again.
+func (v *ValidatorService) ResumeKey(pubKey [48]byte) {
+	v.pausedKeys.remove(pubKey)
+}
+
+// IsPaused returns whether the duties of the key are paused.
+func (v *ValidatorService) IsPaused(pubKey [48]byte) bool {
+	return v.pausedKeys.contains(pubKey)
+}
+
+// importHistories merges the slashing protection histories of the local database into the shared
+// database, so that a client does not sign against the messages it signed before using it. Merging
+// is idempotent, and done at every start.
+func importHistories(ctx context.Context, shared iface.SharedValidatorDB, local iface.ValidatorDB, pubkeys [][48]byte) error {
+	if err := shared.InitializeHistories(ctx, pubkeys); err != nil {
+		return err
+	}
+	for _, pubkey := range pubkeys {
+		proposals, err := local.ProposalHistory(ctx, pubkey[:])
+		if err != nil {
+			return errors.Wrap(err, "could not get local proposal history")
+		}
+		if proposals != nil {
+			if err := shared.UpdateProposalHistory(ctx, pubkey[:], func(history *slashpb.ProposalHistory) (*slashpb.ProposalHistory, error) {
+				return mergeProposalHistory(history, proposals), nil
+			}); err != nil {
+				return errors.Wrap(err, "could not merge proposal history")
+			}
+		}
+		attestations, err := local.AttestationHistory(ctx, pubkey[:])
+		if err != nil {
+			return errors.Wrap(err, "could not get local attestation history")
+		}
+		if attestations != nil {
+			if err := shared.UpdateAttestationHistory(ctx, pubkey[:], func(history *slashpb.AttestationHistory) (*slashpb.AttestationHistory, error) {
+				return mergeAttestationHistory(history, attestations), nil
+			}); err != nil {
+				return errors.Wrap(err, "could not merge attestation history")
+			}
+		}
+	}
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/ha"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -117,3 +119,47 @@ func TestStatus_NoConnectionError(t *testing.T) {
 		t.Errorf("Expected status check to fail if no connection is found, received: %v", err)
 	}
 }
+
+func TestImportHistories_MergesLocalHistories(t *testing.T) {
+	ctx := context.Background()
+	local := db.SetupDB(t, [][48]byte{validatorPubKey})
+	defer db.TeardownDB(t, local)
+	if err := local.SaveProposalHistory(ctx, validatorPubKey[:], SetProposedForEpoch(db.NewProposalHistory(), 3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := local.SaveAttestationHistory(ctx, validatorPubKey[:], markAttestationForTargetEpoch(db.NewAttestationHistory(), 2, 3)); err != nil {
+		t.Fatal(err)
+	}
+	// Another client of the group attested after the client last signed.
+	shared := ha.NewProtectionDB(ha.NewMemoryKV(), "memory")
+	if err := shared.SaveAttestationHistory(ctx, validatorPubKey[:], markAttestationForTargetEpoch(db.NewAttestationHistory(), 4, 5)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Importing the histories again changes nothing.
+	for i := 0; i < 2; i++ {
+		if err := importHistories(ctx, shared, local, [][48]byte{validatorPubKey}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	proposals, err := shared.ProposalHistory(ctx, validatorPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !HasProposedForEpoch(proposals, 3) || proposals.LatestEpochWritten != 3 {
+		t.Errorf("Expected proposal of epoch 3 to be imported, got %v", proposals)
+	}
+	attestations, err := shared.AttestationHistory(ctx, validatorPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source := safeTargetToSource(attestations, 3); source != 2 {
+		t.Errorf("Expected imported attestation of target 3 to have source 2, got %d", source)
+	}
+	if source := safeTargetToSource(attestations, 5); source != 4 {
+		t.Errorf("Expected shared attestation of target 5 to have source 4, got %d", source)
+	}
+	if !isNewAttSlashable(attestations, 1, 4) {
+		t.Error("Expected attestation surrounding the imported one to be slashable")
+	}
+}
@@ -19,6 +19,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/ha"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -27,11 +30,26 @@ import (
 type validator struct {
 	genesisTime          uint64
 	ticker               *slotutil.SlotTicker
-	db                   *db.Store
+	db                   iface.ValidatorDB
+	performanceDB        *db.Store
+	elector              *ha.Elector
 	duties               *ethpb.DutiesResponse
+	dutiesEpoch          uint64
+	dutiesStale          bool
+	dutiesPrefetched     bool
+	fetchingDuties       bool
+	dutiesFetches        sync.WaitGroup
+	nextDuties           *ethpb.DutiesResponse
+	nextDutiesEpoch      uint64
+	dutiesLock           sync.RWMutex
+	prefetching          bool
+	headRoot             []byte
+	dependentRoot        []byte
+	dependentEpoch       uint64
+	selectionProofs      *selectionProofCache
 	validatorClient      ethpb.BeaconNodeValidatorClient
 	beaconClient         ethpb.BeaconChainClient
-	graffiti             []byte
+	graffitiSource       *graffiti.Source
 	aggregatorClient     pb.AggregatorServiceClient
 	node                 ethpb.NodeClient
 	keyManager           keymanager.KeyManager
@@ -40,6 +58,43 @@ type validator struct {
 	emitAccountMetrics   bool
 	attLogs              map[[32]byte]*attSubmitted
 	attLogsLock          sync.Mutex
+	knownKeys            map[[48]byte]bool
+	knownKeysLock        sync.Mutex
+	pausedKeys           *keySet
+	doppelgangerEpochs   uint64
+	doppelgangers        *keySet
+	watchedKeys          *keySet
+}
+
+// keySet is a set of public keys safe for concurrent use.
+type keySet struct {
+	keys map[[48]byte]bool
+	lock sync.RWMutex
+}
+
+func newKeySet() *keySet {
+	return &keySet{keys: make(map[[48]byte]bool)}
+}
+
+func (s *keySet) add(pubKey [48]byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keys[pubKey] = true
+}
+
+func (s *keySet) remove(pubKey [48]byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.keys, pubKey)
+}
+
+func (s *keySet) contains(pubKey [48]byte) bool {
+	if s == nil {
+		return false
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.keys[pubKey]
 }
 
 // Done cleans up the validator.
@@ -85,22 +140,48 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 
 // WaitForActivation checks whether the validator pubkey is in the active
 // validator set. If not, this operation will block until an activation message is
-// received.
+// received. If the validating keys change while waiting, the activation of the new
+// set of keys is awaited instead.
 func (v *validator) WaitForActivation(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForActivation")
 	defer span.End()
-	validatingKeys, err := v.keyManager.FetchValidatingKeys()
-	if err != nil {
-		return errors.Wrap(err, "could not fetch validating keys")
+	for {
+		validatingKeys, err := v.keyManager.FetchValidatingKeys()
+		if err != nil {
+			return errors.Wrap(err, "could not fetch validating keys")
+		}
+		v.setKnownKeys(validatingKeys)
+		activatedKeys, err := v.awaitActivation(ctx, validatingKeys, true /* watchKeys */)
+		if err != nil {
+			return err
+		}
+		if activatedKeys == nil {
+			log.Info("Validating keys changed, waiting for activation of the new set of keys")
+			continue
+		}
+		for _, pubKey := range activatedKeys {
+			log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator activated")
+		}
+		break
 	}
+	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+
+	return nil
+}
+
+// awaitActivation of any of the keys, returning the activated keys. If watchKeys is set and the
+// validating keys of the key manager change before any activation, it returns nil.
+func (v *validator) awaitActivation(ctx context.Context, pubKeys [][48]byte, watchKeys bool) ([][]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	req := &ethpb.ValidatorActivationRequest{
-		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+		PublicKeys: bytesutil.FromBytes48Array(pubKeys),
 	}
 	stream, err := v.validatorClient.WaitForActivation(ctx, req)
 	if err != nil {
-		return errors.Wrap(err, "could not setup validator WaitForActivation streaming client")
+		return nil, errors.Wrap(err, "could not setup validator WaitForActivation streaming client")
 	}
-	var validatorActivatedRecords [][]byte
+	var activatedKeys [][]byte
 	for {
 		res, err := stream.Recv()
 		// If the stream is closed, we stop the loop.
@@ -109,27 +190,136 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 		}
 		// If context is canceled we stop the loop.
 		if ctx.Err() == context.Canceled {
-			return errors.Wrap(ctx.Err(), "context has been canceled so shutting down the loop")
+			return nil, errors.Wrap(ctx.Err(), "context has been canceled so shutting down the loop")
 		}
 		if err != nil {
-			return errors.Wrap(err, "could not receive validator activation from stream")
+			return nil, errors.Wrap(err, "could not receive validator activation from stream")
 		}
 		log.Info("Waiting for validator to be activated in the beacon chain")
-		activatedKeys := v.checkAndLogValidatorStatus(res.Statuses)
+		activatedKeys = v.checkAndLogValidatorStatus(res.Statuses)
 
 		if len(activatedKeys) > 0 {
-			validatorActivatedRecords = activatedKeys
 			break
 		}
+		if watchKeys {
+			validatingKeys, err := v.keyManager.FetchValidatingKeys()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not fetch validating keys")
+			}
+			if !sameKeys(pubKeys, validatingKeys) {
+				return nil, nil
+			}
+		}
 	}
-	for _, pubKey := range validatorActivatedRecords {
-		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator activated")
+	if activatedKeys == nil {
+		activatedKeys = [][]byte{}
 	}
-	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	return activatedKeys, nil
+}
+
+// waitForKeysActivation logs the activation of keys added while the validator is running, so
+// their activation is awaited independently of the keys already performing duties. It returns
+// once all the keys are activated or no longer validating keys.
+func (v *validator) waitForKeysActivation(ctx context.Context, pubKeys [][48]byte) {
+	for len(pubKeys) > 0 {
+		activatedKeys, err := v.awaitActivation(ctx, pubKeys, false /* watchKeys */)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithError(err).Error("Could not wait for the activation of the added keys")
+			}
+			return
+		}
+		activated := make(map[[48]byte]bool, len(activatedKeys))
+		for _, pubKey := range activatedKeys {
+			activated[bytesutil.ToBytes48(pubKey)] = true
+			log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))).Info("Validator activated")
+		}
+		if len(activatedKeys) == 0 {
+			// The stream was closed without any activation, retry after a slot.
+			select {
+			case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+			case <-ctx.Done():
+				return
+			}
+		}
+		v.knownKeysLock.Lock()
+		pending := make([][48]byte, 0, len(pubKeys))
+		for _, pubKey := range pubKeys {
+			if !activated[pubKey] && v.knownKeys[pubKey] {
+				pending = append(pending, pubKey)
+			}
+		}
+		v.knownKeysLock.Unlock()
+		pubKeys = pending
+	}
+}
 
+func (v *validator) setKnownKeys(pubKeys [][48]byte) {
+	v.knownKeysLock.Lock()
+	defer v.knownKeysLock.Unlock()
+	v.knownKeys = make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		v.knownKeys[pubKey] = true
+	}
+}
+
+// updateValidatingKeys records the current validating keys, initializing the slashing protection
+// history of the added keys and awaiting their activation in the background.
+func (v *validator) updateValidatingKeys(ctx context.Context, pubKeys [][48]byte) error {
+	v.knownKeysLock.Lock()
+	previous := v.knownKeys
+	v.knownKeysLock.Unlock()
+	if previous == nil {
+		v.setKnownKeys(pubKeys)
+		return nil
+	}
+	var added [][48]byte
+	current := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		current[pubKey] = true
+		if !previous[pubKey] {
+			added = append(added, pubKey)
+		}
+	}
+	for pubKey := range previous {
+		if !current[pubKey] {
+			log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validating key removed")
+		}
+	}
+	if len(added) > 0 && v.db != nil {
+		if err := v.db.InitializeHistories(ctx, added); err != nil {
+			return errors.Wrap(err, "could not initialize the history of the added keys")
+		}
+	}
+	v.setKnownKeys(pubKeys)
+	for _, pubKey := range added {
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validating key added")
+	}
+	if len(added) > 0 {
+		if v.doppelgangerEpochs > 0 {
+			v.watchAddedKeys(ctx, added)
+		}
+		go v.waitForKeysActivation(ctx, added)
+	}
 	return nil
 }
 
+func sameKeys(a [][48]byte, b [][48]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[[48]byte]bool, len(a))
+	for _, pubKey := range a {
+		keys[pubKey] = true
+	}
+	for _, pubKey := range b {
+		if !keys[pubKey] {
+			return false
+		}
+	}
+	return true
+}
+
 // WaitForSync checks whether the beacon node has sync to the latest head
 func (v *validator) WaitForSync(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForSync")
@@ -222,71 +412,177 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
-// beginning of a new epoch.
+// beginning of a new epoch, or after a chain reorganization made them stale.
+// The attestation duties prefetched for the epoch are installed right away if
+// they are of the current validating keys, and the duties with the proposals of
+// the epoch are fetched in the background.
 func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
-		// Do nothing if not epoch start AND assignments already exist.
+	epoch := helpers.SlotToEpoch(slot)
+	v.dutiesLock.RLock()
+	upToDate := v.duties != nil && v.dutiesEpoch == epoch && !v.dutiesStale && (!v.dutiesPrefetched || v.fetchingDuties)
+	v.dutiesLock.RUnlock()
+	if upToDate {
+		// Do nothing if assignments of the epoch already exist, or are being fetched.
 		return nil
 	}
-	// Set deadline to end of epoch.
-	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(helpers.StartSlot(helpers.SlotToEpoch(slot)+1)))
-	defer cancel()
-	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
-	defer span.End()
-
 	validatingKeys, err := v.keyManager.FetchValidatingKeys()
 	if err != nil {
 		return err
 	}
-	req := &ethpb.DutiesRequest{
-		Epoch:      slot / params.BeaconConfig().SlotsPerEpoch,
-		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	// Keys added to the key manager are picked up at the start of the epoch.
+	if err := v.updateValidatingKeys(ctx, validatingKeys); err != nil {
+		return err
 	}
 
-	resp, err := v.validatorClient.GetDuties(ctx, req)
+	// The proposers of an epoch are only known once it started, so the duties are fetched again
+	// even if they were prefetched, and until then only the prefetched attestation duties are used.
+	v.dutiesLock.Lock()
+	var prefetched *ethpb.DutiesResponse
+	if !v.dutiesStale {
+		if v.nextDuties != nil && v.nextDutiesEpoch == epoch && sameKeys(dutiesKeys(v.nextDuties), validatingKeys) {
+			prefetched = withoutProposals(v.nextDuties)
+		} else if v.dutiesPrefetched && v.dutiesEpoch == epoch && sameKeys(dutiesKeys(v.duties), validatingKeys) {
+			// The duties could not be fetched at the previous slot.
+			prefetched = v.duties
+		}
+	}
+	v.nextDuties = nil
+	if prefetched != nil {
+		v.duties = prefetched
+		v.dutiesEpoch = epoch
+		v.dutiesStale = false
+		v.dutiesPrefetched = true
+		v.fetchingDuties = true
+	}
+	v.dutiesLock.Unlock()
+	if prefetched != nil {
+		v.dutiesFetches.Add(1)
+		go func() {
+			defer v.dutiesFetches.Done()
+			v.fetchPrefetchedDuties(ctx, slot, validatingKeys, prefetched)
+		}()
+		return nil
+	}
+
+	resp, err := v.fetchDuties(ctx, epoch, validatingKeys)
 	if err != nil {
+		v.dutiesLock.Lock()
 		v.duties = nil // Clear assignments so we know to retry the request.
+		v.dutiesLock.Unlock()
 		log.Error(err)
 		return err
 	}
 
+	v.dutiesLock.Lock()
 	v.duties = resp
+	v.dutiesEpoch = epoch
+	v.dutiesStale = false
+	v.dutiesPrefetched = false
+	v.dutiesLock.Unlock()
+	v.logDuties(slot, resp)
+	return nil
+}
+
+// fetchPrefetchedDuties fetches the duties of the epoch of the slot to replace the prefetched
+// attestation duties installed for it, unless those were replaced in the meantime. The prefetched
+// attestation duties are kept if the duties cannot be fetched, and the fetch is tried again at
+// the next slot.
+func (v *validator) fetchPrefetchedDuties(ctx context.Context, slot uint64, keys [][48]byte, prefetched *ethpb.DutiesResponse) {
+	resp, err := v.fetchDuties(ctx, helpers.SlotToEpoch(slot), keys)
+
+	v.dutiesLock.Lock()
+	if v.duties != prefetched {
+		v.dutiesLock.Unlock()
+		return
+	}
+	v.fetchingDuties = false
+	if err != nil {
+		v.dutiesLock.Unlock()
+		log.WithError(err).Warn("Could not get duties, using the prefetched attestation duties")
+		return
+	}
+	v.duties = resp
+	v.dutiesPrefetched = false
+	v.dutiesLock.Unlock()
+	v.logDuties(slot, resp)
+}
+
+// fetchDuties of the keys in the epoch, with a deadline at the end of the epoch.
+func (v *validator) fetchDuties(ctx context.Context, epoch uint64, keys [][48]byte) (*ethpb.DutiesResponse, error) {
+	// Set deadline to end of epoch.
+	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(helpers.StartSlot(epoch+1)))
+	defer cancel()
+	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
+	defer span.End()
+
+	req := &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+	}
+	return v.validatorClient.GetDuties(ctx, req)
+}
+
+// logDuties logs the duties of the epoch of the slot.
+func (v *validator) logDuties(slot uint64, resp *ethpb.DutiesResponse) {
 	// Only log the full assignments output on epoch start to be less verbose.
-	if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
-		for _, duty := range v.duties.Duties {
-			lFields := logrus.Fields{
-				"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)),
-				"validatorIndex": duty.ValidatorIndex,
-				"committeeIndex": duty.CommitteeIndex,
-				"epoch":          slot / params.BeaconConfig().SlotsPerEpoch,
-				"status":         duty.Status,
-			}
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return
+	}
+	for _, duty := range resp.Duties {
+		lFields := logrus.Fields{
+			"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)),
+			"validatorIndex": duty.ValidatorIndex,
+			"committeeIndex": duty.CommitteeIndex,
+			"epoch":          helpers.SlotToEpoch(slot),
+			"status":         duty.Status,
+		}
 
-			if duty.Status == ethpb.ValidatorStatus_ACTIVE {
-				if duty.ProposerSlot > 0 {
-					lFields["proposerSlot"] = duty.ProposerSlot
-				}
-				lFields["attesterSlot"] = duty.AttesterSlot
+		if duty.Status == ethpb.ValidatorStatus_ACTIVE {
+			if duty.ProposerSlot > 0 {
+				lFields["proposerSlot"] = duty.ProposerSlot
 			}
-
-			log.WithFields(lFields).Info("New assignment")
+			lFields["attesterSlot"] = duty.AttesterSlot
 		}
+
+		log.WithFields(lFields).Info("New assignment")
 	}
+}
 
-	return nil
+// isStandby reports whether the client stands by for the leader of its high-availability group,
+// in which case it must not sign anything.
+func (v *validator) isStandby() bool {
+	return v.elector != nil && !v.elector.IsLeader()
 }
 
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the at slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
+// Keys whose duties are paused, or which are watched for doppelgangers, have no roles, and no key
+// has roles while the client stands by for the leader of its high-availability group.
 func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb.ValidatorRole, error) {
+	v.dutiesLock.RLock()
+	duties := v.duties
+	v.dutiesLock.RUnlock()
+
 	rolesAt := make(map[[48]byte][]pb.ValidatorRole)
-	for _, duty := range v.duties.Duties {
+	if v.isStandby() {
+		return rolesAt, nil
+	}
+	for _, duty := range duties.Duties {
 		var roles []pb.ValidatorRole
 
 		if duty == nil {
 			continue
 		}
+		if v.pausedKeys.contains(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
+		if v.doppelgangers.contains(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
+		if v.watchedKeys.contains(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
 		if duty.ProposerSlot > 0 && duty.ProposerSlot == slot {
 			roles = append(roles, pb.ValidatorRole_PROPOSER)
 		}
@@ -316,17 +612,23 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb
 // isAggregator checks if a validator is an aggregator of a given slot, it uses the selection algorithm outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#aggregation-selection
 func (v *validator) isAggregator(ctx context.Context, committee []uint64, slot uint64, pubKey [48]byte) (bool, error) {
+	slotSig, err := v.slotSignature(ctx, pubKey, slot)
+	if err != nil {
+		return false, err
+	}
+
+	return isAggregatorSignature(committee, slotSig), nil
+}
+
+// isAggregatorSignature checks if the slot signature of a validator in the committee selects it
+// as an aggregator.
+func isAggregatorSignature(committee []uint64, slotSig []byte) bool {
 	modulo := uint64(1)
 	if len(committee)/int(params.BeaconConfig().TargetAggregatorsPerCommittee) > 1 {
 		modulo = uint64(len(committee)) / params.BeaconConfig().TargetAggregatorsPerCommittee
 	}
 
-	slotSig, err := v.signSlot(ctx, pubKey, slot)
-	if err != nil {
-		return false, err
-	}
-
 	b := hashutil.Hash(slotSig)
 
-	return binary.LittleEndian.Uint64(b[:8])%modulo == 0, nil
+	return binary.LittleEndian.Uint64(b[:8])%modulo == 0
 }
@@ -60,7 +60,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		return
 	}
 
-	slotSig, err := v.signSlot(ctx, pubKey, slot)
+	slotSig, err := v.slotSignature(ctx, pubKey, slot)
 	if err != nil {
 		log.Errorf("Could not sign slot: %v", err)
 		if v.emitAccountMetrics {
@@ -95,6 +95,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		}
 		return
 	}
+	v.recordAggregation(ctx, pubKey, slot)
 	if v.emitAccountMetrics {
 		validatorAggSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
@@ -112,12 +113,17 @@ func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot uint64)
 		return nil, err
 	}
 
+	return v.signSlotWithDomain(pubKey, slot, domain.SignatureDomain)
+}
+
+// signSlotWithDomain signs the slot with the signature domain of the attesters of its epoch.
+func (v *validator) signSlotWithDomain(pubKey [48]byte, slot uint64, domain uint64) ([]byte, error) {
 	slotRoot, err := ssz.HashTreeRoot(slot)
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := v.keyManager.Sign(pubKey, slotRoot, domain.SignatureDomain)
+	sig, err := v.keyManager.Sign(pubKey, slotRoot, domain)
 	if err != nil {
 		return nil, err
 	}
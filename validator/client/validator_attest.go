@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -43,6 +44,9 @@ var (
 	)
 )
 
+// errSlashableAttestation rejects the update of the history of a key with a slashable attestation.
+var errSlashableAttestation = errors.New("slashable attestation")
+
 // SubmitAttestation completes the validator client's attester responsibility at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
@@ -76,16 +80,49 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
-	if featureconfig.Get().ProtectAttester {
-		history, err := v.db.AttestationHistory(ctx, pubKey[:])
-		if err != nil {
-			log.Errorf("Could not get attestation history from DB: %v", err)
-			if v.emitAccountMetrics {
-				validatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+	// A client which stands by must not record the attestation either, as the leader would then
+	// refuse to sign it.
+	if v.isStandby() {
+		log.Warn("Standing by for the leader, not attesting")
+		if v.emitAccountMetrics {
+			validatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return
+	}
+
+	// Databases shared with other validator clients check and record the attestation atomically
+	// before it is signed, so that no other client signs a conflicting one. Protection is always on
+	// for them, as the other clients sign with the same keys.
+	updater, shared := v.db.(iface.HistoryUpdater)
+	if featureconfig.Get().ProtectAttester || shared {
+		var slashable bool
+		if shared {
+			err := updater.UpdateAttestationHistory(ctx, pubKey[:], func(history *slashpb.AttestationHistory) (*slashpb.AttestationHistory, error) {
+				if isNewAttSlashable(history, data.Source.Epoch, data.Target.Epoch) {
+					return nil, errSlashableAttestation
+				}
+				return markAttestationForTargetEpoch(history, data.Source.Epoch, data.Target.Epoch), nil
+			})
+			slashable = err == errSlashableAttestation
+			if err != nil && !slashable {
+				log.Errorf("Could not update attestation history in DB: %v", err)
+				if v.emitAccountMetrics {
+					validatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+				}
+				return
 			}
-			return
+		} else {
+			history, err := v.db.AttestationHistory(ctx, pubKey[:])
+			if err != nil {
+				log.Errorf("Could not get attestation history from DB: %v", err)
+				if v.emitAccountMetrics {
+					validatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+				}
+				return
+			}
+			slashable = isNewAttSlashable(history, data.Source.Epoch, data.Target.Epoch)
 		}
-		if isNewAttSlashable(history, data.Source.Epoch, data.Target.Epoch) {
+		if slashable {
 			log.WithFields(logrus.Fields{
 				"sourceEpoch": data.Source.Epoch,
 				"targetEpoch": data.Target.Epoch,
@@ -97,6 +134,14 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		}
 	}
 
+	if v.isStandby() {
+		log.Warn("Lost leadership before signing attestation, not signing")
+		if v.emitAccountMetrics {
+			validatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return
+	}
+
 	sig, err := v.signAtt(ctx, pubKey, data)
 	if err != nil {
 		log.WithError(err).Error("Could not sign attestation")
@@ -140,7 +185,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
-	if featureconfig.Get().ProtectAttester {
+	if featureconfig.Get().ProtectAttester && !shared {
 		history, err := v.db.AttestationHistory(ctx, pubKey[:])
 		if err != nil {
 			log.Errorf("Could not get attestation history from DB: %v", err)
@@ -184,11 +229,14 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 
 // Given the validator public key, this gets the validator assignment.
 func (v *validator) duty(pubKey [48]byte) (*ethpb.DutiesResponse_Duty, error) {
-	if v.duties == nil {
+	v.dutiesLock.RLock()
+	duties := v.duties
+	v.dutiesLock.RUnlock()
+	if duties == nil {
 		return nil, errors.New("no duties for validators")
 	}
 
-	for _, duty := range v.duties.Duties {
+	for _, duty := range duties.Duties {
 		if bytes.Equal(pubKey[:], duty.PublicKey) {
 			return duty, nil
 		}
@@ -303,3 +351,24 @@ func safeTargetToSource(history *slashpb.AttestationHistory, targetEpoch uint64)
 	}
 	return history.TargetToSource[targetEpoch%wsPeriod]
 }
+
+// mergeAttestationHistory marks in the attestation history the target epochs attested for in the
+// other history, which are neither attested for nor pruned in it.
+// Returns the modified attestation history.
+func mergeAttestationHistory(history *slashpb.AttestationHistory, other *slashpb.AttestationHistory) *slashpb.AttestationHistory {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	start := uint64(0)
+	if other.LatestEpochWritten >= wsPeriod {
+		start = other.LatestEpochWritten - wsPeriod + 1
+	}
+	for target := start; target <= other.LatestEpochWritten; target++ {
+		source := safeTargetToSource(other, target)
+		if source == farFuture || safeTargetToSource(history, target) != farFuture ||
+			int(target) <= int(history.LatestEpochWritten)-int(wsPeriod) {
+			continue
+		}
+		history = markAttestationForTargetEpoch(history, source, target)
+	}
+	return history
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/ha"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -506,3 +507,52 @@ func TestAttestationHistory_BlocksSurroundingAttestation(t *testing.T) {
 		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
 	}
 }
+
+func TestAttestToBlockHead_HAGroup_BlocksDoubleAttWithoutProtectionFlags(t *testing.T) {
+	// The protection flags are not set, but the clients of a group share their histories.
+	featureconfig.Init(&featureconfig.Flags{})
+	hook := logTest.NewGlobal()
+	shared := ha.NewProtectionDB(ha.NewMemoryKV(), "memory")
+	validatorIndex := uint64(7)
+	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
+
+	var clients []*validator
+	for i := 0; i < 2; i++ {
+		v, m, finish := setup(t)
+		defer finish()
+		v.db = shared
+		v.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:      validatorKey.PublicKey.Marshal(),
+				CommitteeIndex: 5,
+				Committee:      committee,
+				ValidatorIndex: validatorIndex,
+			}}}
+		// Each client of the group is given different data to sign for the same target.
+		m.validatorClient.EXPECT().GetAttestationData(
+			gomock.Any(), // ctx
+			gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+		).Return(&ethpb.AttestationData{
+			BeaconBlockRoot: []byte{byte(i)},
+			Target:          &ethpb.Checkpoint{Root: []byte("B"), Epoch: 4},
+			Source:          &ethpb.Checkpoint{Root: []byte("C"), Epoch: 3},
+		}, nil)
+		if i == 0 {
+			m.validatorClient.EXPECT().DomainData(
+				gomock.Any(), // ctx
+				gomock.Any(), // epoch
+			).Return(&ethpb.DomainResponse{}, nil /*err*/)
+			m.validatorClient.EXPECT().ProposeAttestation(
+				gomock.Any(), // ctx
+				gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+			).Return(&ethpb.AttestResponse{}, nil /* error */)
+		}
+		clients = append(clients, v)
+	}
+
+	clients[0].SubmitAttestation(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsDoNotContain(t, hook, "Attempted to make a slashable attestation, rejected")
+	// The second client does not sign, and so does not request a domain nor submit.
+	clients[1].SubmitAttestation(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Attempted to make a slashable attestation, rejected")
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+// CheckDoppelgangers watches the chain for the configured number of epochs before the validator
+// client signs anything, for attestations of the validating keys included in the chain. Such
+// attestations are signed by another validator client running the same keys, and the validator
+// client refuses to sign with those keys for as long as it runs, so that it cannot be slashed for
+// conflicting votes.
+func (v *validator) CheckDoppelgangers(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	pubKeys, err := v.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return err
+	}
+	startEpoch := slotutil.EpochsSinceGenesis(time.Unix(int64(v.genesisTime), 0))
+	log.WithFields(logrus.Fields{
+		"epochs":     v.doppelgangerEpochs,
+		"untilEpoch": startEpoch + v.doppelgangerEpochs + 2,
+	}).Info("Watching for doppelgangers before validating")
+
+	detected, err := v.watchDoppelgangers(ctx, pubKeys, startEpoch, v.NextSlot())
+	if err != nil {
+		return err
+	}
+	for _, pubKey := range detected {
+		v.doppelgangers.add(pubKey)
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Error(
+			"Doppelganger detected, another validator client is validating with this key. Refusing to sign with it")
+	}
+	if len(detected) == 0 {
+		log.Info("No doppelgangers detected")
+	}
+	return nil
+}
+
+// watchAddedKeys watches the chain for doppelgangers of keys added while the validator client
+// runs, such as keys reloaded from the keystore or added through the admin RPC, as
+// CheckDoppelgangers does for the keys it starts with. The keys have no roles until then.
+func (v *validator) watchAddedKeys(ctx context.Context, pubKeys [][48]byte) {
+	for _, pubKey := range pubKeys {
+		v.watchedKeys.add(pubKey)
+	}
+	genesis := time.Unix(int64(v.genesisTime), 0)
+	ticker := slotutil.GetSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+	go func() {
+		defer ticker.Done()
+		v.checkAddedKeys(ctx, pubKeys, slotutil.EpochsSinceGenesis(genesis), ticker.C())
+	}()
+}
+
+// checkAddedKeys watches the chain for doppelgangers of the added keys from the start epoch, and
+// lets the keys which have none take roles.
+func (v *validator) checkAddedKeys(ctx context.Context, pubKeys [][48]byte, startEpoch uint64, slots <-chan uint64) {
+	log.WithFields(logrus.Fields{
+		"keys":       len(pubKeys),
+		"epochs":     v.doppelgangerEpochs,
+		"untilEpoch": startEpoch + v.doppelgangerEpochs + 2,
+	}).Info("Watching for doppelgangers of added keys before validating with them")
+	detected, err := v.watchDoppelgangers(ctx, pubKeys, startEpoch, slots)
+	if err != nil {
+		log.WithError(err).Error("Could not watch for doppelgangers of added keys")
+		return
+	}
+	for _, pubKey := range detected {
+		v.doppelgangers.add(pubKey)
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Error(
+			"Doppelganger detected, another validator client is validating with this key. Refusing to sign with it")
+	}
+	for _, pubKey := range pubKeys {
+		v.watchedKeys.remove(pubKey)
+	}
+}
+
+// watchDoppelgangers returns the public keys whose validators attested in the configured number
+// of epochs after the start epoch, checking every slot until the beacon node reported the
+// performance of the validators in each of these epochs. The start epoch is not checked, as its
+// attestations may have been signed by a previous run of the validator client.
+func (v *validator) watchDoppelgangers(
+	ctx context.Context,
+	pubKeys [][48]byte,
+	startEpoch uint64,
+	slots <-chan uint64,
+) ([][48]byte, error) {
+	detected := make(map[[48]byte]bool)
+	checkedEpoch := startEpoch
+	checked := uint64(0)
+	for checked < v.doppelgangerEpochs {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-slots:
+		}
+		head, err := v.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			log.WithError(err).Error("Could not get chain head")
+			continue
+		}
+		// The performance of the validators is reported for the epoch two epochs before the head
+		// epoch, whose attestations may be included until the end of the epoch after it.
+		if head.HeadEpoch < 2 || head.HeadEpoch-2 <= checkedEpoch {
+			continue
+		}
+		epoch := head.HeadEpoch - 2
+		resp, err := v.beaconClient.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
+			PublicKeys: bytesutil.FromBytes48Array(pubKeys),
+		})
+		if err != nil {
+			log.WithError(err).Error("Could not get validator performance")
+			continue
+		}
+		missing := make(map[[48]byte]bool, len(resp.MissingValidators))
+		for _, pubKey := range resp.MissingValidators {
+			missing[bytesutil.ToBytes48(pubKey)] = true
+		}
+		// The performance is reported in the order of the requested keys, skipping the missing ones.
+		i := 0
+		for _, pubKey := range pubKeys {
+			if missing[pubKey] {
+				continue
+			}
+			if i < len(resp.CorrectlyVotedSource) && resp.CorrectlyVotedSource[i] {
+				detected[pubKey] = true
+			}
+			i++
+		}
+		// The performance of epochs skipped by the head is never reported, and more epochs are
+		// watched instead.
+		if epoch > checkedEpoch+1 {
+			log.WithFields(logrus.Fields{
+				"from": checkedEpoch + 1,
+				"to":   epoch - 1,
+			}).Warn("Could not check epochs for doppelgangers, watching more epochs")
+		}
+		checkedEpoch = epoch
+		checked++
+		log.WithFields(logrus.Fields{
+			"epoch":         checkedEpoch,
+			"doppelgangers": len(detected),
+		}).Debug("Checked epoch for doppelgangers")
+	}
+
+	var result [][48]byte
+	for _, pubKey := range pubKeys {
+		if detected[pubKey] {
+			result = append(result, pubKey)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+)
+
+func TestWatchDoppelgangers_DetectsAttestingKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := validator{
+		beaconClient:       client,
+		doppelgangerEpochs: 1,
+	}
+	missing := [48]byte{1}
+	attesting := [48]byte{2}
+	idle := [48]byte{3}
+
+	gomock.InOrder(
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 6}, nil),
+		// The performance at head epoch 7 is the one of the start epoch, whose attestations may be
+		// signed by a previous run of the validator client.
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 7}, nil),
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 8}, nil),
+		client.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+			CorrectlyVotedSource: []bool{true, false},
+			MissingValidators:    [][]byte{missing[:]},
+		}, nil),
+	)
+
+	slots := make(chan uint64, 3)
+	slots <- 6 * 32
+	slots <- 7 * 32
+	slots <- 8 * 32
+	detected, err := v.watchDoppelgangers(context.Background(), [][48]byte{missing, attesting, idle}, 5, slots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(detected) != 1 || detected[0] != attesting {
+		t.Errorf("Expected doppelganger %#x, received %#x", attesting, detected)
+	}
+}
+
+func TestWatchDoppelgangers_IgnoresAttestationsBeforeRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := validator{
+		beaconClient:       client,
+		doppelgangerEpochs: 1,
+	}
+	pubKey := [48]byte{1}
+
+	// The validator client attested in epoch 5, and restarted in it: the performance of epoch 5
+	// is never requested, and the key stopped attesting in epoch 6.
+	gomock.InOrder(
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 7}, nil),
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 8}, nil),
+		client.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+			CorrectlyVotedSource: []bool{false},
+		}, nil),
+	)
+
+	slots := make(chan uint64, 2)
+	slots <- 7 * 32
+	slots <- 8 * 32
+	detected, err := v.watchDoppelgangers(context.Background(), [][48]byte{pubKey}, 5, slots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(detected) != 0 {
+		t.Errorf("Expected no doppelganger, received %#x", detected)
+	}
+}
+
+func TestWatchDoppelgangers_ChecksEveryEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := validator{
+		beaconClient:       client,
+		doppelgangerEpochs: 3,
+	}
+	pubKey := [48]byte{1}
+	idle := &ethpb.ValidatorPerformanceResponse{CorrectlyVotedSource: []bool{false}}
+
+	// The head skips epoch 9, so the performance of epoch 7 is never reported, and epoch 9 is
+	// checked instead.
+	gomock.InOrder(
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 8}, nil),
+		client.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(idle, nil),
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil),
+		client.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(idle, nil),
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 11}, nil),
+		client.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+			CorrectlyVotedSource: []bool{true},
+		}, nil),
+	)
+
+	slots := make(chan uint64, 3)
+	slots <- 8 * 32
+	slots <- 10 * 32
+	slots <- 11 * 32
+	detected, err := v.watchDoppelgangers(context.Background(), [][48]byte{pubKey}, 5, slots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(detected) != 1 || detected[0] != pubKey {
+		t.Errorf("Expected doppelganger %#x, received %#x", pubKey, detected)
+	}
+}
+
+func TestRolesAt_SkipsDoppelgangers(t *testing.T) {
+	v := validator{
+		keyManager:    testKeyManager,
+		doppelgangers: newKeySet(),
+		duties: &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{
+					ProposerSlot: 1,
+					PublicKey:    validatorPubKey[:],
+				},
+			},
+		},
+	}
+	v.doppelgangers.add(validatorPubKey)
+
+	roles, err := v.RolesAt(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := roles[validatorPubKey]; ok {
+		t.Error("Expected no roles for a key with a doppelganger")
+	}
+}
+
+func TestCheckAddedKeys_NoRolesUntilWatched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	v := validator{
+		beaconClient:       client,
+		doppelgangerEpochs: 1,
+		doppelgangers:      newKeySet(),
+		watchedKeys:        newKeySet(),
+	}
+	attesting := [48]byte{1}
+	idle := [48]byte{2}
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{ProposerSlot: 1, PublicKey: attesting[:]},
+			{ProposerSlot: 1, PublicKey: idle[:]},
+		},
+	}
+	// The keys were added to the key manager while the validator client runs.
+	v.watchedKeys.add(attesting)
+	v.watchedKeys.add(idle)
+	roles, err := v.RolesAt(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 0 {
+		t.Errorf("Expected no roles for keys watched for doppelgangers, received %v", roles)
+	}
+
+	gomock.InOrder(
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 8}, nil),
+		client.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+			CorrectlyVotedSource: []bool{true, false},
+		}, nil),
+	)
+	slots := make(chan uint64, 1)
+	slots <- 8 * 32
+	v.checkAddedKeys(context.Background(), [][48]byte{attesting, idle}, 5, slots)
+
+	roles, err = v.RolesAt(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := roles[attesting]; ok {
+		t.Error("Expected no roles for the key with a doppelganger")
+	}
+	if _, ok := roles[idle]; !ok {
+		t.Error("Expected roles for the key without doppelganger once watched")
+	}
+}
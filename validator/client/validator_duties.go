@@ -0,0 +1,321 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	validatorNextAttesterSlotGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "next_attester_slot",
+			Help:      "Slot of the next prefetched attestation duty.",
+		},
+		[]string{
+			// validator pubkey
+			"pkey",
+		},
+	)
+	dutiesReorgCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "duties_reorgs_total",
+			Help:      "Number of chain reorganizations that made the duties stale.",
+		},
+	)
+)
+
+// selectionProofKey identifies the slot signature of a validator.
+type selectionProofKey struct {
+	pubKey [48]byte
+	slot   uint64
+}
+
+// selectionProofCache holds the slot signatures of validators computed ahead of their attestation
+// duties. It is safe for concurrent use.
+type selectionProofCache struct {
+	proofs map[selectionProofKey][]byte
+	lock   sync.RWMutex
+}
+
+func newSelectionProofCache() *selectionProofCache {
+	return &selectionProofCache{proofs: make(map[selectionProofKey][]byte)}
+}
+
+func (c *selectionProofCache) get(pubKey [48]byte, slot uint64) []byte {
+	if c == nil {
+		return nil
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.proofs[selectionProofKey{pubKey: pubKey, slot: slot}]
+}
+
+func (c *selectionProofCache) put(pubKey [48]byte, slot uint64, proof []byte) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.proofs[selectionProofKey{pubKey: pubKey, slot: slot}] = proof
+}
+
+// prune removes the slot signatures of slots before the slot.
+func (c *selectionProofCache) prune(slot uint64) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key := range c.proofs {
+		if key.slot < slot {
+			delete(c.proofs, key)
+		}
+	}
+}
+
+// slotSignature returns the slot signature of the validator computed ahead of time, or signs the
+// slot if it was not.
+func (v *validator) slotSignature(ctx context.Context, pubKey [48]byte, slot uint64) ([]byte, error) {
+	if proof := v.selectionProofs.get(pubKey, slot); proof != nil {
+		return proof, nil
+	}
+	proof, err := v.signSlot(ctx, pubKey, slot)
+	if err != nil {
+		return nil, err
+	}
+	v.selectionProofs.put(pubKey, slot, proof)
+	return proof, nil
+}
+
+// PrefetchDuties fetches the duties of the validating keys in the epoch after the slot, so that
+// their attestation duties are ready even if the duties cannot be fetched when the epoch starts,
+// and signs the selection proofs of the attestation duties of both epochs ahead of time. It marks the duties stale when the head of the beacon node shows
+// a chain reorganization, so that they are fetched again.
+func (v *validator) PrefetchDuties(ctx context.Context, slot uint64) {
+	v.dutiesLock.Lock()
+	if v.prefetching {
+		v.dutiesLock.Unlock()
+		return
+	}
+	v.prefetching = true
+	v.dutiesLock.Unlock()
+	defer func() {
+		v.dutiesLock.Lock()
+		v.prefetching = false
+		v.dutiesLock.Unlock()
+	}()
+
+	ctx, span := trace.StartSpan(ctx, "validator.PrefetchDuties")
+	defer span.End()
+
+	epoch := helpers.SlotToEpoch(slot)
+	if v.checkReorg(ctx, epoch) {
+		v.dutiesLock.Lock()
+		v.dutiesStale = true
+		v.nextDuties = nil
+		v.dutiesLock.Unlock()
+	}
+
+	v.dutiesLock.RLock()
+	duties, dutiesEpoch, next := v.duties, v.dutiesEpoch, v.nextDuties
+	if v.nextDutiesEpoch != epoch+1 {
+		next = nil
+	}
+	v.dutiesLock.RUnlock()
+	if duties == nil || dutiesEpoch != epoch {
+		return
+	}
+
+	v.selectionProofs.prune(helpers.StartSlot(epoch))
+	if err := v.computeSelectionProofs(ctx, epoch, duties); err != nil {
+		log.WithError(err).Error("Could not compute selection proofs")
+	}
+	if next != nil {
+		return
+	}
+
+	next, err := v.validatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      epoch + 1,
+		PublicKeys: bytesutil.FromBytes48Array(dutiesKeys(duties)),
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not prefetch duties of the next epoch")
+		return
+	}
+	v.dutiesLock.Lock()
+	v.nextDuties = next
+	v.nextDutiesEpoch = epoch + 1
+	v.dutiesLock.Unlock()
+	if err := v.computeSelectionProofs(ctx, epoch+1, next); err != nil {
+		log.WithError(err).Error("Could not compute selection proofs")
+	}
+	v.logUpcomingDuties(epoch+1, next)
+}
+
+// checkReorg reports whether the dependent root of the epoch changed since it was last checked,
+// which happens when the chain reorganizes. The dependent root is the root of the last block before
+// the epoch, which the proposers of the epoch and the committees of the next epoch depend on, and
+// whose changes also change the committees of the epoch.
+func (v *validator) checkReorg(ctx context.Context, epoch uint64) bool {
+	head, err := v.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Debug("Could not get chain head")
+		return false
+	}
+	if bytes.Equal(head.HeadBlockRoot, v.headRoot) {
+		return false
+	}
+	dependentRoot, err := v.dependentRootOf(ctx, head.HeadBlockRoot, head.HeadSlot, epoch)
+	if err != nil {
+		log.WithError(err).Debug("Could not get dependent root")
+		return false
+	}
+	reorg := v.dependentRoot != nil && v.dependentEpoch == epoch && !bytes.Equal(dependentRoot, v.dependentRoot)
+	if reorg {
+		log.WithFields(logrus.Fields{
+			"epoch":                 epoch,
+			"previousDependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(v.dependentRoot)),
+			"dependentRoot":         fmt.Sprintf("%#x", bytesutil.Trunc(dependentRoot)),
+		}).Info("Chain reorganization, fetching duties again")
+		dutiesReorgCount.Inc()
+	}
+	v.headRoot = head.HeadBlockRoot
+	v.dependentRoot = dependentRoot
+	v.dependentEpoch = epoch
+	return reorg
+}
+
+// dependentRootOf the epoch in the chain of the head, found by walking up its ancestors until the
+// last block before the epoch, or until the previous head if the dependent root of the epoch in its
+// chain is known.
+func (v *validator) dependentRootOf(ctx context.Context, root []byte, slot uint64, epoch uint64) ([]byte, error) {
+	startSlot := helpers.StartSlot(epoch)
+	known := v.dependentRoot != nil && v.dependentEpoch == epoch
+	var block *ethpb.BeaconBlock
+	for slot >= startSlot {
+		if known && bytes.Equal(root, v.headRoot) {
+			return v.dependentRoot, nil
+		}
+		if block == nil {
+			var err error
+			if block, err = v.block(ctx, root); err != nil {
+				return nil, err
+			}
+		}
+		root = block.ParentRoot
+		if known && bytes.Equal(root, v.headRoot) {
+			return v.dependentRoot, nil
+		}
+		var err error
+		if block, err = v.block(ctx, root); err != nil {
+			return nil, err
+		}
+		slot = block.Slot
+	}
+	return root, nil
+}
+
+// block returns the block of the root.
+func (v *validator) block(ctx context.Context, root []byte) (*ethpb.BeaconBlock, error) {
+	resp, err := v.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.BlockContainers) == 0 || resp.BlockContainers[0].Block == nil || resp.BlockContainers[0].Block.Block == nil {
+		return nil, fmt.Errorf("block %#x not found", bytesutil.Trunc(root))
+	}
+	return resp.BlockContainers[0].Block.Block, nil
+}
+
+// computeSelectionProofs signs the slot signatures of the attestation duties in the epoch that
+// were not signed before.
+func (v *validator) computeSelectionProofs(ctx context.Context, epoch uint64, duties *ethpb.DutiesResponse) error {
+	var domain *ethpb.DomainResponse
+	for _, duty := range duties.Duties {
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(duty.PublicKey)
+		if v.selectionProofs.get(pubKey, duty.AttesterSlot) != nil {
+			continue
+		}
+		if domain == nil {
+			var err error
+			domain, err = v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+				Epoch:  epoch,
+				Domain: params.BeaconConfig().DomainBeaconAttester,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		proof, err := v.signSlotWithDomain(pubKey, duty.AttesterSlot, domain.SignatureDomain)
+		if err != nil {
+			return err
+		}
+		v.selectionProofs.put(pubKey, duty.AttesterSlot, proof)
+	}
+	return nil
+}
+
+// logUpcomingDuties logs the prefetched attestation duties in the epoch, with whether the
+// validators are selected as aggregators. The proposers of the epoch are not known yet.
+func (v *validator) logUpcomingDuties(epoch uint64, duties *ethpb.DutiesResponse) {
+	for _, duty := range duties.Duties {
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE {
+			continue
+		}
+		lFields := logrus.Fields{
+			"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)),
+			"validatorIndex": duty.ValidatorIndex,
+			"committeeIndex": duty.CommitteeIndex,
+			"epoch":          epoch,
+			"attesterSlot":   duty.AttesterSlot,
+		}
+		if proof := v.selectionProofs.get(bytesutil.ToBytes48(duty.PublicKey), duty.AttesterSlot); proof != nil {
+			lFields["aggregator"] = isAggregatorSignature(duty.Committee, proof)
+		}
+		log.WithFields(lFields).Info("Upcoming assignment")
+
+		if v.emitAccountMetrics {
+			pubKey := fmt.Sprintf("%#x", duty.PublicKey[:8])
+			validatorNextAttesterSlotGaugeVec.WithLabelValues(pubKey).Set(float64(duty.AttesterSlot))
+		}
+	}
+}
+
+// withoutProposals returns a copy of the duties without their proposal duties.
+func withoutProposals(duties *ethpb.DutiesResponse) *ethpb.DutiesResponse {
+	resp := &ethpb.DutiesResponse{Duties: make([]*ethpb.DutiesResponse_Duty, len(duties.Duties))}
+	for i, duty := range duties.Duties {
+		d := *duty
+		d.ProposerSlot = 0
+		resp.Duties[i] = &d
+	}
+	return resp
+}
+
+// dutiesKeys returns the public keys of the duties.
+func dutiesKeys(duties *ethpb.DutiesResponse) [][48]byte {
+	keys := make([][48]byte, len(duties.Duties))
+	for i, duty := range duties.Duties {
+		keys[i] = bytesutil.ToBytes48(duty.PublicKey)
+	}
+	return keys
+}
@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+func TestUpdateDuties_FetchesPrefetchedDutiesAgain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	prefetched := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot: slotsPerEpoch + 3,
+				ProposerSlot: slotsPerEpoch + 1,
+				PublicKey:    validatorPubKey[:],
+			},
+		},
+	}
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		duties:          &ethpb.DutiesResponse{},
+		nextDuties:      prefetched,
+		nextDutiesEpoch: 1,
+	}
+	// The proposer of the epoch changed after the duties were prefetched.
+	fetched := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot: slotsPerEpoch + 3,
+				PublicKey:    validatorPubKey[:],
+			},
+		},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		&ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{validatorPubKey[:]}},
+	).Return(fetched, nil)
+
+	if err := v.UpdateDuties(context.Background(), slotsPerEpoch); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	v.dutiesLock.RLock()
+	installed := v.duties
+	v.dutiesLock.RUnlock()
+	if installed == fetched || len(installed.Duties) != 1 || installed.Duties[0].ProposerSlot != 0 {
+		t.Errorf("Expected the prefetched attestation duties to be installed right away, received %v", installed)
+	}
+	if v.nextDuties != nil {
+		t.Error("Expected the prefetched duties to be consumed")
+	}
+	v.dutiesFetches.Wait()
+	if v.duties != fetched || v.dutiesEpoch != 1 {
+		t.Errorf("Expected the fetched duties of epoch 1, received %v of epoch %d", v.duties, v.dutiesEpoch)
+	}
+}
+
+func TestUpdateDuties_FallsBackToPrefetchedAttestationDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	prefetched := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot: slotsPerEpoch + 3,
+				ProposerSlot: slotsPerEpoch + 1,
+				PublicKey:    validatorPubKey[:],
+			},
+		},
+	}
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		duties:          &ethpb.DutiesResponse{},
+		nextDuties:      prefetched,
+		nextDutiesEpoch: 1,
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, errors.New("unavailable"))
+
+	if err := v.UpdateDuties(context.Background(), slotsPerEpoch); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	v.dutiesFetches.Wait()
+	if v.dutiesEpoch != 1 || len(v.duties.Duties) != 1 || v.duties.Duties[0].AttesterSlot != slotsPerEpoch+3 {
+		t.Fatalf("Expected the prefetched attestation duties of epoch 1, received %v of epoch %d", v.duties, v.dutiesEpoch)
+	}
+	if v.duties.Duties[0].ProposerSlot != 0 {
+		t.Errorf("Expected no prefetched proposal duty, received slot %d", v.duties.Duties[0].ProposerSlot)
+	}
+
+	// The duties are fetched again at the next slot.
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(prefetched, nil)
+	if err := v.UpdateDuties(context.Background(), slotsPerEpoch+1); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	v.dutiesFetches.Wait()
+	if v.duties != prefetched {
+		t.Errorf("Expected the fetched duties once the beacon node answers, received %v", v.duties)
+	}
+}
+
+func TestPrefetchDuties_FetchesNextEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		beaconClient:    beaconClient,
+		selectionProofs: newSelectionProofCache(),
+		duties: &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{
+					AttesterSlot: slotsPerEpoch + 2,
+					PublicKey:    validatorPubKey[:],
+					Status:       ethpb.ValidatorStatus_ACTIVE,
+				},
+			},
+		},
+		dutiesEpoch: 1,
+	}
+	beaconClient.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadSlot: slotsPerEpoch - 1, HeadBlockRoot: []byte{1}}, nil)
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		&ethpb.DutiesRequest{Epoch: 2, PublicKeys: [][]byte{validatorPubKey[:]}},
+	).Return(&ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot: 2*slotsPerEpoch + 5,
+				PublicKey:    validatorPubKey[:],
+				Status:       ethpb.ValidatorStatus_ACTIVE,
+			},
+		},
+	}, nil)
+	// One domain for the selection proofs of each epoch.
+	client.EXPECT().DomainData(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DomainResponse{}, nil).Times(2)
+
+	v.PrefetchDuties(context.Background(), slotsPerEpoch+1)
+	if v.nextDuties == nil || v.nextDutiesEpoch != 2 {
+		t.Fatalf("Expected prefetched duties of epoch 2, received %v of epoch %d", v.nextDuties, v.nextDutiesEpoch)
+	}
+	for _, slot := range []uint64{slotsPerEpoch + 2, 2*slotsPerEpoch + 5} {
+		if v.selectionProofs.get(validatorPubKey, slot) == nil {
+			t.Errorf("Expected a selection proof for slot %d", slot)
+		}
+	}
+}
+
+// expectBlocks serves the blocks by their root.
+func expectBlocks(client *mock.MockBeaconChainClient, blocks map[byte]*ethpb.BeaconBlock) {
+	client.EXPECT().ListBlocks(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, req *ethpb.ListBlocksRequest) (*ethpb.ListBlocksResponse, error) {
+		root := req.QueryFilter.(*ethpb.ListBlocksRequest_Root).Root
+		block, ok := blocks[root[0]]
+		if !ok {
+			return &ethpb.ListBlocksResponse{}, nil
+		}
+		return &ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{{Block: &ethpb.SignedBeaconBlock{Block: block}, BlockRoot: root}},
+		}, nil
+	}).AnyTimes()
+}
+
+func TestPrefetchDuties_ReorgMakesDutiesStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := validator{
+		beaconClient:   beaconClient,
+		headRoot:       []byte{1},
+		dependentRoot:  []byte{9},
+		dependentEpoch: 1,
+		nextDuties:     &ethpb.DutiesResponse{},
+	}
+	// The new head is at a later slot than the previous head, on a chain whose last block before
+	// the epoch is another block.
+	beaconClient.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadSlot: slotsPerEpoch + 8, HeadBlockRoot: []byte{3}}, nil)
+	expectBlocks(beaconClient, map[byte]*ethpb.BeaconBlock{
+		3: {Slot: slotsPerEpoch + 8, ParentRoot: []byte{2}},
+		2: {Slot: slotsPerEpoch - 2, ParentRoot: []byte{9}},
+	})
+
+	v.PrefetchDuties(context.Background(), slotsPerEpoch+9)
+	if !v.dutiesStale {
+		t.Error("Expected the duties to be stale after a reorganization")
+	}
+	if v.nextDuties != nil {
+		t.Error("Expected the prefetched duties to be dropped after a reorganization")
+	}
+	if len(v.dependentRoot) != 1 || v.dependentRoot[0] != 2 {
+		t.Errorf("Expected dependent root 0x02, received %#x", v.dependentRoot)
+	}
+}
+
+func TestCheckReorg_HeadExtendingPreviousHead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	v := validator{
+		beaconClient:   beaconClient,
+		headRoot:       []byte{1},
+		dependentRoot:  []byte{9},
+		dependentEpoch: 1,
+	}
+	beaconClient.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadSlot: slotsPerEpoch + 8, HeadBlockRoot: []byte{3}}, nil)
+	// Only the new head is requested, as its parent is the previous head.
+	expectBlocks(beaconClient, map[byte]*ethpb.BeaconBlock{
+		3: {Slot: slotsPerEpoch + 8, ParentRoot: []byte{1}},
+	})
+
+	if v.checkReorg(context.Background(), 1) {
+		t.Error("Expected no reorganization")
+	}
+	if len(v.dependentRoot) != 1 || v.dependentRoot[0] != 9 {
+		t.Errorf("Expected dependent root 0x09, received %#x", v.dependentRoot)
+	}
+}
+
+func TestIsAggregator_UsesSelectionProof(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		selectionProofs: newSelectionProofCache(),
+	}
+	v.selectionProofs.put(validatorPubKey, 5, []byte{'A'})
+	client.EXPECT().DomainData(
+		gomock.Any(),
+		gomock.Any(),
+	).Times(0)
+
+	aggregator, err := v.isAggregator(context.Background(), []uint64{1}, 5, validatorPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aggregator {
+		t.Error("Expected the only committee member to aggregate")
+	}
+}
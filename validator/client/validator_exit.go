@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+)
+
+// CheckExitEligibility returns an error if the validator may not exit in the epoch, because it
+// is not active, is already exiting, or has not been active for the persistent committee period.
+func CheckExitEligibility(validator *ethpb.Validator, epoch uint64) error {
+	if validator.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		return fmt.Errorf("validator is already exiting at epoch %d", validator.ExitEpoch)
+	}
+	if !helpers.IsActiveValidator(validator, epoch) {
+		return errors.New("validator is not active")
+	}
+	if minEpoch := validator.ActivationEpoch + params.BeaconConfig().PersistentCommitteePeriod; epoch < minEpoch {
+		return fmt.Errorf("validator has not been active long enough to exit, it may exit from epoch %d", minEpoch)
+	}
+	return nil
+}
+
+// EarliestExitEpoch is the earliest epoch a validator exiting in the epoch leaves the active
+// validator set. The exit epoch is later when more validators exit than the churn limit allows.
+func EarliestExitEpoch(epoch uint64) uint64 {
+	return helpers.DelayedActivationExitEpoch(epoch)
+}
+
+// ProposeExit signs a voluntary exit of the validator of the public key, valid from the epoch,
+// and proposes it to the beacon node. It returns the proposed exit.
+func ProposeExit(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	km keymanager.KeyManager,
+	pubKey [48]byte,
+	epoch uint64,
+) (*ethpb.SignedVoluntaryExit, error) {
+	indexRes, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator index")
+	}
+	exit := &ethpb.VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: indexRes.Index,
+	}
+	domain, err := validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get domain data")
+	}
+	root, err := ssz.HashTreeRoot(exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	sig, err := km.Sign(pubKey, root, domain.SignatureDomain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign exit")
+	}
+	signedExit := &ethpb.SignedVoluntaryExit{
+		Exit:      exit,
+		Signature: sig.Marshal(),
+	}
+	if _, err := validatorClient.ProposeExit(ctx, signedExit); err != nil {
+		return nil, errors.Wrap(err, "could not propose exit")
+	}
+	return signedExit, nil
+}
+
+// WaitForExit polls the beacon node every slot for the status of the validator of the public key,
+// logging its exit epoch once the exit is processed. It returns once the validator has exited.
+func WaitForExit(ctx context.Context, beaconClient ethpb.BeaconChainClient, pubKey [48]byte) error {
+	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	exitEpoch := farFuture
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		val, err := beaconClient.GetValidator(ctx, &ethpb.GetValidatorRequest{
+			QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: pubKey[:]},
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not get validator")
+		}
+		head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get chain head")
+		}
+		if val.ExitEpoch != farFuture && exitEpoch == farFuture {
+			exitEpoch = val.ExitEpoch
+			log.WithFields(logrus.Fields{
+				"exitEpoch":         val.ExitEpoch,
+				"withdrawableEpoch": val.WithdrawableEpoch,
+			}).Info("Voluntary exit processed, waiting for the exit epoch")
+		}
+		if exitEpoch != farFuture && head.HeadEpoch >= exitEpoch {
+			log.WithField("epoch", head.HeadEpoch).Info("Validator exited")
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
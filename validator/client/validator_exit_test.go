@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+func TestProposeExit_OK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	client.EXPECT().ValidatorIndex(
+		gomock.Any(),
+		&ethpb.ValidatorIndexRequest{PublicKey: validatorPubKey[:]},
+	).Return(&ethpb.ValidatorIndexResponse{Index: 7}, nil)
+	client.EXPECT().DomainData(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DomainResponse{}, nil)
+	client.EXPECT().ProposeExit(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ptypes.Empty{}, nil)
+
+	exit, err := ProposeExit(context.Background(), client, testKeyManager, validatorPubKey, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exit.Exit.ValidatorIndex != 7 || exit.Exit.Epoch != 300 {
+		t.Errorf("Unexpected exit %v", exit.Exit)
+	}
+	if len(exit.Signature) != 96 {
+		t.Errorf("Expected a signature of 96 bytes, received %d", len(exit.Signature))
+	}
+}
+
+func TestCheckExitEligibility(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	period := params.BeaconConfig().PersistentCommitteePeriod
+	tests := []struct {
+		name      string
+		validator *ethpb.Validator
+		epoch     uint64
+		wantErr   string
+	}{
+		{
+			name:      "eligible",
+			validator: &ethpb.Validator{ActivationEpoch: 10, ExitEpoch: farFuture},
+			epoch:     10 + period,
+		},
+		{
+			name:      "pending",
+			validator: &ethpb.Validator{ActivationEpoch: farFuture, ExitEpoch: farFuture},
+			epoch:     period,
+			wantErr:   "not active",
+		},
+		{
+			name:      "exiting",
+			validator: &ethpb.Validator{ActivationEpoch: 10, ExitEpoch: 20},
+			epoch:     15,
+			wantErr:   "already exiting",
+		},
+		{
+			name:      "not active long enough",
+			validator: &ethpb.Validator{ActivationEpoch: 10, ExitEpoch: farFuture},
+			epoch:     9 + period,
+			wantErr:   "not been active long enough",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckExitEligibility(tt.validator, tt.epoch)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, received %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitForExit_Exited(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+
+	client.EXPECT().GetValidator(
+		gomock.Any(),
+		&ethpb.GetValidatorRequest{QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: validatorPubKey[:]}},
+	).Return(&ethpb.Validator{ExitEpoch: 20}, nil)
+	client.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadEpoch: 20}, nil)
+
+	if err := WaitForExit(context.Background(), client, validatorPubKey); err != nil {
+		t.Fatal(err)
+	}
+}
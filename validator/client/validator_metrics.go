@@ -27,13 +27,18 @@ var validatorBalancesGaugeVec = promauto.NewGaugeVec(
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
-// of how the validator performs with respect to the rest.
+// of how the validator performs with respect to the rest. The performance is also recorded in
+// the validator database when enabled.
+//
+// At the start of an epoch, the beacon node reports the attestations of two epochs earlier, which
+// were last processed in the epoch transition it just went through.
 func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 || slot <= params.BeaconConfig().SlotsPerEpoch {
-		// Do nothing if we are not at the start of a new epoch and before the first epoch.
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 || slot < 2*params.BeaconConfig().SlotsPerEpoch {
+		// Do nothing if we are not at the start of a new epoch, or before an epoch can be reported.
 		return nil
 	}
-	if !v.logValidatorBalances {
+	epoch := slot/params.BeaconConfig().SlotsPerEpoch - 2
+	if !v.logValidatorBalances && v.performanceDB == nil {
 		return nil
 	}
 
@@ -51,6 +56,15 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 		return err
 	}
 
+	if v.performanceDB != nil {
+		if err := v.recordEpochPerformance(ctx, epoch, pks, resp); err != nil {
+			log.WithError(err).Error("Could not record validator performance")
+		}
+	}
+	if !v.logValidatorBalances {
+		return nil
+	}
+
 	missingValidators := make(map[[48]byte]bool)
 	for _, val := range resp.MissingValidators {
 		missingValidators[bytesutil.ToBytes48(val)] = true
@@ -80,7 +94,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 			prevBalance := float64(resp.BalancesBeforeEpochTransition[i]) / float64(params.BeaconConfig().GweiPerEth)
 			percentNet := (newBalance - prevBalance) / prevBalance
 			log.WithFields(logrus.Fields{
-				"epoch":                (slot / params.BeaconConfig().SlotsPerEpoch) - 1,
+				"epoch":                epoch,
 				"correctlyVotedSource": resp.CorrectlyVotedSource[i],
 				"correctlyVotedTarget": resp.CorrectlyVotedTarget[i],
 				"correctlyVotedHead":   resp.CorrectlyVotedHead[i],
@@ -113,7 +127,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 	}
 
 	log.WithFields(logrus.Fields{
-		"epoch":                          (slot / params.BeaconConfig().SlotsPerEpoch) - 1,
+		"epoch":                          epoch,
 		"attestationInclusionPercentage": fmt.Sprintf("%.2f", float64(included)/float64(len(resp.InclusionSlots))),
 		"correctlyVotedSourcePercentage": fmt.Sprintf("%.2f", float64(votedSource)/float64(len(resp.CorrectlyVotedSource))),
 		"correctlyVotedTargetPercentage": fmt.Sprintf("%.2f", float64(votedTarget)/float64(len(resp.CorrectlyVotedTarget))),
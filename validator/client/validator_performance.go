@@ -0,0 +1,161 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// epochStartTime returns the start time of the epoch, in seconds since the Unix epoch.
+func (v *validator) epochStartTime(epoch uint64) uint64 {
+	return v.genesisTime + epoch*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot
+}
+
+// recordProposal records the proposal of the validator at the slot in its performance, with the
+// root of the proposed block, or no root if no block was proposed.
+func (v *validator) recordProposal(ctx context.Context, pubKey [48]byte, slot uint64, blockRoot []byte) {
+	if v.performanceDB == nil {
+		return
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	if err := v.performanceDB.UpdatePerformance(ctx, pubKey[:], epoch, func(p *dbpb.EpochPerformance) {
+		p.StartTime = v.epochStartTime(epoch)
+		p.Proposals = append(p.Proposals, &dbpb.Proposal{Slot: slot, BlockRoot: blockRoot})
+	}); err != nil {
+		log.WithError(err).Error("Could not record proposal")
+	}
+}
+
+// recordAggregation records an aggregate submitted by the validator at the slot in its performance.
+func (v *validator) recordAggregation(ctx context.Context, pubKey [48]byte, slot uint64) {
+	if v.performanceDB == nil {
+		return
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	if err := v.performanceDB.UpdatePerformance(ctx, pubKey[:], epoch, func(p *dbpb.EpochPerformance) {
+		p.StartTime = v.epochStartTime(epoch)
+		p.Aggregations++
+	}); err != nil {
+		log.WithError(err).Error("Could not record aggregation")
+	}
+}
+
+// recordEpochPerformance records the attestation performance and the balances of the validators
+// in the epoch reported by the beacon node, and whether the blocks they proposed in the epoch were
+// orphaned.
+func (v *validator) recordEpochPerformance(ctx context.Context, epoch uint64, pubKeys [][48]byte, resp *ethpb.ValidatorPerformanceResponse) error {
+	ctx, span := trace.StartSpan(ctx, "validator.recordEpochPerformance")
+	defer span.End()
+
+	missing := make(map[[48]byte]bool, len(resp.MissingValidators))
+	for _, pubKey := range resp.MissingValidators {
+		missing[bytesutil.ToBytes48(pubKey)] = true
+	}
+	canonical, err := v.canonicalRoots(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	// The performance is reported in the order of the requested keys, skipping the missing ones.
+	i := 0
+	for _, pubKey := range pubKeys {
+		if missing[pubKey] {
+			continue
+		}
+		if i >= len(resp.InclusionSlots) {
+			break
+		}
+		orphaned, err := v.orphanedProposals(ctx, pubKey, epoch, canonical)
+		if err != nil {
+			return err
+		}
+		idx := i
+		if err := v.performanceDB.UpdatePerformance(ctx, pubKey[:], epoch, func(p *dbpb.EpochPerformance) {
+			p.StartTime = v.epochStartTime(epoch)
+			p.Accounted = true
+			p.AttestationIncluded = resp.InclusionSlots[idx] != ^uint64(0)
+			if p.AttestationIncluded && idx < len(resp.InclusionDistances) {
+				p.InclusionDistance = resp.InclusionDistances[idx]
+			}
+			p.CorrectSource = idx < len(resp.CorrectlyVotedSource) && resp.CorrectlyVotedSource[idx]
+			p.CorrectTarget = idx < len(resp.CorrectlyVotedTarget) && resp.CorrectlyVotedTarget[idx]
+			p.CorrectHead = idx < len(resp.CorrectlyVotedHead) && resp.CorrectlyVotedHead[idx]
+			if idx < len(resp.BalancesBeforeEpochTransition) && idx < len(resp.BalancesAfterEpochTransition) {
+				p.BalanceBefore = resp.BalancesBeforeEpochTransition[idx]
+				p.BalanceAfter = resp.BalancesAfterEpochTransition[idx]
+			}
+			for _, proposal := range p.Proposals {
+				proposal.Orphaned = orphaned[proposal.Slot]
+			}
+		}); err != nil {
+			return err
+		}
+		i++
+	}
+	return nil
+}
+
+// orphanedProposals returns the slots of the blocks proposed by the validator in the epoch which
+// are not in the chain of the head, given the roots of the blocks of the epoch in that chain.
+func (v *validator) orphanedProposals(ctx context.Context, pubKey [48]byte, epoch uint64, canonical map[uint64][]byte) (map[uint64]bool, error) {
+	performances, err := v.performanceDB.Performance(ctx, pubKey[:], epoch, epoch)
+	if err != nil {
+		return nil, err
+	}
+	orphaned := make(map[uint64]bool)
+	for _, p := range performances {
+		for _, proposal := range p.Proposals {
+			if len(proposal.BlockRoot) == 0 {
+				continue
+			}
+			orphaned[proposal.Slot] = !bytes.Equal(canonical[proposal.Slot], proposal.BlockRoot)
+		}
+	}
+	return orphaned, nil
+}
+
+// canonicalRoots returns the roots of the blocks of the epoch in the chain of the head, by slot.
+// The blocks of the epochs from the epoch to the epoch of the head are listed, as blocks listed by
+// slot also include the blocks of other forks, and the chain is walked back from the head.
+func (v *validator) canonicalRoots(ctx context.Context, epoch uint64) (map[uint64][]byte, error) {
+	head, err := v.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	blocks := make(map[string]*ethpb.BeaconBlock)
+	for e := epoch; e <= head.HeadEpoch; e++ {
+		req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: e}}
+		for {
+			resp, err := v.beaconClient.ListBlocks(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			for _, container := range resp.BlockContainers {
+				if container.Block != nil && container.Block.Block != nil {
+					blocks[string(container.BlockRoot)] = container.Block.Block
+				}
+			}
+			if resp.NextPageToken == "" || len(resp.BlockContainers) == 0 {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+
+	roots := make(map[uint64][]byte)
+	startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+	root := head.HeadBlockRoot
+	for {
+		blk, ok := blocks[string(root)]
+		if !ok || blk.Slot < startSlot {
+			return roots, nil
+		}
+		roots[blk.Slot] = root
+		root = blk.ParentRoot
+	}
+}
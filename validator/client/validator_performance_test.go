@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLogValidatorGainsAndLosses_RecordsPerformance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	valDB := db.SetupDB(t, [][48]byte{validatorPubKey})
+	defer db.TeardownDB(t, valDB)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	v := validator{
+		performanceDB: valDB,
+		genesisTime:   1000,
+		keyManager:    testKeyManager,
+		beaconClient:  beaconClient,
+		prevBalance:   make(map[[48]byte]uint64),
+	}
+	ctx := context.Background()
+	v.recordProposal(ctx, validatorPubKey, slotsPerEpoch+1, []byte("A"))
+	v.recordProposal(ctx, validatorPubKey, slotsPerEpoch+2, nil)
+	v.recordAggregation(ctx, validatorPubKey, slotsPerEpoch+3)
+
+	beaconClient.EXPECT().GetValidatorPerformance(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ValidatorPerformanceResponse{
+		InclusionSlots:                []uint64{slotsPerEpoch + 5},
+		InclusionDistances:            []uint64{2},
+		CorrectlyVotedSource:          []bool{true},
+		CorrectlyVotedTarget:          []bool{true},
+		CorrectlyVotedHead:            []bool{false},
+		BalancesBeforeEpochTransition: []uint64{32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000001000},
+	}, nil)
+	// The proposed block is known, but another block of its slot is in the chain of the head.
+	beaconClient.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadEpoch: 2, HeadBlockRoot: []byte("D")}, nil)
+	block := func(root string, slot uint64, parent string) *ethpb.BeaconBlockContainer {
+		return &ethpb.BeaconBlockContainer{
+			BlockRoot: []byte(root),
+			Block:     &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: []byte(parent)}},
+		}
+	}
+	beaconClient.EXPECT().ListBlocks(
+		gomock.Any(),
+		&ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 1}},
+	).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			block("A", slotsPerEpoch+1, "P"),
+			block("B", slotsPerEpoch+1, "P"),
+			block("C", slotsPerEpoch+4, "B"),
+		},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(
+		gomock.Any(),
+		&ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 2}},
+	).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{block("D", 2*slotsPerEpoch, "C")},
+	}, nil)
+
+	// The performance of epoch 1 is reported at the start of epoch 3.
+	if err := v.LogValidatorGainsAndLosses(ctx, 3*slotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+
+	performances, err := valDB.Performance(ctx, validatorPubKey[:], 0, math.MaxUint64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &dbpb.EpochPerformance{
+		Epoch:               1,
+		StartTime:           1000 + slotsPerEpoch*secondsPerSlot,
+		Accounted:           true,
+		AttestationIncluded: true,
+		InclusionDistance:   2,
+		CorrectSource:       true,
+		CorrectTarget:       true,
+		BalanceBefore:       32000000000,
+		BalanceAfter:        32000001000,
+		Proposals: []*dbpb.Proposal{
+			{Slot: slotsPerEpoch + 1, BlockRoot: []byte("A"), Orphaned: true},
+			{Slot: slotsPerEpoch + 2},
+		},
+		Aggregations: 1,
+	}
+	if len(performances) != 1 || !proto.Equal(performances[0], want) {
+		t.Errorf("Wanted performance %v, received %v", want, performances)
+	}
+}
+
+func TestLogValidatorGainsAndLosses_NotRecordingOrLogging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// Any request for the performance fails the test.
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	v := validator{
+		keyManager:   testKeyManager,
+		beaconClient: beaconClient,
+		prevBalance:  make(map[[48]byte]uint64),
+	}
+	if err := v.LogValidatorGainsAndLosses(context.Background(), 3*params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLogValidatorGainsAndLosses_LogsReportedEpoch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	v := validator{
+		logValidatorBalances: true,
+		keyManager:           testKeyManager,
+		beaconClient:         beaconClient,
+		prevBalance:          map[[48]byte]uint64{validatorPubKey: 32000000000},
+	}
+	beaconClient.EXPECT().GetValidatorPerformance(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ValidatorPerformanceResponse{
+		InclusionSlots:                []uint64{params.BeaconConfig().SlotsPerEpoch + 5},
+		InclusionDistances:            []uint64{2},
+		CorrectlyVotedSource:          []bool{true},
+		CorrectlyVotedTarget:          []bool{true},
+		CorrectlyVotedHead:            []bool{true},
+		BalancesBeforeEpochTransition: []uint64{32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000001000},
+	}, nil)
+
+	// The summaries logged at the start of epoch 3 are of epoch 1.
+	if err := v.LogValidatorGainsAndLosses(context.Background(), 3*params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+	summaries := 0
+	for _, entry := range hook.AllEntries() {
+		epoch, ok := entry.Data["epoch"]
+		if !ok {
+			continue
+		}
+		summaries++
+		if epoch != uint64(1) {
+			t.Errorf("Wanted %q to be logged for epoch 1, received epoch %v", entry.Message, epoch)
+		}
+	}
+	if summaries != 2 {
+		t.Errorf("Wanted 2 voting summaries, received %d", summaries)
+	}
+}
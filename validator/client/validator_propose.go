@@ -11,10 +11,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -42,6 +46,9 @@ var (
 	)
 )
 
+// errDoubleProposal rejects the update of the history of a key with a second proposal in an epoch.
+var errDoubleProposal = errors.New("double proposal")
+
 // ProposeBlock A new beacon block for a given slot. This method collects the
 // previous beacon block, any pending deposits, and ETH1 data from the beacon
 // chain node to construct the new block. The new block is then processed with
@@ -56,6 +63,12 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	defer span.End()
 	fmtKey := fmt.Sprintf("%#x", pubKey[:8])
 
+	// Record the proposal, as missed unless the block is proposed.
+	var blockRoot []byte
+	defer func() {
+		v.recordProposal(ctx, pubKey, slot, blockRoot)
+	}()
+
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
 
@@ -70,11 +83,16 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		return
 	}
 
+	graffiti, err := v.blockGraffiti(pubKey, slot)
+	if err != nil {
+		log.WithError(err).Warn("Failed to select graffiti, proposing without graffiti")
+	}
+
 	// Request block from beacon node
 	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
-		Graffiti:     v.graffiti,
+		Graffiti:     graffiti,
 	})
 	if err != nil {
 		log.WithError(err).Error("Failed to request block from beacon node")
@@ -84,17 +102,50 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		return
 	}
 
-	if featureconfig.Get().ProtectProposer {
-		history, err := v.db.ProposalHistory(ctx, pubKey[:])
-		if err != nil {
-			log.WithError(err).Error("Failed to get proposal history")
-			if v.emitAccountMetrics {
-				validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+	// A client which stands by must not record the proposal either, as the leader would then refuse
+	// to sign a block of the epoch.
+	if v.isStandby() {
+		log.Warn("Standing by for the leader, not proposing block")
+		if v.emitAccountMetrics {
+			validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return
+	}
+
+	// Databases shared with other validator clients check and record the proposal atomically
+	// before it is signed, so that no other client signs another block of the epoch. Protection is
+	// always on for them, as the other clients sign with the same keys.
+	updater, shared := v.db.(iface.HistoryUpdater)
+	if featureconfig.Get().ProtectProposer || shared {
+		var proposed bool
+		if shared {
+			err := updater.UpdateProposalHistory(ctx, pubKey[:], func(history *slashpb.ProposalHistory) (*slashpb.ProposalHistory, error) {
+				if HasProposedForEpoch(history, epoch) {
+					return nil, errDoubleProposal
+				}
+				return SetProposedForEpoch(history, epoch), nil
+			})
+			proposed = err == errDoubleProposal
+			if err != nil && !proposed {
+				log.WithError(err).Error("Failed to update proposal history")
+				if v.emitAccountMetrics {
+					validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+				}
+				return
 			}
-			return
+		} else {
+			history, err := v.db.ProposalHistory(ctx, pubKey[:])
+			if err != nil {
+				log.WithError(err).Error("Failed to get proposal history")
+				if v.emitAccountMetrics {
+					validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+				}
+				return
+			}
+			proposed = HasProposedForEpoch(history, epoch)
 		}
 
-		if HasProposedForEpoch(history, epoch) {
+		if proposed {
 			log.WithField("epoch", epoch).Warn("Tried to sign a double proposal, rejected")
 			if v.emitAccountMetrics {
 				validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -103,6 +154,14 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 	}
 
+	if v.isStandby() {
+		log.Warn("Lost leadership before signing block, not signing")
+		if v.emitAccountMetrics {
+			validatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return
+	}
+
 	// Sign returned block from beacon node
 	sig, err := v.signBlock(ctx, pubKey, epoch, b)
 	if err != nil {
@@ -126,8 +185,9 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 		return
 	}
+	blockRoot = blkResp.BlockRoot
 
-	if featureconfig.Get().ProtectProposer {
+	if featureconfig.Get().ProtectProposer && !shared {
 		history, err := v.db.ProposalHistory(ctx, pubKey[:])
 		if err != nil {
 			log.WithError(err).Error("Failed to get proposal history")
@@ -165,9 +225,18 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	}).Info("Submitted new block")
 }
 
-// ProposeExit --
-func (v *validator) ProposeExit(ctx context.Context, exit *ethpb.VoluntaryExit) error {
-	return errors.New("unimplemented")
+// blockGraffiti returns the graffiti of the block proposed by the validator at the slot.
+func (v *validator) blockGraffiti(pubKey [48]byte, slot uint64) ([]byte, error) {
+	data := &graffiti.Data{
+		Version:   version.GetShortVersion(),
+		PublicKey: fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		Slot:      slot,
+		Epoch:     helpers.SlotToEpoch(slot),
+	}
+	if duty, err := v.duty(pubKey); err == nil {
+		data.ValidatorIndex = duty.ValidatorIndex
+	}
+	return v.graffitiSource.Graffiti(pubKey, data)
 }
 
 // Sign randao reveal with randao domain and private key.
@@ -249,3 +318,22 @@ func SetProposedForEpoch(history *slashpb.ProposalHistory, epoch uint64) *slashp
 	history.EpochBits.SetBitAt(epoch%wsPeriod, true)
 	return history
 }
+
+// mergeProposalHistory marks in the proposal history the epochs proposed for in the other history,
+// which are not pruned from it.
+// Returns the modified proposal history.
+func mergeProposalHistory(history *slashpb.ProposalHistory, other *slashpb.ProposalHistory) *slashpb.ProposalHistory {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	start := uint64(0)
+	if other.LatestEpochWritten >= wsPeriod {
+		start = other.LatestEpochWritten - wsPeriod + 1
+	}
+	for epoch := start; epoch <= other.LatestEpochWritten; epoch++ {
+		// Epochs pruned from the history are not marked again.
+		if !HasProposedForEpoch(other, epoch) || int(epoch) <= int(history.LatestEpochWritten)-int(wsPeriod) {
+			continue
+		}
+		history = SetProposedForEpoch(history, epoch)
+	}
+	return history
+}
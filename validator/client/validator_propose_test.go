@@ -3,7 +3,12 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -13,6 +18,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/ha"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -34,7 +41,6 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		validatorClient:  m.validatorClient,
 		aggregatorClient: m.aggregatorClient,
 		keyManager:       testKeyManager,
-		graffiti:         []byte{},
 		attLogs:          make(map[[32]byte]*attSubmitted),
 	}
 
@@ -187,6 +193,79 @@ func TestProposeBlock_BlocksDoubleProposal_After54KEpochs(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Tried to sign a double proposal")
 }
 
+func TestProposeBlock_SharedDB_RecordsProposalBeforeSigning(t *testing.T) {
+	cfg := &featureconfig.Flags{
+		ProtectProposer: true,
+	}
+	featureconfig.Init(cfg)
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	// The history of the key is shared with another validator client.
+	validator.db = ha.NewProtectionDB(ha.NewMemoryKV(), "memory")
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Times(2).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Times(2).Return(&ethpb.BeaconBlock{Body: &ethpb.BeaconBlockBody{}}, nil /*err*/)
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	m.validatorClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
+	).Return(nil /*response*/, errors.New("uh oh"))
+
+	// The block is signed, but the client fails before broadcasting it.
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().SlotsPerEpoch*5+2, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Failed to propose block")
+	testutil.AssertLogsDoNotContain(t, hook, "Tried to sign a double proposal")
+
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().SlotsPerEpoch*5+2, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Tried to sign a double proposal")
+}
+
+func TestProposeBlock_SharedDB_StandbyDoesNotRecordProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	shared := ha.NewProtectionDB(ha.NewMemoryKV(), "memory")
+	validator.db = shared
+	// The elector of the client has not been elected.
+	validator.elector = ha.NewKVElector(ha.NewMemoryKV(), "standby", time.Minute)
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&ethpb.BeaconBlock{Body: &ethpb.BeaconBlockBody{}}, nil /*err*/)
+
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().SlotsPerEpoch*5+2, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Standing by for the leader")
+
+	history, err := shared.ProposalHistory(context.Background(), validatorPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HasProposedForEpoch(history, 5) {
+		t.Error("Expected the proposal not to be recorded while standing by")
+	}
+}
+
 func TestProposeBlock_AllowsPastProposals(t *testing.T) {
 	cfg := &featureconfig.Flags{
 		ProtectProposer: true,
@@ -257,7 +336,12 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
 
-	validator.graffiti = []byte("12345678901234567890123456789012")
+	wanted := "12345678901234567890123456789012"
+	source, err := graffiti.NewSource("", wanted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator.graffitiSource = source
 
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
@@ -267,7 +351,9 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	m.validatorClient.EXPECT().GetBlock(
 		gomock.Any(), // ctx
 		gomock.Any(),
-	).Return(&ethpb.BeaconBlock{Body: &ethpb.BeaconBlockBody{Graffiti: validator.graffiti}}, nil /*err*/)
+	).DoAndReturn(func(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		return &ethpb.BeaconBlock{Body: &ethpb.BeaconBlockBody{Graffiti: req.Graffiti}}, nil
+	})
 
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
@@ -286,8 +372,54 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 
 	validator.ProposeBlock(context.Background(), 1, validatorPubKey)
 
-	if string(sentBlock.Block.Body.Graffiti) != string(validator.graffiti) {
-		t.Errorf("Block was broadcast with the wrong graffiti field, wanted \"%v\", got \"%v\"", string(validator.graffiti), string(sentBlock.Block.Body.Graffiti))
+	if string(sentBlock.Block.Body.Graffiti) != wanted {
+		t.Errorf("Block was broadcast with the wrong graffiti field, wanted \"%v\", got \"%v\"", wanted, string(sentBlock.Block.Body.Graffiti))
+	}
+}
+
+func TestProposeBlock_RequestsGraffitiOfKey(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	graffitiFile := filepath.Join(testutil.TempDir(), "graffiti.yaml")
+	config := fmt.Sprintf("default: other\nkeys:\n  \"%#x\": \"validator {{.ValidatorIndex}} at {{.Slot}}\"\n", validatorPubKey)
+	if err := ioutil.WriteFile(graffitiFile, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(graffitiFile)
+	source, err := graffiti.NewSource(graffitiFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator.graffitiSource = source
+	validator.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:      validatorPubKey[:],
+				ValidatorIndex: 7,
+				ProposerSlot:   5,
+			},
+		},
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	var request *ethpb.BlockRequest
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		request = req
+		return nil, errors.New("uh oh")
+	})
+
+	validator.ProposeBlock(context.Background(), 5, validatorPubKey)
+
+	if string(request.Graffiti) != "validator 7 at 5" {
+		t.Errorf("Requested block with graffiti %q, wanted %q", request.Graffiti, "validator 7 at 5")
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/ha"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
@@ -373,6 +374,51 @@ func TestWaitActivation_NotAllValidatorsActivatedOK(t *testing.T) {
 	}
 }
 
+func TestWaitActivation_ValidatingKeysChanged(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	km := keymanager.NewDirect([]*bls.SecretKey{bls.RandKey()})
+	v := validator{
+		keyManager:      km,
+		validatorClient: client,
+		genesisTime:     1,
+	}
+	oldKeys := publicKeys(km)
+	pendingStream := internal.NewMockBeaconNodeValidator_WaitForActivationClient(ctrl)
+	client.EXPECT().WaitForActivation(
+		gomock.Any(),
+		&ethpb.ValidatorActivationRequest{PublicKeys: oldKeys},
+	).Return(pendingStream, nil)
+	pendingStream.EXPECT().Recv().DoAndReturn(func() (*ethpb.ValidatorActivationResponse, error) {
+		// A key is added while the key set is pending activation.
+		if _, err := km.AddKey(bls.RandKey()); err != nil {
+			t.Fatal(err)
+		}
+		return generateMockStatusResponse(oldKeys), nil
+	})
+
+	activeStream := internal.NewMockBeaconNodeValidator_WaitForActivationClient(ctrl)
+	client.EXPECT().WaitForActivation(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(activeStream, nil)
+	resp := generateMockStatusResponse(publicKeys(km))
+	resp.Statuses[1].Status.Status = ethpb.ValidatorStatus_ACTIVE
+	activeStream.EXPECT().Recv().Return(resp, nil)
+
+	if err := v.WaitForActivation(context.Background()); err != nil {
+		t.Errorf("Could not wait for activation: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Validating keys changed")
+	testutil.AssertLogsContain(t, hook, "Validator activated")
+	if len(v.knownKeys) != 2 {
+		t.Errorf("Expected 2 known keys, received %d", len(v.knownKeys))
+	}
+}
+
 func TestWaitSync_ContextCanceled(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -563,6 +609,59 @@ func TestUpdateDuties_OK(t *testing.T) {
 	}
 }
 
+func TestUpdateDuties_PicksUpValidatingKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconNodeValidatorClient(ctrl)
+
+	removedKey := bls.RandKey()
+	km := keymanager.NewDirect([]*bls.SecretKey{bls.RandKey(), removedKey})
+	v := validator{
+		keyManager:      km,
+		validatorClient: client,
+	}
+	keys, _ := km.FetchValidatingKeys()
+	v.setKnownKeys(keys)
+	addedPubKey, err := km.AddKey(bls.RandKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := km.RemoveKey(bytesutil.ToBytes48(removedKey.PublicKey().Marshal())); err != nil {
+		t.Fatal(err)
+	}
+
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DutiesResponse{}, nil)
+	// The activation of the added key is awaited in the background, until the test ends.
+	ctx, cancel := context.WithCancel(context.Background())
+	activated := make(chan bool)
+	stream := internal.NewMockBeaconNodeValidator_WaitForActivationClient(ctrl)
+	client.EXPECT().WaitForActivation(
+		gomock.Any(),
+		&ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{addedPubKey[:]}},
+	).Return(stream, nil)
+	resp := generateMockStatusResponse([][]byte{addedPubKey[:]})
+	resp.Statuses[0].Status.Status = ethpb.ValidatorStatus_ACTIVE
+	stream.EXPECT().Recv().DoAndReturn(func() (*ethpb.ValidatorActivationResponse, error) {
+		defer close(activated)
+		return resp, nil
+	})
+
+	if err := v.UpdateDuties(ctx, params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	<-activated
+	cancel()
+	if !v.knownKeys[addedPubKey] || len(v.knownKeys) != 2 {
+		t.Errorf("Unexpected known keys %v", v.knownKeys)
+	}
+	testutil.AssertLogsContain(t, hook, "Validating key added")
+	testutil.AssertLogsContain(t, hook, "Validating key removed")
+}
+
 func TestRolesAt_OK(t *testing.T) {
 	v, m, finish := setup(t)
 	defer finish()
@@ -685,3 +784,52 @@ func TestRolesAt_DoesNotAssignProposer_Slot0(t *testing.T) {
 		t.Errorf("Unexpected validator role. want: UNKNOWN")
 	}
 }
+
+func TestRolesAt_SkipsPausedKeys(t *testing.T) {
+	v, _, finish := setup(t)
+	defer finish()
+
+	active := bls.RandKey()
+	paused := bls.RandKey()
+	v.pausedKeys = newKeySet()
+	v.pausedKeys.add(bytesutil.ToBytes48(paused.PublicKey().Marshal()))
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{ProposerSlot: 1, PublicKey: active.PublicKey().Marshal()},
+			{ProposerSlot: 1, PublicKey: paused.PublicKey().Marshal()},
+		},
+	}
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roles := roleMap[bytesutil.ToBytes48(active.PublicKey().Marshal())]; len(roles) != 1 || roles[0] != pb.ValidatorRole_PROPOSER {
+		t.Errorf("Expected the active key to propose, received %v", roles)
+	}
+	if roles, ok := roleMap[bytesutil.ToBytes48(paused.PublicKey().Marshal())]; ok {
+		t.Errorf("Expected no roles for the paused key, received %v", roles)
+	}
+}
+
+func TestRolesAt_NoRolesOnStandby(t *testing.T) {
+	v, _, finish := setup(t)
+	defer finish()
+
+	key := bls.RandKey()
+	// The elector of the client has not been elected.
+	v.elector = ha.NewKVElector(ha.NewMemoryKV(), "standby", time.Minute)
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{ProposerSlot: 1, AttesterSlot: 1, PublicKey: key.PublicKey().Marshal()},
+		},
+	}
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roleMap) != 0 {
+		t.Errorf("Expected no roles while standing by, received %v", roleMap)
+	}
+}
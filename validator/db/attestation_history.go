@@ -10,7 +10,8 @@ import (
 	"go.opencensus.io/trace"
 )
 
-func unmarshalAttestationHistory(enc []byte) (*slashpb.AttestationHistory, error) {
+// UnmarshalAttestationHistory decodes an encoded attestation history.
+func UnmarshalAttestationHistory(enc []byte) (*slashpb.AttestationHistory, error) {
 	history := &slashpb.AttestationHistory{}
 	err := proto.Unmarshal(enc, history)
 	if err != nil {
@@ -33,7 +34,7 @@ func (db *Store) AttestationHistory(ctx context.Context, publicKey []byte) (*sla
 		if enc == nil {
 			return nil
 		}
-		attestationHistory, err = unmarshalAttestationHistory(enc)
+		attestationHistory, err = UnmarshalAttestationHistory(enc)
 		return err
 	})
 	return attestationHistory, err
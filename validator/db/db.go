@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
@@ -88,44 +89,128 @@ func NewKVStore(dirPath string, pubkeys [][48]byte) (*Store, error) {
 			tx,
 			historicProposalsBucket,
 			historicAttestationsBucket,
+			performanceBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
 
-	// Initialize the required pubkeys into the DB to ensure they're not empty.
+	if err := kv.InitializeHistories(context.Background(), pubkeys); err != nil {
+		return nil, err
+	}
+
+	return kv, err
+}
+
+// InitializeHistories of the public keys which have no proposal or attestation history yet,
+// to ensure they're not empty.
+func (db *Store) InitializeHistories(ctx context.Context, pubkeys [][48]byte) error {
+	return InitializeEmptyHistories(ctx, db, pubkeys)
+}
+
+// InitializeEmptyHistories of the public keys which have no proposal or attestation history yet
+// in the validator database.
+func InitializeEmptyHistories(ctx context.Context, db iface.ValidatorDB, pubkeys [][48]byte) error {
 	for _, pubkey := range pubkeys {
-		proHistory, err := kv.ProposalHistory(context.Background(), pubkey[:])
+		proHistory, err := db.ProposalHistory(ctx, pubkey[:])
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if proHistory == nil {
-			cleanHistory := &slashpb.ProposalHistory{
-				EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
-			}
-			if err := kv.SaveProposalHistory(context.Background(), pubkey[:], cleanHistory); err != nil {
-				return nil, err
+			if err := db.SaveProposalHistory(ctx, pubkey[:], NewProposalHistory()); err != nil {
+				return err
 			}
 		}
 
-		attHistory, err := kv.AttestationHistory(context.Background(), pubkey[:])
+		attHistory, err := db.AttestationHistory(ctx, pubkey[:])
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if attHistory == nil {
-			newMap := make(map[uint64]uint64)
-			newMap[0] = params.BeaconConfig().FarFutureEpoch
-			cleanHistory := &slashpb.AttestationHistory{
-				TargetToSource: newMap,
-			}
-			if err := kv.SaveAttestationHistory(context.Background(), pubkey[:], cleanHistory); err != nil {
-				return nil, err
+			if err := db.SaveAttestationHistory(ctx, pubkey[:], NewAttestationHistory()); err != nil {
+				return err
 			}
 		}
+	}
+	return nil
+}
 
+// InitializeSharedHistories of the public keys which have no proposal or attestation history yet
+// in the validator database shared with other validator clients. The histories are initialized
+// atomically, so that the histories recorded meanwhile by another client are kept.
+func InitializeSharedHistories(ctx context.Context, db iface.HistoryUpdater, pubkeys [][48]byte) error {
+	for _, pubkey := range pubkeys {
+		// Updates start from an initialized history if there is none.
+		if err := db.UpdateProposalHistory(ctx, pubkey[:], func(history *slashpb.ProposalHistory) (*slashpb.ProposalHistory, error) {
+			return history, nil
+		}); err != nil {
+			return err
+		}
+		if err := db.UpdateAttestationHistory(ctx, pubkey[:], func(history *slashpb.AttestationHistory) (*slashpb.AttestationHistory, error) {
+			return history, nil
+		}); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return kv, err
+// NewProposalHistory returns the history of a key which never proposed.
+func NewProposalHistory() *slashpb.ProposalHistory {
+	return &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+}
+
+// NewAttestationHistory returns the history of a key which never attested.
+func NewAttestationHistory() *slashpb.AttestationHistory {
+	newMap := make(map[uint64]uint64)
+	newMap[0] = params.BeaconConfig().FarFutureEpoch
+	return &slashpb.AttestationHistory{
+		TargetToSource: newMap,
+	}
+}
+
+// UpdateEncodedProposalHistory applies the update to the encoded proposal history, a new history
+// if enc is nil, and returns the encoding of the updated history.
+func UpdateEncodedProposalHistory(
+	enc []byte,
+	update func(*slashpb.ProposalHistory) (*slashpb.ProposalHistory, error),
+) ([]byte, error) {
+	history := NewProposalHistory()
+	if enc != nil {
+		var err error
+		history, err = UnmarshalProposalHistory(enc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	history, err := update(history)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(history)
+}
+
+// UpdateEncodedAttestationHistory applies the update to the encoded attestation history, a new
+// history if enc is nil, and returns the encoding of the updated history.
+func UpdateEncodedAttestationHistory(
+	enc []byte,
+	update func(*slashpb.AttestationHistory) (*slashpb.AttestationHistory, error),
+) ([]byte, error) {
+	history := NewAttestationHistory()
+	if enc != nil {
+		var err error
+		history, err = UnmarshalAttestationHistory(enc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	history, err := update(history)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(history)
 }
 
 // Size returns the db size in bytes.
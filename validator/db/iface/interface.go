@@ -13,6 +13,7 @@ type ValidatorDB interface {
 	io.Closer
 	DatabasePath() string
 	ClearDB() error
+	InitializeHistories(ctx context.Context, publicKeys [][48]byte) error
 	// Proposer protection related methods.
 	ProposalHistory(ctx context.Context, publicKey []byte) (*slashpb.ProposalHistory, error)
 	SaveProposalHistory(ctx context.Context, publicKey []byte, history *slashpb.ProposalHistory) error
@@ -22,3 +23,18 @@ type ValidatorDB interface {
 	SaveAttestationHistory(ctx context.Context, publicKey []byte, history *slashpb.AttestationHistory) error
 	DeleteAttestationHistory(ctx context.Context, publicKey []byte) error
 }
+
+// HistoryUpdater is implemented by the validator databases shared by several validator clients. They
+// check and update the history of a public key atomically, so that no other client records a
+// conflicting message of the key meanwhile. The update returns the updated history, or an error to
+// leave the history unchanged.
+type HistoryUpdater interface {
+	UpdateProposalHistory(ctx context.Context, publicKey []byte, update func(*slashpb.ProposalHistory) (*slashpb.ProposalHistory, error)) error
+	UpdateAttestationHistory(ctx context.Context, publicKey []byte, update func(*slashpb.AttestationHistory) (*slashpb.AttestationHistory, error)) error
+}
+
+// SharedValidatorDB is a validator database shared by several validator clients.
+type SharedValidatorDB interface {
+	ValidatorDB
+	HistoryUpdater
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+func unmarshalEpochPerformance(enc []byte) (*dbpb.EpochPerformance, error) {
+	performance := &dbpb.EpochPerformance{}
+	if err := proto.Unmarshal(enc, performance); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	return performance, nil
+}
+
+// epochKey encodes the epoch in big endian, so that the performance of a validator is ordered by
+// epoch.
+func epochKey(epoch uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, epoch)
+	return key
+}
+
+// UpdatePerformance applies the update to the performance of the validator in the epoch within a
+// single transaction, starting from an empty performance if none was recorded for the epoch.
+func (db *Store) UpdatePerformance(ctx context.Context, publicKey []byte, epoch uint64, update func(*dbpb.EpochPerformance)) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.UpdatePerformance")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(performanceBucket).CreateBucketIfNotExists(publicKey)
+		if err != nil {
+			return err
+		}
+		performance := &dbpb.EpochPerformance{Epoch: epoch}
+		if enc := bucket.Get(epochKey(epoch)); enc != nil {
+			performance, err = unmarshalEpochPerformance(enc)
+			if err != nil {
+				return err
+			}
+		}
+		update(performance)
+		enc, err := proto.Marshal(performance)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode performance")
+		}
+		return bucket.Put(epochKey(epoch), enc)
+	})
+}
+
+// Performance returns the recorded performance of the validator in the epochs from start to end
+// inclusive, ordered by epoch.
+func (db *Store) Performance(ctx context.Context, publicKey []byte, start uint64, end uint64) ([]*dbpb.EpochPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.Performance")
+	defer span.End()
+
+	var performances []*dbpb.EpochPerformance
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(performanceBucket).Bucket(publicKey)
+		if bucket == nil {
+			return nil
+		}
+		c := bucket.Cursor()
+		for k, v := c.Seek(epochKey(start)); k != nil && binary.BigEndian.Uint64(k) <= end; k, v = c.Next() {
+			performance, err := unmarshalEpochPerformance(v)
+			if err != nil {
+				return err
+			}
+			performances = append(performances, performance)
+		}
+		return nil
+	})
+	return performances, err
+}
+
+// PerformanceKeys returns the public keys of the validators with recorded performance.
+func (db *Store) PerformanceKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PerformanceKeys")
+	defer span.End()
+
+	var keys [][48]byte
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(performanceBucket).ForEach(func(k, v []byte) error {
+			keys = append(keys, bytesutil.ToBytes48(k))
+			return nil
+		})
+	})
+	return keys, err
+}
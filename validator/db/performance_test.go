@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+)
+
+func TestPerformance_UpdateAndRead(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+
+	for _, epoch := range []uint64{300, 2, 5} {
+		epoch := epoch
+		if err := db.UpdatePerformance(ctx, pubKey[:], epoch, func(p *dbpb.EpochPerformance) {
+			p.Accounted = true
+			p.BalanceAfter = epoch
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdatePerformance(ctx, pubKey[:], 5, func(p *dbpb.EpochPerformance) {
+		p.Aggregations++
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	performances, err := db.Performance(ctx, pubKey[:], 0, math.MaxUint64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(performances) != 3 || performances[0].Epoch != 2 || performances[1].Epoch != 5 || performances[2].Epoch != 300 {
+		t.Fatalf("Expected the performance of epochs 2, 5 and 300 in order, received %v", performances)
+	}
+	want := &dbpb.EpochPerformance{Epoch: 5, Accounted: true, BalanceAfter: 5, Aggregations: 1}
+	if !proto.Equal(performances[1], want) {
+		t.Errorf("Wanted %v, received %v", want, performances[1])
+	}
+
+	performances, err = db.Performance(ctx, pubKey[:], 3, 299)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(performances) != 1 || performances[0].Epoch != 5 {
+		t.Errorf("Expected the performance of epoch 5, received %v", performances)
+	}
+
+	keys, err := db.PerformanceKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != pubKey {
+		t.Errorf("Expected the key with recorded performance, received %#x", keys)
+	}
+}
+
+func TestPerformance_UnknownKey(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	performances, err := db.Performance(context.Background(), []byte{1, 2, 3}, 0, math.MaxUint64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(performances) != 0 {
+		t.Errorf("Expected no performance, received %v", performances)
+	}
+}
@@ -10,7 +10,8 @@ import (
 	"go.opencensus.io/trace"
 )
 
-func unmarshalProposalHistory(enc []byte) (*slashpb.ProposalHistory, error) {
+// UnmarshalProposalHistory decodes an encoded proposal history.
+func UnmarshalProposalHistory(enc []byte) (*slashpb.ProposalHistory, error) {
 	history := &slashpb.ProposalHistory{}
 	err := proto.Unmarshal(enc, history)
 	if err != nil {
@@ -33,7 +34,7 @@ func (db *Store) ProposalHistory(ctx context.Context, publicKey []byte) (*slashp
 		if enc == nil {
 			return nil
 		}
-		proposalHistory, err = unmarshalProposalHistory(enc)
+		proposalHistory, err = UnmarshalProposalHistory(enc)
 		return err
 	})
 	return proposalHistory, err
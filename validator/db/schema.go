@@ -5,4 +5,6 @@ var (
 	historicProposalsBucket = []byte("proposal-history-bucket")
 	// Validator slashing protection from slashable attestations.
 	historicAttestationsBucket = []byte("attestation-history-bucket")
+	// Validator performance in each epoch, in a nested bucket for each public key.
+	performanceBucket = []byte("performance-bucket")
 )
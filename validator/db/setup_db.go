@@ -7,6 +7,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
 )
 
 // SetupDB instantiates and returns a DB instance for the validator client.
@@ -38,7 +40,7 @@ func TempDir() string {
 }
 
 // TeardownDB cleans up a test DB instance.
-func TeardownDB(t testing.TB, db *Store) {
+func TeardownDB(t testing.TB, db iface.ValidatorDB) {
 	if err := db.Close(); err != nil {
 		t.Fatalf("Failed to close database: %v", err)
 	}
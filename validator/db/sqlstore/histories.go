@@ -0,0 +1,185 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"go.opencensus.io/trace"
+)
+
+// Columns of the histories table holding the histories.
+const (
+	proposalsColumn    = "proposals"
+	attestationsColumn = "attestations"
+)
+
+// InitializeHistories of the public keys which have no proposal or attestation history yet.
+func (s *Store) InitializeHistories(ctx context.Context, publicKeys [][48]byte) error {
+	return db.InitializeSharedHistories(ctx, s, publicKeys)
+}
+
+// ProposalHistory of the public key, nil if there is none.
+func (s *Store) ProposalHistory(ctx context.Context, publicKey []byte) (*slashpb.ProposalHistory, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.ProposalHistory")
+	defer span.End()
+
+	enc, err := s.get(ctx, s.db, proposalsColumn, publicKey, false)
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	return db.UnmarshalProposalHistory(enc)
+}
+
+// SaveProposalHistory of the public key.
+func (s *Store) SaveProposalHistory(ctx context.Context, publicKey []byte, history *slashpb.ProposalHistory) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.SaveProposalHistory")
+	defer span.End()
+
+	enc, err := proto.Marshal(history)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode proposal history")
+	}
+	return s.put(ctx, proposalsColumn, publicKey, enc)
+}
+
+// DeleteProposalHistory of the public key.
+func (s *Store) DeleteProposalHistory(ctx context.Context, publicKey []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.DeleteProposalHistory")
+	defer span.End()
+
+	return s.put(ctx, proposalsColumn, publicKey, nil)
+}
+
+// UpdateProposalHistory of the public key in a transaction holding a lock on the key.
+func (s *Store) UpdateProposalHistory(
+	ctx context.Context,
+	publicKey []byte,
+	update func(*slashpb.ProposalHistory) (*slashpb.ProposalHistory, error),
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.UpdateProposalHistory")
+	defer span.End()
+
+	return s.update(ctx, proposalsColumn, publicKey, func(enc []byte) ([]byte, error) {
+		return db.UpdateEncodedProposalHistory(enc, update)
+	})
+}
+
+// AttestationHistory of the public key, nil if there is none.
+func (s *Store) AttestationHistory(ctx context.Context, publicKey []byte) (*slashpb.AttestationHistory, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.AttestationHistory")
+	defer span.End()
+
+	enc, err := s.get(ctx, s.db, attestationsColumn, publicKey, false)
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	return db.UnmarshalAttestationHistory(enc)
+}
+
+// SaveAttestationHistory of the public key.
+func (s *Store) SaveAttestationHistory(ctx context.Context, publicKey []byte, history *slashpb.AttestationHistory) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.SaveAttestationHistory")
+	defer span.End()
+
+	enc, err := proto.Marshal(history)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode attestation history")
+	}
+	return s.put(ctx, attestationsColumn, publicKey, enc)
+}
+
+// DeleteAttestationHistory of the public key.
+func (s *Store) DeleteAttestationHistory(ctx context.Context, publicKey []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.DeleteAttestationHistory")
+	defer span.End()
+
+	return s.put(ctx, attestationsColumn, publicKey, nil)
+}
+
+// UpdateAttestationHistory of the public key in a transaction holding a lock on the key.
+func (s *Store) UpdateAttestationHistory(
+	ctx context.Context,
+	publicKey []byte,
+	update func(*slashpb.AttestationHistory) (*slashpb.AttestationHistory, error),
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SQL.UpdateAttestationHistory")
+	defer span.End()
+
+	return s.update(ctx, attestationsColumn, publicKey, func(enc []byte) ([]byte, error) {
+		return db.UpdateEncodedAttestationHistory(enc, update)
+	})
+}
+
+// querier is a database or a transaction.
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// get the encoded history of the column of the public key, nil if there is none. The row of the
+// key is locked until the end of the transaction if lock is set.
+func (s *Store) get(ctx context.Context, q querier, column string, publicKey []byte, lock bool) ([]byte, error) {
+	query := "SELECT " + column + " FROM histories WHERE public_key = $1"
+	if lock {
+		query += s.dialect.forUpdate
+	}
+	var enc []byte
+	err := q.QueryRowContext(ctx, query, publicKey).Scan(&enc)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get history")
+	}
+	return enc, nil
+}
+
+// put the encoded history of the column of the public key, deleting it if nil.
+func (s *Store) put(ctx context.Context, column string, publicKey []byte, enc []byte) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		"INSERT INTO histories (public_key, "+column+") VALUES ($1, $2) "+
+			"ON CONFLICT (public_key) DO UPDATE SET "+column+" = excluded."+column,
+		publicKey,
+		enc,
+	)
+	return errors.Wrap(err, "could not save history")
+}
+
+// update the encoded history of the column of the public key in a transaction, holding a lock
+// on the row of the key from its read to its write.
+func (s *Store) update(ctx context.Context, column string, publicKey []byte, update func([]byte) ([]byte, error)) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not start transaction")
+	}
+	defer func() {
+		// Rolling back a committed transaction does nothing.
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.WithError(err).Error("Could not roll back transaction")
+		}
+	}()
+	// The row of the key is created if missing, so that there is a row to lock.
+	if _, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO histories (public_key) VALUES ($1) ON CONFLICT (public_key) DO NOTHING",
+		publicKey,
+	); err != nil {
+		return errors.Wrap(err, "could not create history")
+	}
+	enc, err := s.get(ctx, tx, column, publicKey, true)
+	if err != nil {
+		return err
+	}
+	enc, err = update(enc)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE histories SET "+column+" = $1 WHERE public_key = $2", enc, publicKey); err != nil {
+		return errors.Wrap(err, "could not save history")
+	}
+	return errors.Wrap(tx.Commit(), "could not commit transaction")
+}
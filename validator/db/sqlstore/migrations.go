@@ -0,0 +1,69 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// migrations of the schema of the database. The version of a database is the number of
+// migrations applied to it. Migrations are only ever appended.
+var migrations = []string{
+	// 1: Proposal and attestation histories of each public key, encoded as protobuf messages.
+	`CREATE TABLE histories (
+		public_key BYTEA PRIMARY KEY,
+		proposals BYTEA,
+		attestations BYTEA
+	)`,
+}
+
+// migrate the schema of the database to the latest version, in a transaction.
+func (s *Store) migrate(ctx context.Context) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not start migration")
+	}
+	defer func() {
+		// Rolling back a committed transaction does nothing.
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.WithError(err).Error("Could not roll back migration")
+		}
+	}()
+	// The lock is taken before the schema migrations table is created, as concurrent creations of
+	// a table may fail in Postgres, even if it did not exist.
+	if s.dialect.lockMigrations != "" {
+		if _, err := tx.ExecContext(ctx, s.dialect.lockMigrations); err != nil {
+			return errors.Wrap(err, "could not lock migrations")
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)"); err != nil {
+		return errors.Wrap(err, "could not create schema migrations table")
+	}
+	var version int
+	if err := tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return errors.Wrap(err, "could not get schema version")
+	}
+	if version > len(migrations) {
+		return errors.Errorf("schema version %d is more recent than the latest known version %d", version, len(migrations))
+	}
+	for i := version; i < len(migrations); i++ {
+		if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+			return errors.Wrapf(err, "could not migrate schema to version %d", i+1)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", i+1); err != nil {
+			return errors.Wrapf(err, "could not migrate schema to version %d", i+1)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "could not commit migration")
+	}
+	if version < len(migrations) {
+		log.WithFields(logrus.Fields{
+			"from": version,
+			"to":   len(migrations),
+		}).Info("Migrated schema of the validator database")
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package sqlstore
+
+// The tests run against an embedded SQLite database, which is not a supported driver otherwise.
+func init() {
+	// SQLite locks the whole database in write transactions, and an in-memory database is only
+	// seen by the connection which created it.
+	dialects["sqlite3"] = &dialect{
+		maxOpenConns: 1,
+	}
+}
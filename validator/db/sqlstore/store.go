@@ -0,0 +1,116 @@
+// Package sqlstore implements the validator database in a SQL database, so that the slashing
+// protection histories of the validating keys of many validator clients are kept in a single
+// database, such as a Postgres database. The history of a key is checked and updated in a
+// transaction holding a lock on the key.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	// Registers the Postgres driver.
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "sqlstore")
+
+var _ = iface.ValidatorDB(&Store{})
+var _ = iface.HistoryUpdater(&Store{})
+
+// Postgres is the name of the driver of Postgres databases.
+const Postgres = "postgres"
+
+// migrationsLockID identifies the advisory lock of the migrations in Postgres databases.
+const migrationsLockID = 0x70727973
+
+// dialect of SQL of a driver.
+type dialect struct {
+	// forUpdate is appended to the selections in a transaction, to lock the selected rows until
+	// the end of the transaction.
+	forUpdate string
+	// lockMigrations is executed at the start of the migration of the database, to hold a lock
+	// until the end of its transaction, so that clients sharing the database do not migrate it
+	// concurrently.
+	lockMigrations string
+	// maxOpenConns to the database, 0 for no limit.
+	maxOpenConns int
+}
+
+// dialects of the supported drivers.
+var dialects = map[string]*dialect{
+	Postgres: {
+		forUpdate:      " FOR UPDATE",
+		lockMigrations: fmt.Sprintf("SELECT pg_advisory_xact_lock(%d)", migrationsLockID),
+	},
+}
+
+// Store is the validator database stored in a SQL database.
+type Store struct {
+	db      *sql.DB
+	dialect *dialect
+	path    string
+}
+
+// NewStore opens the SQL database of the driver at the data source name, migrates its schema to
+// the latest version, and initializes the histories of the public keys.
+func NewStore(driverName string, dataSourceName string, pubkeys [][48]byte) (*Store, error) {
+	d, ok := dialects[driverName]
+	if !ok {
+		return nil, errors.Errorf("unsupported SQL driver %q", driverName)
+	}
+	sqlDB, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open SQL database")
+	}
+	if d.maxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(d.maxOpenConns)
+	}
+	s := &Store{db: sqlDB, dialect: d, path: redact(driverName, dataSourceName)}
+	ctx := context.Background()
+	if err := s.migrate(ctx); err != nil {
+		if err := sqlDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close SQL database")
+		}
+		return nil, err
+	}
+	if err := s.InitializeHistories(ctx, pubkeys); err != nil {
+		if err := sqlDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close SQL database")
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close the connections to the SQL database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// DatabasePath of the SQL database, without credentials.
+func (s *Store) DatabasePath() string {
+	return s.path
+}
+
+// ClearDB deletes the histories of all the keys.
+func (s *Store) ClearDB() error {
+	_, err := s.db.Exec("DELETE FROM histories")
+	return errors.Wrap(err, "could not clear histories")
+}
+
+// redact the password from the data source name, if it is a URL.
+func redact(driverName string, dataSourceName string) string {
+	u, err := url.Parse(dataSourceName)
+	if err != nil || u.Scheme == "" {
+		return driverName
+	}
+	if u.User != nil {
+		u.User = url.User(u.User.Username())
+	}
+	return u.String()
+}
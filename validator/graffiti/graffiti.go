@@ -0,0 +1,351 @@
+// Package graffiti selects the graffiti of the blocks proposed by each validating key, from a
+// file mapping public keys and groups of public keys to graffiti templates.
+//
+// The file is YAML or JSON:
+//
+//	default: "{{.Version}}"
+//	keys:
+//	  "0xa99a...": "validator {{.ValidatorIndex}}"
+//	groups:
+//	  - name: customer-1
+//	    graffiti: "customer-1"
+//	    keys: ["0xb0b1...", "0xb2b3..."]
+//
+// The graffiti of a key is its own, or else the one of its group, or else the default one, or
+// else the graffiti given on the command line. Graffiti are Go templates executed with Data,
+// and truncated to 32 bytes.
+package graffiti
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+var log = logrus.WithField("prefix", "graffiti")
+
+// ReloadInterval is the interval at which the graffiti file is checked for changes.
+const ReloadInterval = 10 * time.Second
+
+// maxLength is the length of the graffiti of a block.
+const maxLength = 32
+
+// Origins of the graffiti of a key.
+const (
+	OriginKey     = "key"
+	OriginGroup   = "group"
+	OriginDefault = "default"
+	OriginFlag    = "flag"
+)
+
+// Config of the graffiti file.
+type Config struct {
+	// Default graffiti of the keys without graffiti of their own or of their group.
+	Default string `yaml:"default,omitempty" json:"default,omitempty"`
+	// Keys maps hex encoded public keys to their graffiti.
+	Keys map[string]string `yaml:"keys,omitempty" json:"keys,omitempty"`
+	// Groups of public keys sharing a graffiti.
+	Groups []*Group `yaml:"groups,omitempty" json:"groups,omitempty"`
+}
+
+// Group of public keys sharing a graffiti.
+type Group struct {
+	Name     string   `yaml:"name" json:"name"`
+	Graffiti string   `yaml:"graffiti" json:"graffiti"`
+	Keys     []string `yaml:"keys" json:"keys"`
+}
+
+// Data is the data graffiti templates are executed with.
+type Data struct {
+	// Version of the validator client.
+	Version string
+	// ValidatorIndex of the proposer.
+	ValidatorIndex uint64
+	// PublicKey of the proposer, hex encoded and truncated.
+	PublicKey string
+	// Slot of the proposed block.
+	Slot uint64
+	// Epoch of the proposed block.
+	Epoch uint64
+}
+
+// entry is the graffiti template of a key, with where it was configured.
+type entry struct {
+	text   string
+	origin string
+	tmpl   *template.Template
+}
+
+// Source selects the graffiti of the validating keys from a graffiti file, reloading it when it
+// changes. It is safe for concurrent use.
+type Source struct {
+	path     string
+	fallback *entry
+	config   *Config
+	keys     map[[48]byte]*entry
+	def      *entry
+	modTime  time.Time
+	lock     sync.RWMutex
+}
+
+// NewSource loads the graffiti file at the path, if any. The fallback graffiti is used for the
+// keys the file gives no graffiti for.
+func NewSource(path string, fallback string) (*Source, error) {
+	fallbackEntry, err := newEntry(fallback, OriginFlag)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid graffiti")
+	}
+	s := &Source{
+		path:     path,
+		fallback: fallbackEntry,
+		config:   &Config{},
+		keys:     make(map[[48]byte]*entry),
+	}
+	if path == "" {
+		return s, nil
+	}
+	if err := s.reload(true); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Graffiti of the key, executed with the data and truncated to 32 bytes.
+func (s *Source) Graffiti(pubKey [48]byte, data *Data) ([]byte, error) {
+	if s == nil {
+		return nil, nil
+	}
+	e := s.entry(pubKey)
+	if e.tmpl == nil {
+		return truncate([]byte(e.text)), nil
+	}
+	var buf bytes.Buffer
+	if err := e.tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrapf(err, "could not execute graffiti template %q", e.text)
+	}
+	return truncate(buf.Bytes()), nil
+}
+
+// Template returns the graffiti template of the key, and where it was configured: for the key,
+// for its group, as default, or on the command line.
+func (s *Source) Template(pubKey [48]byte) (string, string) {
+	if s == nil {
+		return "", ""
+	}
+	e := s.entry(pubKey)
+	return e.text, e.origin
+}
+
+// Set the graffiti of the key, saving it to the graffiti file if there is one. An empty graffiti
+// removes the graffiti of the key.
+func (s *Source) Set(pubKey [48]byte, graffiti string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	config := *s.config
+	config.Keys = make(map[string]string, len(s.config.Keys)+1)
+	for key, graffiti := range s.config.Keys {
+		if k, err := parsePublicKey(key); err == nil && k == pubKey {
+			continue
+		}
+		config.Keys[key] = graffiti
+	}
+	if graffiti != "" {
+		config.Keys[fmt.Sprintf("%#x", pubKey)] = graffiti
+	}
+	keys, def, err := compile(&config)
+	if err != nil {
+		return err
+	}
+	if s.path != "" {
+		if err := s.save(&config); err != nil {
+			return err
+		}
+	}
+	s.config = &config
+	s.keys = keys
+	s.def = def
+	return nil
+}
+
+// Watch the graffiti file for changes at every interval, until the context is canceled.
+func (s *Source) Watch(ctx context.Context, interval time.Duration) {
+	if s.path == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.reload(false); err != nil {
+				log.WithError(err).Warn("Could not reload graffiti file, keeping the previous graffiti")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Source) entry(pubKey [48]byte) *entry {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if e, ok := s.keys[pubKey]; ok {
+		return e
+	}
+	if s.def != nil {
+		return s.def
+	}
+	return s.fallback
+}
+
+// reload loads the graffiti file if it changed since it was last loaded, or if forced.
+func (s *Source) reload(force bool) error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return errors.Wrap(err, "could not read graffiti file")
+	}
+	s.lock.RLock()
+	unchanged := info.ModTime().Equal(s.modTime)
+	s.lock.RUnlock()
+	if unchanged && !force {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return errors.Wrap(err, "could not read graffiti file")
+	}
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return errors.Wrap(err, "could not parse graffiti file")
+	}
+	keys, def, err := compile(config)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.config = config
+	s.keys = keys
+	s.def = def
+	s.modTime = info.ModTime()
+	log.WithFields(logrus.Fields{
+		"path": s.path,
+		"keys": len(keys),
+	}).Info("Loaded graffiti file")
+	return nil
+}
+
+// save writes the config to the graffiti file, as JSON if the file has a .json extension and as
+// YAML otherwise.
+func (s *Source) save(config *Config) error {
+	var data []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(s.path), ".json") {
+		data, err = json.MarshalIndent(config, "", "  ")
+	} else {
+		data, err = yaml.Marshal(config)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not encode graffiti file")
+	}
+	tmpPath := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return errors.Wrap(err, "could not write graffiti file")
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return errors.Wrap(err, "could not write graffiti file")
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime = info.ModTime()
+	}
+	return nil
+}
+
+// compile parses the templates of the config, returning the graffiti of each key and the default
+// graffiti.
+func compile(config *Config) (map[[48]byte]*entry, *entry, error) {
+	keys := make(map[[48]byte]*entry)
+	for _, group := range config.Groups {
+		e, err := newEntry(group.Graffiti, OriginGroup+":"+group.Name)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid graffiti of group %q", group.Name)
+		}
+		for _, key := range group.Keys {
+			pubKey, err := parsePublicKey(key)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "invalid key of group %q", group.Name)
+			}
+			if other, ok := keys[pubKey]; ok {
+				return nil, nil, fmt.Errorf("key %s is in groups %q and %q", key, strings.TrimPrefix(other.origin, OriginGroup+":"), group.Name)
+			}
+			keys[pubKey] = e
+		}
+	}
+	for key, graffiti := range config.Keys {
+		pubKey, err := parsePublicKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		e, err := newEntry(graffiti, OriginKey)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid graffiti of key %s", key)
+		}
+		keys[pubKey] = e
+	}
+	var def *entry
+	if config.Default != "" {
+		var err error
+		def, err = newEntry(config.Default, OriginDefault)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "invalid default graffiti")
+		}
+	}
+	return keys, def, nil
+}
+
+// newEntry parses the graffiti template, if it is one.
+func newEntry(text string, origin string) (*entry, error) {
+	e := &entry{text: text, origin: origin}
+	if !strings.Contains(text, "{{") {
+		return e, nil
+	}
+	tmpl, err := template.New("graffiti").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	e.tmpl = tmpl
+	return e, nil
+}
+
+func parsePublicKey(key string) ([48]byte, error) {
+	var pubKey [48]byte
+	b, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil || len(b) != len(pubKey) {
+		return pubKey, fmt.Errorf("invalid public key %q", key)
+	}
+	copy(pubKey[:], b)
+	return pubKey, nil
+}
+
+func truncate(graffiti []byte) []byte {
+	if len(graffiti) > maxLength {
+		return graffiti[:maxLength]
+	}
+	return graffiti
+}
@@ -0,0 +1,181 @@
+package graffiti
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	keyA = [48]byte{1}
+	keyB = [48]byte{2}
+	keyC = [48]byte{3}
+)
+
+func writeFile(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "graffiti")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestSource_Graffiti(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := writeFile(t, dir, "graffiti.yaml", fmt.Sprintf(`
+default: "{{.Version}}"
+keys:
+  "%#x": "validator {{.ValidatorIndex}} at {{.Slot}}"
+groups:
+  - name: customer
+    graffiti: customer
+    keys: ["%#x"]
+`, keyA, keyB))
+
+	s, err := NewSource(path, "flag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &Data{Version: "Prysm/v1", ValidatorIndex: 7, Slot: 100}
+	tests := []struct {
+		pubKey   [48]byte
+		graffiti string
+		origin   string
+	}{
+		{pubKey: keyA, graffiti: "validator 7 at 100", origin: OriginKey},
+		{pubKey: keyB, graffiti: "customer", origin: OriginGroup + ":customer"},
+		{pubKey: keyC, graffiti: "Prysm/v1", origin: OriginDefault},
+	}
+	for _, tt := range tests {
+		graffiti, err := s.Graffiti(tt.pubKey, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(graffiti) != tt.graffiti {
+			t.Errorf("Expected graffiti %q for %#x, received %q", tt.graffiti, tt.pubKey[:1], graffiti)
+		}
+		if _, origin := s.Template(tt.pubKey); origin != tt.origin {
+			t.Errorf("Expected origin %q for %#x, received %q", tt.origin, tt.pubKey[:1], origin)
+		}
+	}
+}
+
+func TestSource_FallbackAndTruncation(t *testing.T) {
+	s, err := NewSource("", strings.Repeat("a", 40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	graffiti, err := s.Graffiti(keyA, &Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graffiti) != 32 {
+		t.Errorf("Expected graffiti truncated to 32 bytes, received %d bytes", len(graffiti))
+	}
+}
+
+func TestSource_InvalidFile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	tests := map[string]string{
+		"unknown field":   `graffit: "typo"`,
+		"invalid key":     `keys: {"0x1234": "short"}`,
+		"bad template":    `default: "{{.Version"`,
+		"key in 2 groups": fmt.Sprintf(`groups: [{name: a, graffiti: a, keys: ["%#x"]}, {name: b, graffiti: b, keys: ["%#x"]}]`, keyA, keyA),
+	}
+	for name, content := range tests {
+		path := writeFile(t, dir, "graffiti.yaml", content)
+		if _, err := NewSource(path, ""); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSource_Reload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := writeFile(t, dir, "graffiti.json", `{"default": "before"}`)
+	s, err := NewSource(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, dir, "graffiti.json", `{"default": "after"}`)
+	// Make the modification time differ on file systems with a coarse resolution.
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.reload(false); err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := s.Template(keyA); text != "after" {
+		t.Errorf("Expected the reloaded graffiti, received %q", text)
+	}
+
+	writeFile(t, dir, "graffiti.json", `{"default": "{{"}`)
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime().Add(2*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.reload(false); err == nil {
+		t.Error("Expected an error reloading an invalid file")
+	}
+	if text, _ := s.Template(keyA); text != "after" {
+		t.Errorf("Expected the previous graffiti to be kept, received %q", text)
+	}
+}
+
+func TestSource_Set(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := writeFile(t, dir, "graffiti.yaml", fmt.Sprintf(`
+groups:
+  - name: customer
+    graffiti: customer
+    keys: ["%#x"]
+`, keyA))
+	s, err := NewSource(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Set(keyA, "own {{.Epoch}}"); err != nil {
+		t.Fatal(err)
+	}
+	if text, origin := s.Template(keyA); text != "own {{.Epoch}}" || origin != OriginKey {
+		t.Errorf("Unexpected graffiti %q from %q", text, origin)
+	}
+	reloaded, err := NewSource(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := reloaded.Template(keyA); text != "own {{.Epoch}}" {
+		t.Errorf("Expected the graffiti to be saved, received %q", text)
+	}
+
+	if err := s.Set(keyA, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, origin := s.Template(keyA); origin != OriginGroup+":customer" {
+		t.Errorf("Expected the graffiti of the group after removing the key's, received %q", origin)
+	}
+	if err := s.Set(keyA, "{{"); err == nil {
+		t.Error("Expected an error setting an invalid template")
+	}
+}
@@ -0,0 +1,110 @@
+package ha
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "ha")
+
+var leaderGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: "validator",
+		Name:      "ha_leader",
+		Help:      "1 if the validator client is the leader of its high-availability group, 0 otherwise.",
+	},
+)
+
+// leaderLock is the key of the lock electing the leader in the KV of a group.
+const leaderLock = "leader"
+
+// Elector elects the leader of a group of validator clients by holding a lock for a lease that it
+// renews regularly.
+type Elector struct {
+	lock   Lock
+	holder string
+	lease  time.Duration
+	// acquiredAt is the time of the start of the last campaign which acquired the lock.
+	acquiredAt time.Time
+	leader     bool
+	mu         sync.RWMutex
+}
+
+// NewElector returns an elector campaigning for the holder, with a lock held for the lease.
+func NewElector(lock Lock, holder string, lease time.Duration) *Elector {
+	return &Elector{lock: lock, holder: holder, lease: lease}
+}
+
+// NewKVElector returns an elector campaigning for the holder on the leader lock of the KV.
+func NewKVElector(kv KV, holder string, lease time.Duration) *Elector {
+	return NewElector(NewKVLock(kv, leaderLock), holder, lease)
+}
+
+// Run campaigns for the lock until the context is done, and then releases it.
+func (e *Elector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.lease / 4)
+	defer ticker.Stop()
+	for {
+		e.campaign(ctx)
+		select {
+		case <-ctx.Done():
+			e.setLeader(false)
+			// The context is done, release the lock within a context of its own.
+			releaseCtx, cancel := context.WithTimeout(context.Background(), e.lease/4)
+			if err := e.lock.Release(releaseCtx, e.holder); err != nil {
+				log.WithError(err).Error("Could not release leader lock")
+			}
+			cancel()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsLeader reports whether the client is the leader. The client stops being the leader half-way
+// through its lease when it could not renew it, so that it stops performing duties well before
+// another client takes the lock over.
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader && time.Since(e.acquiredAt) < e.lease/2
+}
+
+func (e *Elector) campaign(ctx context.Context) {
+	start := time.Now()
+	acquired, err := e.lock.TryAcquire(ctx, e.holder, e.lease)
+	if err != nil {
+		log.WithError(err).Error("Could not acquire leader lock")
+		// The lease runs out unless renewed by a later campaign.
+		return
+	}
+	if !acquired {
+		e.setLeader(false)
+		return
+	}
+	e.mu.Lock()
+	e.acquiredAt = start
+	e.mu.Unlock()
+	e.setLeader(true)
+}
+
+func (e *Elector) setLeader(leader bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if leader == e.leader {
+		return
+	}
+	e.leader = leader
+	if leader {
+		log.WithField("instance", e.holder).Info("Became leader, performing duties")
+		leaderGauge.Set(1)
+	} else {
+		log.WithField("instance", e.holder).Info("Lost leadership, standing by")
+		leaderGauge.Set(0)
+	}
+}
@@ -0,0 +1,264 @@
+package ha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// mutexName is the file created in the directory of a FileKV while a write is ongoing, holding
+	// the token of its owner.
+	mutexName = ".mutex"
+	// staleMutexAge is the age after which the mutex of a FileKV is considered left by a crashed
+	// client, and is broken.
+	staleMutexAge = 10 * time.Second
+	// mutexRefreshInterval is the interval at which the owner of the mutex of a FileKV refreshes
+	// its modification time, so that it is not considered stale while held.
+	mutexRefreshInterval = staleMutexAge / 4
+	// mutexRetryInterval is the interval at which a locked mutex is tried again.
+	mutexRetryInterval = 10 * time.Millisecond
+	// revisionLength is the length of the revision prefixing the value in the file of a key.
+	revisionLength = 8
+)
+
+// FileKV is a KV stored in a directory shared by the clients of a group, for instance on a
+// network file system. Each key is stored in a file holding its revision and value, and writes are
+// serialized by a mutex file, created as a hard link, as the creation of a link is atomic. The file
+// system must make links, renames and modification times visible to all clients as soon as they
+// are made, as local and NFS file systems do, but not eventually consistent object stores.
+type FileKV struct {
+	dir string
+}
+
+// NewFileKV returns a KV stored in the directory, which is created if it does not exist.
+func NewFileKV(dir string) (*FileKV, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "could not create directory")
+	}
+	return &FileKV{dir: dir}, nil
+}
+
+// Get returns the value of the key and its revision.
+func (f *FileKV) Get(_ context.Context, key string) ([]byte, uint64, error) {
+	return f.read(key)
+}
+
+// CompareAndSwap sets the value of the key if its revision is still the revision.
+func (f *FileKV) CompareAndSwap(ctx context.Context, key string, value []byte, revision uint64) (bool, error) {
+	token, err := f.lock(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer f.unlock(token)
+	stop := f.keepMutex(token)
+	defer stop()
+
+	_, current, err := f.read(key)
+	if err != nil {
+		return false, err
+	}
+	if current != revision {
+		return false, nil
+	}
+	enc := make([]byte, revisionLength+len(value))
+	binary.BigEndian.PutUint64(enc, current+1)
+	copy(enc[revisionLength:], value)
+	// Deleted keys keep a file with their revision and no value, so that a stale revision never
+	// matches again.
+	tmp := filepath.Join(f.dir, "."+fileName(key))
+	if err := ioutil.WriteFile(tmp, enc, 0600); err != nil {
+		return false, errors.Wrap(err, "could not write key")
+	}
+	// The mutex may still have been broken if the client stalled for longer than it takes to be
+	// stale, despite its refreshes.
+	if !f.owns(token) {
+		return false, errors.New("lock of directory was broken by another client")
+	}
+	if err := os.Rename(tmp, filepath.Join(f.dir, fileName(key))); err != nil {
+		return false, errors.Wrap(err, "could not write key")
+	}
+	return true, nil
+}
+
+// Keys returns the keys with the prefix that are set.
+func (f *FileKV) Keys(_ context.Context, prefix string) ([]string, error) {
+	infos, err := ioutil.ReadDir(f.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list keys")
+	}
+	var keys []string
+	for _, info := range infos {
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+		dec, err := hex.DecodeString(info.Name())
+		if err != nil {
+			continue
+		}
+		key := string(dec)
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		value, _, err := f.read(key)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (f *FileKV) read(key string) ([]byte, uint64, error) {
+	enc, err := ioutil.ReadFile(filepath.Join(f.dir, fileName(key)))
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not read key")
+	}
+	if len(enc) < revisionLength {
+		return nil, 0, errors.Errorf("corrupted key %q", key)
+	}
+	revision := binary.BigEndian.Uint64(enc)
+	if len(enc) == revisionLength {
+		return nil, revision, nil
+	}
+	return enc[revisionLength:], revision, nil
+}
+
+// lock takes the mutex of the directory, and returns the token identifying its owner.
+func (f *FileKV) lock(ctx context.Context) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "could not generate token")
+	}
+	token := hex.EncodeToString(b)
+	mutex := filepath.Join(f.dir, mutexName)
+	// The file is written again before every attempt, so that the mutex is not taken stale.
+	own := filepath.Join(f.dir, mutexName+"."+token)
+	defer removeFile(own)
+	for {
+		if err := ioutil.WriteFile(own, []byte(token), 0600); err != nil {
+			return "", errors.Wrap(err, "could not lock directory")
+		}
+		err := os.Link(own, mutex)
+		if err == nil {
+			return token, nil
+		}
+		if !os.IsExist(err) {
+			return "", errors.Wrap(err, "could not lock directory")
+		}
+		if info, err := os.Stat(mutex); err == nil && time.Since(info.ModTime()) > staleMutexAge {
+			if err := f.breakMutex(info, token); err != nil {
+				return "", err
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(mutexRetryInterval):
+		}
+	}
+}
+
+// breakMutex removes the stale mutex. Many clients may break it at once, and one of them may take
+// the mutex before another moves it: the mutex is moved to a name unique to the client, and is
+// only removed if it is still the stale one. Otherwise it is put back.
+func (f *FileKV) breakMutex(stale os.FileInfo, token string) error {
+	mutex := filepath.Join(f.dir, mutexName)
+	broken := filepath.Join(f.dir, mutexName+".broken."+token)
+	if err := os.Rename(mutex, broken); err != nil {
+		if os.IsNotExist(err) {
+			// Another client broke it.
+			return nil
+		}
+		return errors.Wrap(err, "could not break stale lock of directory")
+	}
+	defer removeFile(broken)
+	info, err := os.Stat(broken)
+	if err != nil {
+		return errors.Wrap(err, "could not break stale lock of directory")
+	}
+	// The inode of a removed file may be reused right away, but not with the same time.
+	if os.SameFile(info, stale) && info.ModTime().Equal(stale.ModTime()) {
+		log.WithField("path", mutex).Warn("Removed stale lock of directory")
+		return nil
+	}
+	// If another client took the mutex in the meantime, its owner finds out that it lost it
+	// before writing.
+	if err := os.Link(broken, mutex); err != nil && !os.IsExist(err) {
+		return errors.Wrap(err, "could not restore lock of directory")
+	}
+	return nil
+}
+
+// owns returns whether the mutex of the directory is still owned by the token.
+func (f *FileKV) owns(token string) bool {
+	owner, err := ioutil.ReadFile(filepath.Join(f.dir, mutexName))
+	return err == nil && string(owner) == token
+}
+
+// keepMutex refreshes the modification time of the mutex while the token owns it, until the
+// returned function is called.
+func (f *FileKV) keepMutex(token string) func() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(mutexRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if !f.owns(token) {
+					return
+				}
+				now := time.Now()
+				if err := os.Chtimes(filepath.Join(f.dir, mutexName), now, now); err != nil {
+					log.WithError(err).Warn("Could not refresh lock of directory")
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+func (f *FileKV) unlock(token string) {
+	if !f.owns(token) {
+		log.Error("Lock of directory was broken by another client")
+		return
+	}
+	removeFile(filepath.Join(f.dir, mutexName))
+}
+
+func removeFile(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).WithField("path", path).Error("Could not remove file")
+	}
+}
+
+// fileName of a key, which may hold characters that are not allowed in file names.
+func fileName(key string) string {
+	return hex.EncodeToString([]byte(key))
+}
@@ -0,0 +1,85 @@
+// Package ha runs validator clients as an active/passive high-availability group. The clients of a
+// group elect a leader through a lease held in a store they share, only the leader performs
+// duties, and the slashing protection history of the validating keys is kept in the shared store,
+// so that another client takes over when the leader fails without signing slashable messages.
+package ha
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// KV is an etcd-like key-value store shared by the validator clients of a group. Every write of a
+// key gives it a new revision, so that concurrent writes are detected.
+type KV interface {
+	// Get returns the value of the key and its revision. The value is nil if the key is not set,
+	// with revision 0 if it was never set.
+	Get(ctx context.Context, key string) ([]byte, uint64, error)
+	// CompareAndSwap sets the value of the key if its revision is still the revision, and reports
+	// whether it did. A nil value deletes the key.
+	CompareAndSwap(ctx context.Context, key string, value []byte, revision uint64) (bool, error)
+	// Keys returns the keys with the prefix that are set, in order.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+type memoryEntry struct {
+	value    []byte
+	revision uint64
+}
+
+// MemoryKV is a KV held in memory, shared by the clients of a group running in a single process.
+// It stands in for a networked store such as etcd, and is safe for concurrent use.
+type MemoryKV struct {
+	entries  map[string]*memoryEntry
+	revision uint64
+	lock     sync.Mutex
+}
+
+// NewMemoryKV returns an empty in-memory KV.
+func NewMemoryKV() *MemoryKV {
+	return &MemoryKV{entries: make(map[string]*memoryEntry)}
+}
+
+// Get returns the value of the key and its revision.
+func (m *MemoryKV) Get(_ context.Context, key string) ([]byte, uint64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, 0, nil
+	}
+	return e.value, e.revision, nil
+}
+
+// CompareAndSwap sets the value of the key if its revision is still the revision.
+func (m *MemoryKV) CompareAndSwap(_ context.Context, key string, value []byte, revision uint64) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var current uint64
+	if e, ok := m.entries[key]; ok {
+		current = e.revision
+	}
+	if current != revision {
+		return false, nil
+	}
+	// Deleted keys keep their revision, so that a stale revision never matches again.
+	m.revision++
+	m.entries[key] = &memoryEntry{value: value, revision: m.revision}
+	return true, nil
+}
+
+// Keys returns the keys with the prefix that are set.
+func (m *MemoryKV) Keys(_ context.Context, prefix string) ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var keys []string
+	for key, e := range m.entries {
+		if e.value != nil && strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
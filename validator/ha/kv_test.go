@@ -0,0 +1,296 @@
+package ha
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testKVs(t *testing.T) (map[string]KV, func()) {
+	dir, err := ioutil.TempDir("", "ha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileKV, err := NewFileKV(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvs := map[string]KV{
+		"memory": NewMemoryKV(),
+		"file":   fileKV,
+	}
+	return kvs, func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestKV_CompareAndSwap(t *testing.T) {
+	kvs, cleanup := testKVs(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for name, kv := range kvs {
+		t.Run(name, func(t *testing.T) {
+			value, revision, err := kv.Get(ctx, "a/key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != nil || revision != 0 {
+				t.Fatalf("Expected unset key, received %v at revision %d", value, revision)
+			}
+			swapped, err := kv.CompareAndSwap(ctx, "a/key", []byte("first"), revision)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !swapped {
+				t.Fatal("Expected first write to succeed")
+			}
+			// A write at the stale revision loses.
+			swapped, err = kv.CompareAndSwap(ctx, "a/key", []byte("second"), revision)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if swapped {
+				t.Fatal("Expected write at stale revision to fail")
+			}
+			value, revision, err = kv.Get(ctx, "a/key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(value, []byte("first")) {
+				t.Fatalf("Expected value %q, received %q", "first", value)
+			}
+
+			// Deletion keeps the revision moving forward.
+			swapped, err = kv.CompareAndSwap(ctx, "a/key", nil, revision)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !swapped {
+				t.Fatal("Expected deletion to succeed")
+			}
+			value, deletedRevision, err := kv.Get(ctx, "a/key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != nil || deletedRevision <= revision {
+				t.Fatalf("Expected deleted key at a new revision, received %v at revision %d", value, deletedRevision)
+			}
+		})
+	}
+}
+
+func TestKV_Keys(t *testing.T) {
+	kvs, cleanup := testKVs(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for name, kv := range kvs {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{"b/2", "b/1", "c/1", "b/deleted"} {
+				if _, err := kv.CompareAndSwap(ctx, key, []byte{1}, 0); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, revision, err := kv.Get(ctx, "b/deleted")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := kv.CompareAndSwap(ctx, "b/deleted", nil, revision); err != nil {
+				t.Fatal(err)
+			}
+			keys, err := kv.Keys(ctx, "b/")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"b/1", "b/2"}; !reflect.DeepEqual(keys, want) {
+				t.Fatalf("Expected keys %v, received %v", want, keys)
+			}
+		})
+	}
+}
+
+func TestFileKV_RemovesStaleMutex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	kv, err := NewFileKV(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaveStaleMutex(t, dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	swapped, err := kv.CompareAndSwap(ctx, "key", []byte{1}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !swapped {
+		t.Fatal("Expected write to succeed")
+	}
+}
+
+func TestFileKV_BreaksStaleMutexConcurrently(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	kv, err := NewFileKV(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	clients := 8
+	for round := 0; round < 20; round++ {
+		_, start, err := kv.Get(ctx, "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaveStaleMutex(t, dir)
+		// The clients all find the stale mutex, and swap the key once. If two of them held the
+		// mutex at once, both could swap the same revision.
+		var wg sync.WaitGroup
+		for i := 0; i < clients; i++ {
+			client, err := NewFileKV(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for ctx.Err() == nil {
+					_, revision, err := client.Get(ctx, "key")
+					if err != nil {
+						continue
+					}
+					// The owner of a mutex broken by another client fails to write, and tries again.
+					if swapped, err := client.CompareAndSwap(ctx, "key", []byte{1}, revision); err == nil && swapped {
+						return
+					}
+				}
+				t.Error("Could not swap key")
+			}()
+		}
+		wg.Wait()
+		_, end, err := kv.Get(ctx, "key")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if end-start != uint64(clients) {
+			t.Fatalf("Expected %d swaps to increment the revision by as much, got %d", clients, end-start)
+		}
+	}
+}
+
+func TestFileKV_DoesNotBreakTakenMutex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	kv, err := NewFileKV(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaveStaleMutex(t, dir)
+	stale, err := os.Stat(filepath.Join(dir, mutexName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A client breaks the stale mutex and takes it, before another client which found it stale
+	// breaks it too.
+	token, err := kv.lock(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := kv.breakMutex(stale, "other"); err != nil {
+		t.Fatal(err)
+	}
+	if !kv.owns(token) {
+		t.Fatal("Expected the mutex taken after the stale one to be kept")
+	}
+	kv.unlock(token)
+	if _, err := os.Stat(filepath.Join(dir, mutexName)); !os.IsNotExist(err) {
+		t.Fatalf("Expected mutex to be removed, got %v", err)
+	}
+}
+
+// leaveStaleMutex leaves the mutex of a client which crashed during a write in the directory.
+func leaveStaleMutex(t *testing.T, dir string) {
+	mutex := filepath.Join(dir, mutexName)
+	if err := ioutil.WriteFile(mutex, []byte("crashed"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	stale := time.Now().Add(-2 * staleMutexAge)
+	if err := os.Chtimes(mutex, stale, stale); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileKV_RefreshesHeldMutex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	kv, err := NewFileKV(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := kv.lock(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kv.unlock(token)
+	// The write holding the mutex is about to be taken for a crashed one.
+	mutex := filepath.Join(dir, mutexName)
+	old := time.Now().Add(-staleMutexAge + time.Second)
+	if err := os.Chtimes(mutex, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := kv.keepMutex(token)
+	time.Sleep(mutexRefreshInterval + 500*time.Millisecond)
+	stop()
+	info, err := os.Stat(mutex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(info.ModTime()) > mutexRefreshInterval {
+		t.Errorf("Expected the held mutex to be refreshed, last modified %v ago", time.Since(info.ModTime()))
+	}
+	if !kv.owns(token) {
+		t.Error("Expected the mutex to still be owned")
+	}
+}
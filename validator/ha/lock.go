@@ -0,0 +1,85 @@
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Lock is a lock held by one client of a group at a time, for a limited time unless renewed, so
+// that the lock of a failed client is taken over without manual intervention.
+type Lock interface {
+	// TryAcquire acquires or renews the lock for the holder, for the duration, and reports whether
+	// the holder holds it.
+	TryAcquire(ctx context.Context, holder string, ttl time.Duration) (bool, error)
+	// Release the lock if the holder holds it.
+	Release(ctx context.Context, holder string) error
+}
+
+// lease held on a KVLock.
+type lease struct {
+	Holder string    `json:"holder"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// KVLock is a Lock stored in a key of a KV. The expiry of the lock is compared to the clock of
+// the clients, which must be synchronized to well within the duration of the lock.
+type KVLock struct {
+	kv  KV
+	key string
+}
+
+// NewKVLock returns a lock stored in the key of the KV.
+func NewKVLock(kv KV, key string) *KVLock {
+	return &KVLock{kv: kv, key: key}
+}
+
+// TryAcquire acquires or renews the lock for the holder, if it is free, expired or already held
+// by the holder.
+func (l *KVLock) TryAcquire(ctx context.Context, holder string, ttl time.Duration) (bool, error) {
+	current, revision, err := l.get(ctx)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	if current != nil && current.Holder != holder && now.Before(current.Expiry) {
+		return false, nil
+	}
+	enc, err := json.Marshal(&lease{Holder: holder, Expiry: now.Add(ttl)})
+	if err != nil {
+		return false, errors.Wrap(err, "could not encode lease")
+	}
+	// A concurrent acquisition by another client changed the revision, and wins.
+	return l.kv.CompareAndSwap(ctx, l.key, enc, revision)
+}
+
+// Release the lock if the holder holds it, so that another client acquires it without waiting
+// for its expiry.
+func (l *KVLock) Release(ctx context.Context, holder string) error {
+	current, revision, err := l.get(ctx)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.Holder != holder {
+		return nil
+	}
+	_, err = l.kv.CompareAndSwap(ctx, l.key, nil, revision)
+	return err
+}
+
+func (l *KVLock) get(ctx context.Context) (*lease, uint64, error) {
+	enc, revision, err := l.kv.Get(ctx, l.key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if enc == nil {
+		return nil, revision, nil
+	}
+	current := &lease{}
+	if err := json.Unmarshal(enc, current); err != nil {
+		return nil, 0, errors.Wrap(err, "could not decode lease")
+	}
+	return current, revision, nil
+}
@@ -0,0 +1,144 @@
+package ha
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestKVLock_ExcludesOtherHolders(t *testing.T) {
+	ctx := context.Background()
+	lock := NewKVLock(NewMemoryKV(), "lock")
+
+	acquired, err := lock.TryAcquire(ctx, "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !acquired {
+		t.Fatal("Expected a to acquire the free lock")
+	}
+	acquired, err = lock.TryAcquire(ctx, "b", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acquired {
+		t.Fatal("Expected b not to acquire the lock held by a")
+	}
+	acquired, err = lock.TryAcquire(ctx, "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !acquired {
+		t.Fatal("Expected a to renew its lock")
+	}
+
+	if err := lock.Release(ctx, "b"); err != nil {
+		t.Fatal(err)
+	}
+	acquired, err = lock.TryAcquire(ctx, "b", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acquired {
+		t.Fatal("Expected b not to release the lock held by a")
+	}
+	if err := lock.Release(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	acquired, err = lock.TryAcquire(ctx, "b", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !acquired {
+		t.Fatal("Expected b to acquire the lock released by a")
+	}
+}
+
+func TestKVLock_TakesOverExpiredLock(t *testing.T) {
+	ctx := context.Background()
+	lock := NewKVLock(NewMemoryKV(), "lock")
+
+	if _, err := lock.TryAcquire(ctx, "a", 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	acquired, err := lock.TryAcquire(ctx, "b", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !acquired {
+		t.Fatal("Expected b to take over the expired lock of a")
+	}
+}
+
+func TestElector_FailsOver(t *testing.T) {
+	kv := NewMemoryKV()
+	lease := 40 * time.Millisecond
+	a := NewKVElector(kv, "a", lease)
+	b := NewKVElector(kv, "b", lease)
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	doneA := make(chan struct{})
+	go func() {
+		a.Run(ctxA)
+		close(doneA)
+	}()
+	time.Sleep(lease / 8)
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+	go b.Run(ctxB)
+
+	time.Sleep(lease)
+	if !a.IsLeader() {
+		t.Fatal("Expected a to be the leader")
+	}
+	if b.IsLeader() {
+		t.Fatal("Expected b to stand by")
+	}
+
+	// a stops, b takes over.
+	cancelA()
+	<-doneA
+	if a.IsLeader() {
+		t.Fatal("Expected a to stop leading when stopped")
+	}
+	time.Sleep(lease)
+	if !b.IsLeader() {
+		t.Fatal("Expected b to take over")
+	}
+}
+
+// unreachableLock fails to be acquired, like the lock of a client cut off from the shared store.
+type unreachableLock struct {
+	acquired bool
+}
+
+func (l *unreachableLock) TryAcquire(context.Context, string, time.Duration) (bool, error) {
+	if l.acquired {
+		return false, context.DeadlineExceeded
+	}
+	l.acquired = true
+	return true, nil
+}
+
+func (l *unreachableLock) Release(context.Context, string) error {
+	return nil
+}
+
+func TestElector_StopsLeadingBeforeLeaseExpiry(t *testing.T) {
+	lease := 40 * time.Millisecond
+	e := NewElector(&unreachableLock{}, "a", lease)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go e.Run(ctx)
+
+	time.Sleep(lease / 8)
+	if !e.IsLeader() {
+		t.Fatal("Expected elector to lead")
+	}
+	// The lock cannot be renewed, so the elector stops leading half-way through its lease.
+	time.Sleep(lease / 2)
+	if e.IsLeader() {
+		t.Fatal("Expected elector to stop leading without renewing its lease")
+	}
+}
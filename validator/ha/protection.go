@@ -0,0 +1,192 @@
+package ha
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"go.opencensus.io/trace"
+)
+
+var _ = iface.ValidatorDB(&ProtectionDB{})
+var _ = iface.HistoryUpdater(&ProtectionDB{})
+
+// Prefixes of the keys of the histories in the KV.
+const (
+	proposalPrefix    = "proposal/"
+	attestationPrefix = "attestation/"
+)
+
+// ProtectionDB is a validator database storing the slashing protection histories of the
+// validating keys in the KV shared by the clients of a group, so that the client taking over
+// the duties of a failed leader knows what the leader signed.
+type ProtectionDB struct {
+	kv   KV
+	path string
+}
+
+// NewProtectionDB returns a validator database stored in the KV, found at the path.
+func NewProtectionDB(kv KV, path string) *ProtectionDB {
+	return &ProtectionDB{kv: kv, path: path}
+}
+
+// Close the database. The KV is left open, as it is shared with the elector.
+func (p *ProtectionDB) Close() error {
+	return nil
+}
+
+// DatabasePath at which the KV is found.
+func (p *ProtectionDB) DatabasePath() string {
+	return p.path
+}
+
+// ClearDB deletes the histories of all the keys.
+func (p *ProtectionDB) ClearDB() error {
+	ctx := context.Background()
+	for _, prefix := range []string{proposalPrefix, attestationPrefix} {
+		keys, err := p.kv.Keys(ctx, prefix)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := p.put(ctx, key, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// InitializeHistories of the public keys which have no proposal or attestation history yet.
+func (p *ProtectionDB) InitializeHistories(ctx context.Context, publicKeys [][48]byte) error {
+	return db.InitializeSharedHistories(ctx, p, publicKeys)
+}
+
+// ProposalHistory of the public key, nil if there is none.
+func (p *ProtectionDB) ProposalHistory(ctx context.Context, publicKey []byte) (*slashpb.ProposalHistory, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.ProposalHistory")
+	defer span.End()
+
+	enc, _, err := p.kv.Get(ctx, proposalPrefix+hex.EncodeToString(publicKey))
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	return db.UnmarshalProposalHistory(enc)
+}
+
+// SaveProposalHistory of the public key.
+func (p *ProtectionDB) SaveProposalHistory(ctx context.Context, publicKey []byte, history *slashpb.ProposalHistory) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.SaveProposalHistory")
+	defer span.End()
+
+	enc, err := proto.Marshal(history)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode proposal history")
+	}
+	return p.put(ctx, proposalPrefix+hex.EncodeToString(publicKey), enc)
+}
+
+// DeleteProposalHistory of the public key.
+func (p *ProtectionDB) DeleteProposalHistory(ctx context.Context, publicKey []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.DeleteProposalHistory")
+	defer span.End()
+
+	return p.put(ctx, proposalPrefix+hex.EncodeToString(publicKey), nil)
+}
+
+// UpdateProposalHistory of the public key atomically, retrying the update if another client
+// updated the history meanwhile.
+func (p *ProtectionDB) UpdateProposalHistory(
+	ctx context.Context,
+	publicKey []byte,
+	update func(*slashpb.ProposalHistory) (*slashpb.ProposalHistory, error),
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.UpdateProposalHistory")
+	defer span.End()
+
+	return p.update(ctx, proposalPrefix+hex.EncodeToString(publicKey), func(enc []byte) ([]byte, error) {
+		return db.UpdateEncodedProposalHistory(enc, update)
+	})
+}
+
+// AttestationHistory of the public key, nil if there is none.
+func (p *ProtectionDB) AttestationHistory(ctx context.Context, publicKey []byte) (*slashpb.AttestationHistory, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.AttestationHistory")
+	defer span.End()
+
+	enc, _, err := p.kv.Get(ctx, attestationPrefix+hex.EncodeToString(publicKey))
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	return db.UnmarshalAttestationHistory(enc)
+}
+
+// SaveAttestationHistory of the public key.
+func (p *ProtectionDB) SaveAttestationHistory(ctx context.Context, publicKey []byte, history *slashpb.AttestationHistory) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.SaveAttestationHistory")
+	defer span.End()
+
+	enc, err := proto.Marshal(history)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode attestation history")
+	}
+	return p.put(ctx, attestationPrefix+hex.EncodeToString(publicKey), enc)
+}
+
+// DeleteAttestationHistory of the public key.
+func (p *ProtectionDB) DeleteAttestationHistory(ctx context.Context, publicKey []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.DeleteAttestationHistory")
+	defer span.End()
+
+	return p.put(ctx, attestationPrefix+hex.EncodeToString(publicKey), nil)
+}
+
+// UpdateAttestationHistory of the public key atomically, retrying the update if another client
+// updated the history meanwhile.
+func (p *ProtectionDB) UpdateAttestationHistory(
+	ctx context.Context,
+	publicKey []byte,
+	update func(*slashpb.AttestationHistory) (*slashpb.AttestationHistory, error),
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.HA.UpdateAttestationHistory")
+	defer span.End()
+
+	return p.update(ctx, attestationPrefix+hex.EncodeToString(publicKey), func(enc []byte) ([]byte, error) {
+		return db.UpdateEncodedAttestationHistory(enc, update)
+	})
+}
+
+// update the value of the key, until no other client updated it between its read and its write.
+func (p *ProtectionDB) update(ctx context.Context, key string, update func([]byte) ([]byte, error)) error {
+	for {
+		enc, revision, err := p.kv.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		enc, err = update(enc)
+		if err != nil {
+			return err
+		}
+		swapped, err := p.kv.CompareAndSwap(ctx, key, enc, revision)
+		if err != nil {
+			return err
+		}
+		if swapped {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// put the value of the key, whatever its current revision.
+func (p *ProtectionDB) put(ctx context.Context, key string, value []byte) error {
+	return p.update(ctx, key, func([]byte) ([]byte, error) {
+		return value, nil
+	})
+}
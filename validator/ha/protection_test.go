@@ -0,0 +1,200 @@
+package ha
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestProtectionDB_SharesHistories(t *testing.T) {
+	ctx := context.Background()
+	kv := NewMemoryKV()
+	leader := NewProtectionDB(kv, "memory")
+	standby := NewProtectionDB(kv, "memory")
+	pubKey := [48]byte{1}
+
+	if err := leader.InitializeHistories(ctx, [][48]byte{pubKey}); err != nil {
+		t.Fatal(err)
+	}
+	proposals := &slashpb.ProposalHistory{
+		EpochBits:          bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+		LatestEpochWritten: 1,
+	}
+	proposals.EpochBits.SetBitAt(1, true)
+	if err := leader.SaveProposalHistory(ctx, pubKey[:], proposals); err != nil {
+		t.Fatal(err)
+	}
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource:     map[uint64]uint64{0: params.BeaconConfig().FarFutureEpoch, 2: 1},
+		LatestEpochWritten: 2,
+	}
+	if err := leader.SaveAttestationHistory(ctx, pubKey[:], attestations); err != nil {
+		t.Fatal(err)
+	}
+
+	// The client taking over finds the histories, and does not overwrite them.
+	if err := standby.InitializeHistories(ctx, [][48]byte{pubKey}); err != nil {
+		t.Fatal(err)
+	}
+	gotProposals, err := standby.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotProposals, proposals) {
+		t.Fatalf("Expected proposal history %v, received %v", proposals, gotProposals)
+	}
+	gotAttestations, err := standby.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotAttestations, attestations) {
+		t.Fatalf("Expected attestation history %v, received %v", attestations, gotAttestations)
+	}
+}
+
+// interleavedKV runs a write of another client after its first read of an attestation history.
+type interleavedKV struct {
+	KV
+	write func()
+}
+
+func (i *interleavedKV) Get(ctx context.Context, key string) ([]byte, uint64, error) {
+	value, revision, err := i.KV.Get(ctx, key)
+	if i.write != nil && strings.HasPrefix(key, attestationPrefix) {
+		i.write()
+		i.write = nil
+	}
+	return value, revision, err
+}
+
+func TestProtectionDB_InitializeKeepsHistoriesRecordedMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	kv := NewMemoryKV()
+	pubKey := [48]byte{1}
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource:     map[uint64]uint64{0: params.BeaconConfig().FarFutureEpoch, 2: 1},
+		LatestEpochWritten: 2,
+	}
+	// The leader records an attestation while the client starting finds no history.
+	starting := NewProtectionDB(&interleavedKV{KV: kv, write: func() {
+		if err := NewProtectionDB(kv, "memory").SaveAttestationHistory(ctx, pubKey[:], attestations); err != nil {
+			t.Fatal(err)
+		}
+	}}, "memory")
+	if err := starting.InitializeHistories(ctx, [][48]byte{pubKey}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := starting.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, attestations) {
+		t.Fatalf("Expected attestation history %v, received %v", attestations, got)
+	}
+}
+
+func TestProtectionDB_DeletesHistories(t *testing.T) {
+	ctx := context.Background()
+	p := NewProtectionDB(NewMemoryKV(), "memory")
+	pubKeys := [][48]byte{{1}, {2}}
+	if err := p.InitializeHistories(ctx, pubKeys); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.DeleteProposalHistory(ctx, pubKeys[0][:]); err != nil {
+		t.Fatal(err)
+	}
+	history, err := p.ProposalHistory(ctx, pubKeys[0][:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history != nil {
+		t.Fatalf("Expected deleted proposal history, received %v", history)
+	}
+
+	if err := p.ClearDB(); err != nil {
+		t.Fatal(err)
+	}
+	for _, pubKey := range pubKeys {
+		history, err := p.AttestationHistory(ctx, pubKey[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if history != nil {
+			t.Fatalf("Expected cleared attestation history, received %v", history)
+		}
+	}
+}
+
+func TestProtectionDB_UpdatesHistoriesAtomically(t *testing.T) {
+	ctx := context.Background()
+	kv := NewMemoryKV()
+	p := NewProtectionDB(kv, "memory")
+	pubKey := [48]byte{1}
+
+	// The history of a key without history starts empty.
+	if err := p.UpdateAttestationHistory(ctx, pubKey[:], func(history *slashpb.AttestationHistory) (*slashpb.AttestationHistory, error) {
+		history.TargetToSource[2] = 1
+		history.LatestEpochWritten = 2
+		return history, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	history, err := p.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history.LatestEpochWritten != 2 || history.TargetToSource[2] != 1 {
+		t.Fatalf("Unexpected attestation history %v", history)
+	}
+
+	// A history updated by another client meanwhile is updated again.
+	calls := 0
+	if err := p.UpdateProposalHistory(ctx, pubKey[:], func(history *slashpb.ProposalHistory) (*slashpb.ProposalHistory, error) {
+		calls++
+		if calls == 1 {
+			other := &slashpb.ProposalHistory{
+				EpochBits:          bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+				LatestEpochWritten: 5,
+			}
+			if err := NewProtectionDB(kv, "memory").SaveProposalHistory(ctx, pubKey[:], other); err != nil {
+				return nil, err
+			}
+		}
+		history.LatestEpochWritten++
+		return history, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected the update to be retried once, was called %d times", calls)
+	}
+	proposals, err := p.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposals.LatestEpochWritten != 6 {
+		t.Fatalf("Expected the update to apply on top of the concurrent one, received %v", proposals)
+	}
+
+	// A failing update leaves the history unchanged.
+	errRejected := errors.New("rejected")
+	if err := p.UpdateProposalHistory(ctx, pubKey[:], func(*slashpb.ProposalHistory) (*slashpb.ProposalHistory, error) {
+		return nil, errRejected
+	}); err != errRejected {
+		t.Fatalf("Expected error %v, received %v", errRejected, err)
+	}
+	proposals, err = p.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposals.LatestEpochWritten != 6 {
+		t.Fatalf("Expected unchanged history, received %v", proposals)
+	}
+}
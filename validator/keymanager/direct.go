@@ -1,6 +1,8 @@
 package keymanager
 
 import (
+	"sync"
+
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
@@ -11,6 +13,7 @@ type Direct struct {
 	publicKeys map[[48]byte]*bls.PublicKey
 	// Key to the map is the bytes of the public key.
 	secretKeys map[[48]byte]*bls.SecretKey
+	lock       sync.RWMutex
 }
 
 // NewDirect creates a new direct key manager from the secret keys provided to it.
@@ -30,6 +33,8 @@ func NewDirect(sks []*bls.SecretKey) *Direct {
 
 // FetchValidatingKeys fetches the list of public keys that should be used to validate with.
 func (km *Direct) FetchValidatingKeys() ([][48]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
 	keys := make([][48]byte, 0, len(km.publicKeys))
 	for key := range km.publicKeys {
 		keys = append(keys, key)
@@ -39,8 +44,33 @@ func (km *Direct) FetchValidatingKeys() ([][48]byte, error) {
 
 // Sign signs a message for the validator to broadcast.
 func (km *Direct) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
 	if secretKey, exists := km.secretKeys[pubKey]; exists {
 		return secretKey.Sign(root[:], domain), nil
 	}
 	return nil, ErrNoSuchKey
 }
+
+// AddKey adds a secret key to validate with, returning its public key.
+func (km *Direct) AddKey(sk *bls.SecretKey) ([48]byte, error) {
+	publicKey := sk.PublicKey()
+	pubKey := bytesutil.ToBytes48(publicKey.Marshal())
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	km.publicKeys[pubKey] = publicKey
+	km.secretKeys[pubKey] = sk
+	return pubKey, nil
+}
+
+// RemoveKey stops validating with the key of the public key.
+func (km *Direct) RemoveKey(pubKey [48]byte) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if _, exists := km.secretKeys[pubKey]; !exists {
+		return ErrNoSuchKey
+	}
+	delete(km.publicKeys, pubKey)
+	delete(km.secretKeys, pubKey)
+	return nil
+}
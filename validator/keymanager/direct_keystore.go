@@ -1,23 +1,46 @@
 package keymanager
 
 import (
+	"context"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"os/user"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
-	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
-// Keystore is a key manager that loads keys from a standard keystore.
+// removedKeysDir is the directory of the keystore that removed key files are moved to.
+const removedKeysDir = "removed"
+
+// Keystore is a key manager that loads keys from a standard keystore. Key files added to or
+// removed from the keystore directory are picked up while the validator runs.
 type Keystore struct {
 	*Direct
+	path       string
+	passphrase string
+	// Key to the map is the name of the key file.
+	files     map[string]keystoreFile
+	filesLock sync.Mutex
+}
+
+// keystoreFile is a key file of the keystore, as of when it was last loaded.
+type keystoreFile struct {
+	modTime time.Time
+	pubKey  [48]byte
+	loaded  bool
 }
 
 type keystoreOpts struct {
@@ -72,25 +95,139 @@ func NewKeystore(input string) (KeyManager, string, error) {
 		}
 	}
 
-	keyMap, err := accounts.DecryptKeysFromKeystore(opts.Path, opts.Passphrase)
-	if err != nil {
-		return nil, keystoreOptsHelp, err
-	}
-
-	km := &Unencrypted{
+	km := &Keystore{
 		Direct: &Direct{
 			publicKeys: make(map[[48]byte]*bls.PublicKey),
 			secretKeys: make(map[[48]byte]*bls.SecretKey),
 		},
+		path:       opts.Path,
+		passphrase: opts.Passphrase,
+		files:      make(map[string]keystoreFile),
 	}
-	for _, key := range keyMap {
-		pubKey := bytesutil.ToBytes48(key.PublicKey.Marshal())
-		km.publicKeys[pubKey] = key.PublicKey
-		km.secretKeys[pubKey] = key.SecretKey
+	if err := km.reload(true); err != nil {
+		return nil, keystoreOptsHelp, err
 	}
 	return km, "", nil
 }
 
+// Watch reloads the key files of the keystore directory at every interval, until the context
+// is canceled.
+func (km *Keystore) Watch(ctx context.Context, interval time.Duration) {
+	watch(ctx, interval, func() error {
+		return km.reload(false)
+	})
+}
+
+// reload loads the key files which were added or changed since the last reload, and stops
+// validating with the keys whose files were deleted. Key files which cannot be decrypted fail
+// the initial load, and are skipped afterwards.
+func (km *Keystore) reload(initial bool) error {
+	km.filesLock.Lock()
+	defer km.filesLock.Unlock()
+	files, err := ioutil.ReadDir(km.path)
+	if err != nil {
+		return err
+	}
+	ks := keystore.NewKeystore(km.path)
+	prefix := strings.TrimPrefix(params.BeaconConfig().ValidatorPrivkeyFileName, "/")
+	present := make(map[string]bool)
+	for _, f := range files {
+		name := f.Name()
+		if !f.Mode().IsRegular() || !strings.Contains(name, prefix) {
+			continue
+		}
+		present[name] = true
+		known, ok := km.files[name]
+		if ok && known.modTime.Equal(f.ModTime()) {
+			continue
+		}
+		if ok && known.loaded {
+			// The key file was replaced.
+			if err := km.Direct.RemoveKey(known.pubKey); err != nil && err != ErrNoSuchKey {
+				return err
+			}
+		}
+		key, err := ks.GetKey(filepath.Join(km.path, name), km.passphrase)
+		if err != nil {
+			if initial {
+				return fmt.Errorf("could not decrypt key file %s: %v", name, err)
+			}
+			log.WithError(err).WithField("file", name).Warn("Could not decrypt key file")
+			km.files[name] = keystoreFile{modTime: f.ModTime()}
+			continue
+		}
+		pubKey, err := km.Direct.AddKey(key.SecretKey)
+		if err != nil {
+			return err
+		}
+		km.files[name] = keystoreFile{modTime: f.ModTime(), pubKey: pubKey, loaded: true}
+		if !initial {
+			log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Loaded validating key from keystore")
+		}
+	}
+	for name, known := range km.files {
+		if present[name] {
+			continue
+		}
+		delete(km.files, name)
+		if !known.loaded {
+			continue
+		}
+		if err := km.Direct.RemoveKey(known.pubKey); err == nil {
+			log.WithField("pubKey", fmt.Sprintf("%#x", known.pubKey)).Info("Stopped validating with key deleted from keystore")
+		}
+	}
+	return nil
+}
+
+// AddKey stores the secret key in the keystore, encrypted with the keystore passphrase, and
+// validates with it.
+func (km *Keystore) AddKey(sk *bls.SecretKey) ([48]byte, error) {
+	key, err := keystore.NewKeyFromBLS(sk)
+	if err != nil {
+		return [48]byte{}, err
+	}
+	name := strings.TrimPrefix(params.BeaconConfig().ValidatorPrivkeyFileName, "/") + hex.EncodeToString(key.PublicKey.Marshal())[:12]
+	filePath := filepath.Join(km.path, name)
+
+	km.filesLock.Lock()
+	defer km.filesLock.Unlock()
+	if err := keystore.NewKeystore(km.path).StoreKey(filePath, key, km.passphrase); err != nil {
+		return [48]byte{}, err
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return [48]byte{}, err
+	}
+	pubKey, err := km.Direct.AddKey(sk)
+	if err != nil {
+		return [48]byte{}, err
+	}
+	km.files[name] = keystoreFile{modTime: info.ModTime(), pubKey: pubKey, loaded: true}
+	return pubKey, nil
+}
+
+// RemoveKey stops validating with the key, and moves its key files to the removed directory of
+// the keystore so the key is not loaded again.
+func (km *Keystore) RemoveKey(pubKey [48]byte) error {
+	km.filesLock.Lock()
+	defer km.filesLock.Unlock()
+	for name, f := range km.files {
+		if !f.loaded || f.pubKey != pubKey {
+			continue
+		}
+		removedDir := filepath.Join(km.path, removedKeysDir)
+		if err := os.MkdirAll(removedDir, 0700); err != nil {
+			return err
+		}
+		if err := os.Rename(filepath.Join(km.path, name), filepath.Join(removedDir, name)); err != nil {
+			return err
+		}
+		delete(km.files, name)
+	}
+	return km.Direct.RemoveKey(pubKey)
+}
+
 func homeDir() string {
 	if home := os.Getenv("HOME"); home != "" {
 		return home
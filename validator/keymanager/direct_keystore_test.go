@@ -0,0 +1,78 @@
+package keymanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestKeystoreReload(t *testing.T) {
+	dir := filepath.Join(testutil.TempDir(), "keystorereload")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Log(err)
+		}
+	}()
+	km := &Keystore{
+		Direct:     NewDirect(nil),
+		path:       dir,
+		passphrase: "secret",
+		files:      make(map[string]keystoreFile),
+	}
+
+	addedPubKey, err := km.AddKey(bls.RandKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A key file copied into the keystore directory.
+	key, err := keystore.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	copiedFile := filepath.Join(dir, params.BeaconConfig().ValidatorPrivkeyFileName+"copied")
+	if err := keystore.NewKeystore(dir).StoreKey(copiedFile, key, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if err := km.reload(false); err != nil {
+		t.Fatal(err)
+	}
+	if keys, _ := km.FetchValidatingKeys(); len(keys) != 2 {
+		t.Fatalf("Expected 2 keys after adding a key file, received %d", len(keys))
+	}
+
+	if err := os.Remove(copiedFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := km.reload(false); err != nil {
+		t.Fatal(err)
+	}
+	keys, _ := km.FetchValidatingKeys()
+	if len(keys) != 1 || keys[0] != addedPubKey {
+		t.Fatalf("Expected only the added key after deleting a key file, received %d keys", len(keys))
+	}
+
+	if err := km.RemoveKey(addedPubKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := km.reload(false); err != nil {
+		t.Fatal(err)
+	}
+	if keys, _ := km.FetchValidatingKeys(); len(keys) != 0 {
+		t.Errorf("Expected no keys after removing the key, received %d", len(keys))
+	}
+	removed, err := filepath.Glob(filepath.Join(dir, removedKeysDir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 1 {
+		t.Errorf("Expected the removed key file to be kept, found %d files", len(removed))
+	}
+}
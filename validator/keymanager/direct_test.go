@@ -76,3 +76,35 @@ func TestSign(t *testing.T) {
 		t.Fatal("Failed to verify generated signature")
 	}
 }
+
+func TestDirectAddRemoveKey(t *testing.T) {
+	direct := keymanager.NewDirect(nil)
+	sk := bls.RandKey()
+	pubKey, err := direct.AddKey(sk)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pubKey != bytesutil.ToBytes48(sk.PublicKey().Marshal()) {
+		t.Errorf("Incorrect public key returned; expected %#x, received %#x", sk.PublicKey().Marshal(), pubKey)
+	}
+	keys, err := direct.FetchValidatingKeys()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != pubKey {
+		t.Errorf("Expected the added key to be validated with, received %v", keys)
+	}
+	if _, err := direct.Sign(pubKey, [32]byte{}, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := direct.RemoveKey(pubKey); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := direct.Sign(pubKey, [32]byte{}, 0); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Incorrect error: expected %v, received %v", keymanager.ErrNoSuchKey, err)
+	}
+	if err := direct.RemoveKey(pubKey); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Incorrect error: expected %v, received %v", keymanager.ErrNoSuchKey, err)
+	}
+}
@@ -1,7 +1,9 @@
 package keymanager
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
 )
@@ -19,3 +21,35 @@ type KeyManager interface {
 	// Sign signs a message for the validator to broadcast.
 	Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error)
 }
+
+// Dynamic is a key manager whose keys can be added and removed while the validator runs.
+// The validator fetches the keys it validates with at the start of every epoch.
+type Dynamic interface {
+	KeyManager
+	// AddKey adds a secret key to validate with, returning its public key.
+	AddKey(sk *bls.SecretKey) ([48]byte, error)
+	// RemoveKey stops validating with the key of the public key.
+	RemoveKey(pubKey [48]byte) error
+}
+
+// Watcher is a key manager which reloads its keys when the store they are loaded from changes.
+type Watcher interface {
+	// Watch checks the store for changes at every interval, until the context is canceled.
+	Watch(ctx context.Context, interval time.Duration)
+}
+
+// watch calls reload at every interval until the context is canceled.
+func watch(ctx context.Context, interval time.Duration, reload func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := reload(); err != nil {
+				log.WithError(err).Warn("Could not reload keys")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package keymanager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -56,10 +59,6 @@ func NewWallet(input string) (KeyManager, string, error) {
 		return nil, walletOptsHelp, errors.New("at least one passphrase is required to decrypt accounts")
 	}
 
-	km := &Wallet{
-		accounts: make(map[[48]byte]e2wtypes.Account),
-	}
-
 	var store e2wtypes.Store
 	if opts.Location == "" {
 		store = filesystem.New()
@@ -67,13 +66,38 @@ func NewWallet(input string) (KeyManager, string, error) {
 		store = filesystem.New(filesystem.WithLocation(opts.Location))
 	}
 	for _, path := range opts.Accounts {
-		parts := strings.Split(path, "/")
-		if len(parts[0]) == 0 {
+		if parts := strings.Split(path, "/"); len(parts[0]) == 0 {
 			return nil, walletOptsHelp, fmt.Errorf("did not understand account specifier %q", path)
 		}
-		wallet, err := e2wallet.OpenWallet(parts[0], e2wallet.WithStore(store))
+	}
+	km := &Wallet{
+		accounts: make(map[[48]byte]e2wtypes.Account),
+		opts:     opts,
+		store:    store,
+	}
+	if err := km.reload(true); err != nil {
+		return nil, walletOptsHelp, err
+	}
+
+	return km, walletOptsHelp, nil
+}
+
+// Watch reloads the accounts of the wallets at every interval, until the context is canceled.
+func (km *Wallet) Watch(ctx context.Context, interval time.Duration) {
+	watch(ctx, interval, func() error {
+		return km.reload(false)
+	})
+}
+
+// reload unlocks the accounts matching the account specifiers which were added to the wallets
+// since the last reload, and stops validating with the accounts which were removed.
+func (km *Wallet) reload(initial bool) error {
+	accounts := make(map[[48]byte]e2wtypes.Account)
+	for _, path := range km.opts.Accounts {
+		parts := strings.Split(path, "/")
+		wallet, err := e2wallet.OpenWallet(parts[0], e2wallet.WithStore(km.store))
 		if err != nil {
-			return nil, walletOptsHelp, err
+			return err
 		}
 		accountSpecifier := "^.*$"
 		if len(parts) > 1 && len(parts[1]) > 0 {
@@ -83,27 +107,54 @@ func NewWallet(input string) (KeyManager, string, error) {
 		for account := range wallet.Accounts() {
 			if re.Match([]byte(account.Name())) {
 				pubKey := bytesutil.ToBytes48(account.PublicKey().Marshal())
-				for _, passphrase := range opts.Passphrases {
+				km.lock.RLock()
+				unlocked, ok := km.accounts[pubKey]
+				km.lock.RUnlock()
+				if ok {
+					accounts[pubKey] = unlocked
+					continue
+				}
+				for _, passphrase := range km.opts.Passphrases {
 					if err := account.Unlock([]byte(passphrase)); err != nil {
 						log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Warn("Failed to unlock account with supplied passphrases; cannot validate")
 					} else {
-						km.accounts[pubKey] = account
+						accounts[pubKey] = account
 					}
 				}
 			}
 		}
 	}
 
-	return km, walletOptsHelp, nil
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if !initial {
+		for pubKey := range accounts {
+			if _, ok := km.accounts[pubKey]; !ok {
+				log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Loaded validating key from wallet")
+			}
+		}
+		for pubKey := range km.accounts {
+			if _, ok := accounts[pubKey]; !ok {
+				log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Stopped validating with key removed from wallet")
+			}
+		}
+	}
+	km.accounts = accounts
+	return nil
 }
 
 // Wallet is a key manager that loads keys from a local Ethereum 2 wallet.
 type Wallet struct {
 	accounts map[[48]byte]e2wtypes.Account
+	opts     *walletOpts
+	store    e2wtypes.Store
+	lock     sync.RWMutex
 }
 
 // FetchValidatingKeys fetches the list of public keys that should be used to validate with.
 func (km *Wallet) FetchValidatingKeys() ([][48]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
 	res := make([][48]byte, 0, len(km.accounts))
 	for pubKey := range km.accounts {
 		res = append(res, pubKey)
@@ -113,7 +164,9 @@ func (km *Wallet) FetchValidatingKeys() ([][48]byte, error) {
 
 // Sign signs a message for the validator to broadcast.
 func (km *Wallet) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	km.lock.RLock()
 	account, exists := km.accounts[pubKey]
+	km.lock.RUnlock()
 	if !exists {
 		return nil, ErrNoSuchKey
 	}
@@ -38,6 +38,7 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
+	flags.GraffitiFileFlag,
 	flags.KeystorePathFlag,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
@@ -48,6 +49,20 @@ var appFlags = []cli.Flag{
 	flags.KeyManager,
 	flags.KeyManagerOpts,
 	flags.AccountMetricsFlag,
+	flags.RecordPerformanceFlag,
+	flags.KeyReloadIntervalFlag,
+	flags.DoppelgangerEpochsFlag,
+	flags.HADirFlag,
+	flags.HAInstanceFlag,
+	flags.HALeaseDurationFlag,
+	flags.ProtectionDBURLFlag,
+	flags.EnableAdminRPCFlag,
+	flags.AdminRPCHostFlag,
+	flags.AdminRPCPortFlag,
+	flags.AdminHTTPPortFlag,
+	flags.AdminTokenFileFlag,
+	flags.AdminTLSCertFlag,
+	flags.AdminTLSKeyFlag,
 	cmd.VerbosityFlag,
 	cmd.DataDirFlag,
 	cmd.ClearDB,
@@ -139,6 +154,51 @@ contract in order to activate the validator client`,
 				},
 			},
 		},
+		{
+			Name:     "exit",
+			Category: "accounts",
+			Usage:    "signs and proposes voluntary exits of validators, then waits until they have exited",
+			Description: `checks that the validators of the given public keys may exit in the current epoch, and
+after confirmation signs their voluntary exits with the keys of the key manager and proposes them to the
+beacon node. A validator cannot rejoin the active set after exiting.`,
+			Flags: []cli.Flag{
+				flags.ExitPublicKeysFlag,
+				flags.ForceExitFlag,
+				flags.BeaconRPCProviderFlag,
+				flags.CertFlag,
+				flags.KeyManager,
+				flags.KeyManagerOpts,
+				flags.KeystorePathFlag,
+				flags.PasswordFlag,
+				flags.UnencryptedKeysFlag,
+			},
+			Action: func(ctx *cli.Context) {
+				if err := node.ExitValidators(ctx); err != nil {
+					log.WithError(err).Fatal("Could not exit validators")
+				}
+			},
+		},
+		{
+			Name:     "report",
+			Category: "accounts",
+			Usage:    "summarizes the performance of the validating keys by day or week, as CSV or JSON",
+			Description: `reads the performance recorded in the validator database each epoch: attestations
+included or missed, inclusion distance, correct source, target and head votes, proposals missed or orphaned,
+aggregations and balance changes by a validator client running with --record-performance. The database is
+locked by a running validator client.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.ReportPeriodFlag,
+				flags.ReportFormatFlag,
+				flags.ReportSinceFlag,
+				flags.ReportOutputFlag,
+			},
+			Action: func(ctx *cli.Context) {
+				if err := node.GenerateReport(ctx); err != nil {
+					log.WithError(err).Fatal("Could not generate report")
+				}
+			},
+		},
 	}
 	app.Flags = appFlags
 
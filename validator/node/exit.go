@@ -0,0 +1,166 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// ExitValidators signs voluntary exits of the validators of the public keys given with the
+// --public-keys flag, proposes them to the beacon node and waits until the validators have exited.
+// The keys are loaded through the selected key manager, and every validator must be eligible to
+// exit in the current epoch.
+func ExitValidators(cliCtx *cli.Context) error {
+	configureChain(cliCtx)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		select {
+		case <-sigc:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	keyManager, err := selectKeyManager(cliCtx)
+	if err != nil {
+		return err
+	}
+	pubKeys, err := exitPublicKeys(cliCtx.String(flags.ExitPublicKeysFlag.Name), keyManager.FetchValidatingKeys)
+	if err != nil {
+		return err
+	}
+
+	conn, err := dialBeaconNode(ctx, cliCtx.String(flags.BeaconRPCProviderFlag.Name), cliCtx.String(flags.CertFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to the beacon node")
+		}
+	}()
+	beaconClient := ethpb.NewBeaconChainClient(conn)
+	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
+
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	epoch := head.HeadEpoch
+	for _, pubKey := range pubKeys {
+		val, err := beaconClient.GetValidator(ctx, &ethpb.GetValidatorRequest{
+			QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: pubKey[:]},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "could not get validator %#x", pubKey)
+		}
+		if err := client.CheckExitEligibility(val, epoch); err != nil {
+			return errors.Wrapf(err, "validator %#x cannot exit", pubKey)
+		}
+	}
+
+	if !cliCtx.Bool(flags.ForceExitFlag.Name) {
+		keys := make([]string, len(pubKeys))
+		for i, pubKey := range pubKeys {
+			keys[i] = fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))
+		}
+		actionText := fmt.Sprintf("Exiting validators %s in epoch %d. The validators leave the active set no earlier "+
+			"than epoch %d, and cannot rejoin or withdraw their balance before withdrawals are enabled. "+
+			"Do you want to continue? (Y/N)", strings.Join(keys, ", "), epoch, client.EarliestExitEpoch(epoch))
+		confirmed, err := cmd.ConfirmAction(actionText, "Voluntary exit cancelled")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	for _, pubKey := range pubKeys {
+		exit, err := client.ProposeExit(ctx, validatorClient, keyManager, pubKey, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not exit validator %#x", pubKey)
+		}
+		log.WithFields(logrus.Fields{
+			"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"validatorIndex": exit.Exit.ValidatorIndex,
+			"epoch":          exit.Exit.Epoch,
+		}).Info("Proposed voluntary exit")
+	}
+	for _, pubKey := range pubKeys {
+		if err := client.WaitForExit(ctx, beaconClient, pubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exitPublicKeys parses the comma-separated hex encoded public keys, which must all be validating
+// keys of the key manager.
+func exitPublicKeys(keys string, fetchValidatingKeys func() ([][48]byte, error)) ([][48]byte, error) {
+	if strings.TrimSpace(keys) == "" {
+		return nil, fmt.Errorf("%s is required", flags.ExitPublicKeysFlag.Name)
+	}
+	validatingKeys, err := fetchValidatingKeys()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating keys")
+	}
+	known := make(map[[48]byte]bool, len(validatingKeys))
+	for _, key := range validatingKeys {
+		known[key] = true
+	}
+	var pubKeys [][48]byte
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimPrefix(strings.TrimSpace(key), "0x")
+		b, err := hex.DecodeString(key)
+		if err != nil || len(b) != 48 {
+			return nil, fmt.Errorf("invalid public key %q", key)
+		}
+		pubKey := bytesutil.ToBytes48(b)
+		if !known[pubKey] {
+			return nil, fmt.Errorf("public key %#x is not a validating key of the key manager", pubKey)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+// dialBeaconNode connects to the beacon node at the endpoint, over TLS if a certificate is given.
+func dialBeaconNode(ctx context.Context, endpoint string, cert string) (*grpc.ClientConn, error) {
+	dialOpt := grpc.WithInsecure()
+	if cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get valid credentials")
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+	}
+	return conn, nil
+}
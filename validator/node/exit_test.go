@@ -0,0 +1,37 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestExitPublicKeys(t *testing.T) {
+	known := [48]byte{1}
+	other := [48]byte{2}
+	fetch := func() ([][48]byte, error) {
+		return [][48]byte{known}, nil
+	}
+
+	pubKeys, err := exitPublicKeys(fmt.Sprintf(" %#x ", known), fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubKeys) != 1 || pubKeys[0] != known {
+		t.Errorf("Unexpected public keys %#x", pubKeys)
+	}
+
+	tests := []struct {
+		keys    string
+		wantErr string
+	}{
+		{keys: "", wantErr: "is required"},
+		{keys: "0x1234", wantErr: "invalid public key"},
+		{keys: fmt.Sprintf("%#x,%#x", known, other), wantErr: "not a validating key"},
+	}
+	for _, tt := range tests {
+		if _, err := exitPublicKeys(tt.keys, fetch); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Expected error containing %q for %q, received %v", tt.wantErr, tt.keys, err)
+		}
+	}
+}
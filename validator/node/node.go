@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"sync"
 	"syscall"
@@ -25,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -67,18 +69,7 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		stop:     make(chan struct{}),
 	}
 
-	featureconfig.ConfigureValidator(ctx)
-	// Use custom config values if the --no-custom-config flag is set.
-	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
-		log.Info("Using custom parameter configuration")
-		if featureconfig.Get().MinimalConfig {
-			log.Warn("Using Minimal Config")
-			params.UseMinimalConfig()
-		} else {
-			log.Warn("Using Demo Config")
-			params.UseDemoBeaconConfig()
-		}
-	}
+	configureChain(ctx)
 
 	keyManager, err := selectKeyManager(ctx)
 	if err != nil {
@@ -120,6 +111,12 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(flags.EnableAdminRPCFlag.Name) {
+		if err := ValidatorClient.registerRPCService(ctx, keyManager); err != nil {
+			return nil, err
+		}
+	}
+
 	return ValidatorClient, nil
 }
 
@@ -182,18 +179,52 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 	dataDir := ctx.GlobalString(cmd.DataDirFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	emitAccountMetrics := ctx.GlobalBool(flags.AccountMetricsFlag.Name)
+	recordPerformance := ctx.GlobalBool(flags.RecordPerformanceFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	graffiti := ctx.GlobalString(flags.GraffitiFlag.Name)
+	graffitiFile := ctx.GlobalString(flags.GraffitiFileFlag.Name)
 	maxCallRecvMsgSize := ctx.GlobalInt(flags.GrpcMaxCallRecvMsgSizeFlag.Name)
+	keyReloadInterval := ctx.GlobalDuration(flags.KeyReloadIntervalFlag.Name)
+	doppelgangerEpochs := ctx.GlobalUint64(flags.DoppelgangerEpochsFlag.Name)
+	haDir := ctx.GlobalString(flags.HADirFlag.Name)
+	haInstance := ctx.GlobalString(flags.HAInstanceFlag.Name)
+	haLease := ctx.GlobalDuration(flags.HALeaseDurationFlag.Name)
+	protectionDBURL := ctx.GlobalString(flags.ProtectionDBURLFlag.Name)
+	if haDir != "" {
+		if haInstance == "" {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return errors.Wrap(err, "could not get hostname to name the instance of the high-availability group")
+			}
+			haInstance = hostname
+		}
+		if doppelgangerEpochs > 0 {
+			// The other clients of the group are expected to have signed with the keys.
+			log.Warn("Disabling doppelganger detection, which is not compatible with high-availability groups")
+			doppelgangerEpochs = 0
+		}
+	}
+	if haDir != "" || protectionDBURL != "" {
+		// Other clients may sign with the same keys, which is only safe with the shared histories.
+		log.Info("Enabling slashing protection of proposals and attestations with the shared histories")
+	}
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
 		EmitAccountMetrics:         emitAccountMetrics,
+		RecordPerformance:          recordPerformance,
 		CertFlag:                   cert,
 		GraffitiFlag:               graffiti,
+		GraffitiFile:               graffitiFile,
 		GrpcMaxCallRecvMsgSizeFlag: maxCallRecvMsgSize,
+		KeyReloadInterval:          keyReloadInterval,
+		DoppelgangerEpochs:         doppelgangerEpochs,
+		HADir:                      haDir,
+		HAInstance:                 haInstance,
+		HALease:                    haLease,
+		ProtectionDBURL:            protectionDBURL,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize client service")
@@ -201,6 +232,45 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 	return s.services.RegisterService(v)
 }
 
+func (s *ValidatorClient) registerRPCService(ctx *cli.Context, keyManager keymanager.KeyManager) error {
+	tokenFile := ctx.GlobalString(flags.AdminTokenFileFlag.Name)
+	if tokenFile == "" {
+		tokenFile = filepath.Join(ctx.GlobalString(cmd.DataDirFlag.Name), "admin.token")
+	}
+	var validatorService *client.ValidatorService
+	if err := s.services.FetchService(&validatorService); err != nil {
+		return err
+	}
+	service := rpc.NewService(context.Background(), &rpc.Config{
+		Host:             ctx.GlobalString(flags.AdminRPCHostFlag.Name),
+		Port:             ctx.GlobalInt(flags.AdminRPCPortFlag.Name),
+		HTTPPort:         ctx.GlobalInt(flags.AdminHTTPPortFlag.Name),
+		TokenFile:        tokenFile,
+		TLSCert:          ctx.GlobalString(flags.AdminTLSCertFlag.Name),
+		TLSKey:           ctx.GlobalString(flags.AdminTLSKeyFlag.Name),
+		KeyManager:       keyManager,
+		ValidatorService: validatorService,
+	})
+	return s.services.RegisterService(service)
+}
+
+// configureChain applies the feature flags and, unless the --no-custom-config flag is set, the
+// custom parameter configuration.
+func configureChain(ctx *cli.Context) {
+	featureconfig.ConfigureValidator(ctx)
+	// Use custom config values if the --no-custom-config flag is set.
+	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
+		log.Info("Using custom parameter configuration")
+		if featureconfig.Get().MinimalConfig {
+			log.Warn("Using Minimal Config")
+			params.UseMinimalConfig()
+		} else {
+			log.Warn("Using Demo Config")
+			params.UseDemoBeaconConfig()
+		}
+	}
+}
+
 // selectKeyManager selects the key manager depending on the options provided by the user.
 func selectKeyManager(ctx *cli.Context) (keymanager.KeyManager, error) {
 	manager := strings.ToLower(ctx.String(flags.KeyManager.Name))
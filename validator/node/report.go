@@ -0,0 +1,79 @@
+package node
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/report"
+	"github.com/urfave/cli"
+)
+
+// GenerateReport writes the summaries of the performance recorded in the validator database by
+// period, in the format given with the --format flag.
+func GenerateReport(cliCtx *cli.Context) error {
+	since, err := reportSince(cliCtx.String(flags.ReportSinceFlag.Name))
+	if err != nil {
+		return err
+	}
+	valDB, err := db.NewKVStore(cliCtx.String(cmd.DataDirFlag.Name), nil)
+	if err != nil {
+		return errors.Wrap(err, "could not open validator database")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+
+	ctx := context.Background()
+	keys, err := valDB.PerformanceKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read validator performance")
+	}
+	performances := make(map[[48]byte][]*dbpb.EpochPerformance, len(keys))
+	for _, pubKey := range keys {
+		performances[pubKey], err = valDB.Performance(ctx, pubKey[:], 0, math.MaxUint64)
+		if err != nil {
+			return errors.Wrapf(err, "could not read performance of %#x", pubKey)
+		}
+	}
+	summaries, err := report.Summarize(performances, cliCtx.String(flags.ReportPeriodFlag.Name), since)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if output := cliCtx.String(flags.ReportOutputFlag.Name); output != "" {
+		f, err := os.OpenFile(output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Wrap(err, "could not create report file")
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("Could not close report file")
+			}
+		}()
+		w = f
+	}
+	return report.Write(w, summaries, cliCtx.String(flags.ReportFormatFlag.Name))
+}
+
+// reportSince parses the first day of a report, or returns the zero time if there is none.
+func reportSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("2006-01-02", since)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid %s", flags.ReportSinceFlag.Name)
+	}
+	return t, nil
+}
@@ -0,0 +1,204 @@
+// Package report summarizes the performance of validating keys recorded in the validator database
+// by day or by week, as CSV or JSON.
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+)
+
+// Periods of the summaries.
+const (
+	Daily  = "daily"
+	Weekly = "weekly"
+)
+
+// Formats of the reports.
+const (
+	CSV  = "csv"
+	JSON = "json"
+)
+
+// Summary of the performance of a validator over a period.
+type Summary struct {
+	// PeriodStart is the start of the day or week, in UTC.
+	PeriodStart time.Time `json:"period_start"`
+	// PublicKey of the validator, hex encoded.
+	PublicKey string `json:"public_key"`
+	// Epochs of the period with accounted attestation performance.
+	Epochs uint64 `json:"epochs"`
+	// AttestationsIncluded and AttestationsMissed in the accounted epochs.
+	AttestationsIncluded uint64 `json:"attestations_included"`
+	AttestationsMissed   uint64 `json:"attestations_missed"`
+	// AverageInclusionDistance of the included attestations, in slots.
+	AverageInclusionDistance float64 `json:"average_inclusion_distance"`
+	// CorrectSource, CorrectTarget and CorrectHead are the numbers of included attestations with a
+	// correct vote.
+	CorrectSource uint64 `json:"correct_source"`
+	CorrectTarget uint64 `json:"correct_target"`
+	CorrectHead   uint64 `json:"correct_head"`
+	// Proposals assigned, of which ProposalsMissed were not proposed and ProposalsOrphaned did not
+	// make it into the chain.
+	Proposals         uint64 `json:"proposals"`
+	ProposalsMissed   uint64 `json:"proposals_missed"`
+	ProposalsOrphaned uint64 `json:"proposals_orphaned"`
+	// Aggregations submitted.
+	Aggregations uint64 `json:"aggregations"`
+	// BalanceDelta over the accounted epochs, in Gwei.
+	BalanceDelta int64 `json:"balance_delta"`
+
+	inclusionDistances uint64
+}
+
+// Summarize the performance of the validators by period, ignoring the epochs starting before
+// since. The summaries are ordered by period, then by public key.
+func Summarize(performances map[[48]byte][]*dbpb.EpochPerformance, period string, since time.Time) ([]*Summary, error) {
+	if period != Daily && period != Weekly {
+		return nil, fmt.Errorf("unknown period %q, expected %s or %s", period, Daily, Weekly)
+	}
+	type summaryKey struct {
+		start  int64
+		pubKey [48]byte
+	}
+	summaries := make(map[summaryKey]*Summary)
+	for pubKey, epochs := range performances {
+		for _, p := range epochs {
+			startTime := time.Unix(int64(p.StartTime), 0).UTC()
+			if startTime.Before(since) {
+				continue
+			}
+			start := periodStart(startTime, period)
+			key := summaryKey{start: start.Unix(), pubKey: pubKey}
+			s, ok := summaries[key]
+			if !ok {
+				s = &Summary{PeriodStart: start, PublicKey: fmt.Sprintf("%#x", pubKey)}
+				summaries[key] = s
+			}
+			s.add(p)
+		}
+	}
+
+	res := make([]*Summary, 0, len(summaries))
+	for _, s := range summaries {
+		if s.AttestationsIncluded > 0 {
+			s.AverageInclusionDistance = float64(s.inclusionDistances) / float64(s.AttestationsIncluded)
+		}
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].PeriodStart.Equal(res[j].PeriodStart) {
+			return res[i].PeriodStart.Before(res[j].PeriodStart)
+		}
+		return res[i].PublicKey < res[j].PublicKey
+	})
+	return res, nil
+}
+
+func (s *Summary) add(p *dbpb.EpochPerformance) {
+	if p.Accounted {
+		s.Epochs++
+		if p.AttestationIncluded {
+			s.AttestationsIncluded++
+			s.inclusionDistances += p.InclusionDistance
+			if p.CorrectSource {
+				s.CorrectSource++
+			}
+			if p.CorrectTarget {
+				s.CorrectTarget++
+			}
+			if p.CorrectHead {
+				s.CorrectHead++
+			}
+		} else {
+			s.AttestationsMissed++
+		}
+		s.BalanceDelta += int64(p.BalanceAfter) - int64(p.BalanceBefore)
+	}
+	for _, proposal := range p.Proposals {
+		s.Proposals++
+		if len(proposal.BlockRoot) == 0 {
+			s.ProposalsMissed++
+		} else if proposal.Orphaned {
+			s.ProposalsOrphaned++
+		}
+	}
+	s.Aggregations += p.Aggregations
+}
+
+// periodStart returns the start of the day, or of the week starting on Monday, of the time.
+func periodStart(t time.Time, period string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if period == Daily {
+		return day
+	}
+	// Days since Monday.
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// Write the summaries in the format.
+func Write(w io.Writer, summaries []*Summary, format string) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, summaries)
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(summaries)
+	default:
+		return fmt.Errorf("unknown format %q, expected %s or %s", format, CSV, JSON)
+	}
+}
+
+func writeCSV(w io.Writer, summaries []*Summary) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		"period_start",
+		"public_key",
+		"epochs",
+		"attestations_included",
+		"attestations_missed",
+		"average_inclusion_distance",
+		"correct_source",
+		"correct_target",
+		"correct_head",
+		"proposals",
+		"proposals_missed",
+		"proposals_orphaned",
+		"aggregations",
+		"balance_delta",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	u := func(n uint64) string { return strconv.FormatUint(n, 10) }
+	for _, s := range summaries {
+		if err := cw.Write([]string{
+			s.PeriodStart.Format("2006-01-02"),
+			s.PublicKey,
+			u(s.Epochs),
+			u(s.AttestationsIncluded),
+			u(s.AttestationsMissed),
+			strconv.FormatFloat(s.AverageInclusionDistance, 'f', 2, 64),
+			u(s.CorrectSource),
+			u(s.CorrectTarget),
+			u(s.CorrectHead),
+			u(s.Proposals),
+			u(s.ProposalsMissed),
+			u(s.ProposalsOrphaned),
+			u(s.Aggregations),
+			strconv.FormatInt(s.BalanceDelta, 10),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
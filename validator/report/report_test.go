@@ -0,0 +1,138 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/validator/db"
+)
+
+// Wednesday 2020-04-01 and Monday 2020-04-06, at noon UTC.
+var (
+	wednesday = uint64(time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC).Unix())
+	monday    = uint64(time.Date(2020, 4, 6, 12, 0, 0, 0, time.UTC).Unix())
+)
+
+func testPerformances() map[[48]byte][]*dbpb.EpochPerformance {
+	return map[[48]byte][]*dbpb.EpochPerformance{
+		{1}: {
+			{
+				Epoch:               1,
+				StartTime:           wednesday,
+				Accounted:           true,
+				AttestationIncluded: true,
+				InclusionDistance:   1,
+				CorrectSource:       true,
+				CorrectTarget:       true,
+				CorrectHead:         true,
+				BalanceBefore:       100,
+				BalanceAfter:        110,
+				Proposals:           []*dbpb.Proposal{{Slot: 40, BlockRoot: []byte{1}, Orphaned: true}},
+				Aggregations:        2,
+			},
+			{
+				Epoch:               2,
+				StartTime:           wednesday + 384,
+				Accounted:           true,
+				AttestationIncluded: true,
+				InclusionDistance:   4,
+				CorrectSource:       true,
+				BalanceBefore:       110,
+				BalanceAfter:        115,
+				Proposals:           []*dbpb.Proposal{{Slot: 70}},
+			},
+			{
+				Epoch:         1000,
+				StartTime:     monday,
+				Accounted:     true,
+				BalanceBefore: 115,
+				BalanceAfter:  113,
+			},
+		},
+	}
+}
+
+func TestSummarize_Daily(t *testing.T) {
+	summaries, err := Summarize(testPerformances(), Daily, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("Expected 2 daily summaries, received %d", len(summaries))
+	}
+	s := summaries[0]
+	if !s.PeriodStart.Equal(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected period start %v", s.PeriodStart)
+	}
+	if s.Epochs != 2 || s.AttestationsIncluded != 2 || s.AttestationsMissed != 0 || s.AverageInclusionDistance != 2.5 ||
+		s.CorrectSource != 2 || s.CorrectTarget != 1 || s.CorrectHead != 1 {
+		t.Errorf("Unexpected attestation summary %+v", s)
+	}
+	if s.Proposals != 2 || s.ProposalsMissed != 1 || s.ProposalsOrphaned != 1 || s.Aggregations != 2 || s.BalanceDelta != 15 {
+		t.Errorf("Unexpected summary %+v", s)
+	}
+	s = summaries[1]
+	if s.Epochs != 1 || s.AttestationsMissed != 1 || s.BalanceDelta != -2 {
+		t.Errorf("Unexpected summary %+v", s)
+	}
+}
+
+func TestSummarize_WeeklySince(t *testing.T) {
+	summaries, err := Summarize(testPerformances(), Weekly, time.Date(2020, 4, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 1 || !summaries[0].PeriodStart.Equal(time.Date(2020, 4, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected only the week starting on Monday 2020-04-06, received %v", summaries)
+	}
+
+	summaries, err = Summarize(testPerformances(), Weekly, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 2 || !summaries[0].PeriodStart.Equal(time.Date(2020, 3, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the weeks starting on Monday 2020-03-30 and 2020-04-06, received %v", summaries)
+	}
+
+	if _, err := Summarize(testPerformances(), "monthly", time.Time{}); err == nil {
+		t.Error("Expected an error for an unknown period")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	summaries, err := Summarize(testPerformances(), Daily, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, summaries, CSV); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "period_start,public_key,") {
+		t.Fatalf("Unexpected CSV report %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[1], "2020-04-01,0x01") || !strings.HasSuffix(lines[1], ",2,2,0,2.50,2,1,1,2,1,1,2,15") {
+		t.Errorf("Unexpected CSV row %q", lines[1])
+	}
+
+	buf.Reset()
+	if err := Write(&buf, summaries, JSON); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []*Summary
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || decoded[1].BalanceDelta != -2 {
+		t.Errorf("Unexpected JSON report %s", buf.String())
+	}
+
+	if err := Write(&buf, summaries, "xml"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
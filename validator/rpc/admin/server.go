@@ -0,0 +1,348 @@
+// Package admin defines the gRPC server through which a running validator client is inspected,
+// and its validating keys are managed.
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/admin"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "admin")
+
+// defaultHistoryEpochs is the number of epochs of history returned when none is requested.
+const defaultHistoryEpochs = 32
+
+// KeyPauser pauses and resumes the duties of validating keys.
+type KeyPauser interface {
+	PauseKey(pubKey [48]byte)
+	ResumeKey(pubKey [48]byte)
+	IsPaused(pubKey [48]byte) bool
+}
+
+// Server defines a server implementation of the gRPC Admin service, managing the keys of the
+// key manager the validator client validates with. Added and removed keys are picked up by the
+// validator at the start of the next epoch. Secret keys are only accepted over TLS.
+type Server struct {
+	KeyManager      keymanager.KeyManager
+	KeyPauser       KeyPauser
+	ValidatorDB     iface.ValidatorDB
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	BeaconClient    ethpb.BeaconChainClient
+	NodeClient      ethpb.NodeClient
+	Graffiti        *graffiti.Source
+	TLS             bool
+}
+
+// ListKeys returns the public keys the validator client validates with.
+func (s *Server) ListKeys(ctx context.Context, _ *ptypes.Empty) (*pb.ListKeysResponse, error) {
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	return &pb.ListKeysResponse{PublicKeys: bytesutil.FromBytes48Array(keys)}, nil
+}
+
+// AddKey adds a secret key to validate with. The key is refused unless the server serves over
+// TLS, so that it is never sent in the clear.
+func (s *Server) AddKey(ctx context.Context, req *pb.AddKeyRequest) (*pb.AddKeyResponse, error) {
+	if !s.TLS {
+		return nil, status.Error(codes.FailedPrecondition, "Adding keys requires the admin RPC server to use TLS")
+	}
+	km, err := s.dynamicKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	sk, err := bls.SecretKeyFromBytes(req.SecretKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid secret key: %v", err)
+	}
+	pubKey, err := km.AddKey(sk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not add key: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Added validating key")
+	return &pb.AddKeyResponse{PublicKey: pubKey[:]}, nil
+}
+
+// RemoveKey stops validating with the key of the public key.
+func (s *Server) RemoveKey(ctx context.Context, req *pb.RemoveKeyRequest) (*ptypes.Empty, error) {
+	km, err := s.dynamicKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := km.RemoveKey(pubKey); err != nil {
+		if err == keymanager.ErrNoSuchKey {
+			return nil, status.Errorf(codes.NotFound, "Not validating with key %#x", pubKey)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not remove key: %v", err)
+	}
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Removed validating key")
+	return &ptypes.Empty{}, nil
+}
+
+// ListValidators of the validating keys, with their status and duties in the current epoch.
+func (s *Server) ListValidators(ctx context.Context, _ *ptypes.Empty) (*pb.ValidatorsResponse, error) {
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	head, err := s.BeaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get chain head: %v", err)
+	}
+	res := &pb.ValidatorsResponse{
+		Epoch:      head.HeadEpoch,
+		Validators: make([]*pb.Validator, len(keys)),
+	}
+	if len(keys) == 0 {
+		return res, nil
+	}
+	duties, err := s.ValidatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      head.HeadEpoch,
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get duties: %v", err)
+	}
+	dutyByKey := make(map[[48]byte]*ethpb.DutiesResponse_Duty, len(duties.Duties))
+	for _, duty := range duties.Duties {
+		dutyByKey[bytesutil.ToBytes48(duty.PublicKey)] = duty
+	}
+	for i, pubKey := range keys {
+		val := &pb.Validator{
+			PublicKey: pubKey[:],
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS.String(),
+			Paused:    s.KeyPauser.IsPaused(pubKey),
+		}
+		if duty, ok := dutyByKey[pubKey]; ok {
+			val.Index = duty.ValidatorIndex
+			val.Status = duty.Status.String()
+			if duty.Status == ethpb.ValidatorStatus_ACTIVE {
+				val.AttesterSlot = duty.AttesterSlot
+				val.CommitteeIndex = duty.CommitteeIndex
+				val.ProposerSlot = duty.ProposerSlot
+			}
+		}
+		res.Validators[i] = val
+	}
+	return res, nil
+}
+
+// GetHistory of the attestations and proposals signed with the key in the most recent epochs.
+func (s *Server) GetHistory(ctx context.Context, req *pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	pubKey, err := publicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = defaultHistoryEpochs
+	}
+	if wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod; epochs > wsPeriod {
+		epochs = wsPeriod
+	}
+	attHistory, err := s.ValidatorDB.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get attestation history: %v", err)
+	}
+	proHistory, err := s.ValidatorDB.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposal history: %v", err)
+	}
+	if attHistory == nil || proHistory == nil {
+		return nil, status.Errorf(codes.NotFound, "No history for key %#x", pubKey)
+	}
+
+	res := &pb.HistoryResponse{
+		LatestAttestationEpoch: attHistory.LatestEpochWritten,
+		LatestProposalEpoch:    proHistory.LatestEpochWritten,
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	for i := uint64(0); i < epochs && i <= attHistory.LatestEpochWritten; i++ {
+		target := attHistory.LatestEpochWritten - i
+		source, ok := attHistory.TargetToSource[target%wsPeriod]
+		if !ok || source == farFuture {
+			continue
+		}
+		res.Attestations = append(res.Attestations, &pb.AttestationRecord{
+			SourceEpoch: source,
+			TargetEpoch: target,
+		})
+	}
+	for i := uint64(0); i < epochs && i <= proHistory.LatestEpochWritten; i++ {
+		epoch := proHistory.LatestEpochWritten - i
+		if client.HasProposedForEpoch(proHistory, epoch) {
+			res.ProposalEpochs = append(res.ProposalEpochs, epoch)
+		}
+	}
+	return res, nil
+}
+
+// PauseKey stops performing the duties of the key.
+func (s *Server) PauseKey(ctx context.Context, req *pb.PauseKeyRequest) (*ptypes.Empty, error) {
+	pubKey, err := s.validatingKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	s.KeyPauser.PauseKey(pubKey)
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Paused validating key")
+	return &ptypes.Empty{}, nil
+}
+
+// ResumeKey performs the duties of a paused key again.
+func (s *Server) ResumeKey(ctx context.Context, req *pb.ResumeKeyRequest) (*ptypes.Empty, error) {
+	pubKey, err := s.validatingKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	s.KeyPauser.ResumeKey(pubKey)
+	log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Info("Resumed validating key")
+	return &ptypes.Empty{}, nil
+}
+
+// ExitValidator signs a voluntary exit of the validator of the key in the epoch of the chain
+// head, and proposes it to the beacon node.
+func (s *Server) ExitValidator(ctx context.Context, req *pb.ExitRequest) (*pb.ExitResponse, error) {
+	pubKey, err := s.validatingKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	head, err := s.BeaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get chain head: %v", err)
+	}
+	exit, err := client.ProposeExit(ctx, s.ValidatorClient, s.KeyManager, pubKey, head.HeadEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not exit validator: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"pubKey":         fmt.Sprintf("%#x", pubKey),
+		"validatorIndex": exit.Exit.ValidatorIndex,
+		"epoch":          exit.Exit.Epoch,
+	}).Info("Proposed voluntary exit")
+	return &pb.ExitResponse{
+		ValidatorIndex: exit.Exit.ValidatorIndex,
+		Epoch:          exit.Exit.Epoch,
+	}, nil
+}
+
+// GetBeaconNodeHealth of the beacon node the validator client is connected to. A beacon node
+// which cannot be reached is reported as not connected, rather than as an error.
+func (s *Server) GetBeaconNodeHealth(ctx context.Context, _ *ptypes.Empty) (*pb.BeaconNodeHealth, error) {
+	notConnected := func(err error) *pb.BeaconNodeHealth {
+		return &pb.BeaconNodeHealth{Error: err.Error()}
+	}
+	syncStatus, err := s.NodeClient.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		return notConnected(err), nil
+	}
+	version, err := s.NodeClient.GetVersion(ctx, &ptypes.Empty{})
+	if err != nil {
+		return notConnected(err), nil
+	}
+	peers, err := s.NodeClient.ListPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		return notConnected(err), nil
+	}
+	head, err := s.BeaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return notConnected(err), nil
+	}
+	return &pb.BeaconNodeHealth{
+		Connected:      true,
+		Syncing:        syncStatus.Syncing,
+		Version:        version.Version,
+		Peers:          uint64(len(peers.Peers)),
+		HeadSlot:       head.HeadSlot,
+		FinalizedEpoch: head.FinalizedEpoch,
+	}, nil
+}
+
+// ListGraffiti of the validating keys, with where each graffiti is configured.
+func (s *Server) ListGraffiti(ctx context.Context, _ *ptypes.Empty) (*pb.GraffitiResponse, error) {
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	res := &pb.GraffitiResponse{Keys: make([]*pb.KeyGraffiti, len(keys))}
+	for i, pubKey := range keys {
+		template, origin := s.Graffiti.Template(pubKey)
+		res.Keys[i] = &pb.KeyGraffiti{
+			PublicKey: pubKey[:],
+			Template:  template,
+			Origin:    origin,
+		}
+	}
+	return res, nil
+}
+
+// SetGraffiti of the key, saving it to the graffiti file if there is one.
+func (s *Server) SetGraffiti(ctx context.Context, req *pb.SetGraffitiRequest) (*ptypes.Empty, error) {
+	pubKey, err := s.validatingKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if s.Graffiti == nil {
+		return nil, status.Error(codes.Unimplemented, "The validator client does not select graffiti")
+	}
+	if err := s.Graffiti.Set(pubKey, req.Graffiti); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not set graffiti: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"pubKey":   fmt.Sprintf("%#x", pubKey),
+		"graffiti": req.Graffiti,
+	}).Info("Set graffiti")
+	return &ptypes.Empty{}, nil
+}
+
+// validatingKey returns the public key, if it is a key the validator client validates with.
+func (s *Server) validatingKey(enc []byte) ([48]byte, error) {
+	pubKey, err := publicKey(enc)
+	if err != nil {
+		return pubKey, err
+	}
+	keys, err := s.KeyManager.FetchValidatingKeys()
+	if err != nil {
+		return pubKey, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	for _, key := range keys {
+		if key == pubKey {
+			return pubKey, nil
+		}
+	}
+	return pubKey, status.Errorf(codes.NotFound, "Not validating with key %#x", pubKey)
+}
+
+func publicKey(enc []byte) ([48]byte, error) {
+	if len(enc) != 48 {
+		return [48]byte{}, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(enc))
+	}
+	return bytesutil.ToBytes48(enc), nil
+}
+
+func (s *Server) dynamicKeyManager() (keymanager.Dynamic, error) {
+	km, ok := s.KeyManager.(keymanager.Dynamic)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "The key manager does not support adding or removing keys")
+	}
+	return km, nil
+}
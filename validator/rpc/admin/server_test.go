@@ -0,0 +1,333 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/admin"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type staticKeyManager struct {
+	keymanager.KeyManager
+}
+
+type pauser map[[48]byte]bool
+
+func (p pauser) PauseKey(pubKey [48]byte)      { p[pubKey] = true }
+func (p pauser) ResumeKey(pubKey [48]byte)     { delete(p, pubKey) }
+func (p pauser) IsPaused(pubKey [48]byte) bool { return p[pubKey] }
+
+type fakeBeaconChainClient struct {
+	ethpb.BeaconChainClient
+}
+
+func (f *fakeBeaconChainClient) GetChainHead(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: 330, HeadEpoch: 10, FinalizedEpoch: 8}, nil
+}
+
+type fakeValidatorClient struct {
+	ethpb.BeaconNodeValidatorClient
+	duties []*ethpb.DutiesResponse_Duty
+	exits  []*ethpb.SignedVoluntaryExit
+}
+
+func (f *fakeValidatorClient) GetDuties(context.Context, *ethpb.DutiesRequest, ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	return &ethpb.DutiesResponse{Duties: f.duties}, nil
+}
+
+func (f *fakeValidatorClient) ValidatorIndex(context.Context, *ethpb.ValidatorIndexRequest, ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	return &ethpb.ValidatorIndexResponse{Index: 3}, nil
+}
+
+func (f *fakeValidatorClient) DomainData(context.Context, *ethpb.DomainRequest, ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	return &ethpb.DomainResponse{}, nil
+}
+
+func (f *fakeValidatorClient) ProposeExit(_ context.Context, exit *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	f.exits = append(f.exits, exit)
+	return &ptypes.Empty{}, nil
+}
+
+type fakeNodeClient struct {
+	ethpb.NodeClient
+	err error
+}
+
+func (f *fakeNodeClient) GetSyncStatus(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ethpb.SyncStatus{Syncing: true}, nil
+}
+
+func (f *fakeNodeClient) GetVersion(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.Version, error) {
+	return &ethpb.Version{Version: "Prysm/Test"}, nil
+}
+
+func (f *fakeNodeClient) ListPeers(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.Peers, error) {
+	return &ethpb.Peers{Peers: []*ethpb.Peer{{}, {}}}, nil
+}
+
+// testServer returns a server validating with two keys, of which the first is active.
+func testServer(t *testing.T) (*Server, *fakeValidatorClient, [][48]byte) {
+	km := keymanager.NewDirect([]*bls.SecretKey{bls.RandKey(), bls.RandKey()})
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validatorClient := &fakeValidatorClient{
+		duties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:      keys[0][:],
+				ValidatorIndex: 3,
+				Status:         ethpb.ValidatorStatus_ACTIVE,
+				AttesterSlot:   325,
+				CommitteeIndex: 2,
+				ProposerSlot:   327,
+			},
+			{
+				PublicKey: keys[1][:],
+				Status:    ethpb.ValidatorStatus_PENDING,
+			},
+		},
+	}
+	return &Server{
+		KeyManager:      km,
+		KeyPauser:       pauser{},
+		ValidatorDB:     db.SetupDB(t, keys),
+		ValidatorClient: validatorClient,
+		BeaconClient:    &fakeBeaconChainClient{},
+		NodeClient:      &fakeNodeClient{},
+	}, validatorClient, keys
+}
+
+func TestServer_AddListRemoveKeys(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{KeyManager: keymanager.NewDirect(nil), TLS: true}
+
+	sk := bls.RandKey()
+	added, err := s.AddKey(ctx, &pb.AddKeyRequest{SecretKey: sk.Marshal()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := s.ListKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys.PublicKeys) != 1 || string(keys.PublicKeys[0]) != string(added.PublicKey) {
+		t.Errorf("Expected the added key to be listed, received %#x", keys.PublicKeys)
+	}
+
+	if _, err := s.RemoveKey(ctx, &pb.RemoveKeyRequest{PublicKey: added.PublicKey}); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = s.ListKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys.PublicKeys) != 0 {
+		t.Errorf("Expected no keys after removal, received %d", len(keys.PublicKeys))
+	}
+	_, err = s.RemoveKey(ctx, &pb.RemoveKeyRequest{PublicKey: added.PublicKey})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound removing an unknown key, received %v", err)
+	}
+}
+
+func TestServer_AddKey_InvalidSecretKey(t *testing.T) {
+	s := &Server{KeyManager: keymanager.NewDirect(nil), TLS: true}
+	_, err := s.AddKey(context.Background(), &pb.AddKeyRequest{SecretKey: []byte{1, 2, 3}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, received %v", err)
+	}
+}
+
+func TestServer_AddKey_RequiresTLS(t *testing.T) {
+	km := keymanager.NewDirect(nil)
+	s := &Server{KeyManager: km}
+	_, err := s.AddKey(context.Background(), &pb.AddKeyRequest{SecretKey: bls.RandKey().Marshal()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, received %v", err)
+	}
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("Expected no key to be added, received %d", len(keys))
+	}
+}
+
+func TestServer_StaticKeyManager(t *testing.T) {
+	s := &Server{KeyManager: &staticKeyManager{KeyManager: keymanager.NewDirect(nil)}, TLS: true}
+	_, err := s.AddKey(context.Background(), &pb.AddKeyRequest{SecretKey: bls.RandKey().Marshal()})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented, received %v", err)
+	}
+}
+
+func TestServer_ListValidators(t *testing.T) {
+	s, _, keys := testServer(t)
+	ctx := context.Background()
+	if _, err := s.PauseKey(ctx, &pb.PauseKeyRequest{PublicKey: keys[0][:]}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.ListValidators(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Epoch != 10 || len(res.Validators) != 2 {
+		t.Fatalf("Unexpected response %v", res)
+	}
+	active := res.Validators[0]
+	if bytesutil.ToBytes48(active.PublicKey) != keys[0] {
+		active = res.Validators[1]
+	}
+	if active.Index != 3 || active.Status != "ACTIVE" || !active.Paused || active.AttesterSlot != 325 || active.ProposerSlot != 327 {
+		t.Errorf("Unexpected active validator %v", active)
+	}
+
+	if _, err := s.ResumeKey(ctx, &pb.ResumeKeyRequest{PublicKey: keys[0][:]}); err != nil {
+		t.Fatal(err)
+	}
+	if s.KeyPauser.IsPaused(keys[0]) {
+		t.Error("Expected the key to be resumed")
+	}
+	unknown := bls.RandKey().PublicKey().Marshal()
+	if _, err := s.PauseKey(ctx, &pb.PauseKeyRequest{PublicKey: unknown}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound pausing an unknown key, received %v", err)
+	}
+}
+
+func TestServer_GetHistory(t *testing.T) {
+	s, _, keys := testServer(t)
+	ctx := context.Background()
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	farFuture := params.BeaconConfig().FarFutureEpoch
+
+	attHistory := &slashpb.AttestationHistory{
+		TargetToSource:     map[uint64]uint64{4 % wsPeriod: 3, 5 % wsPeriod: farFuture, 6 % wsPeriod: 5},
+		LatestEpochWritten: 6,
+	}
+	if err := s.ValidatorDB.SaveAttestationHistory(ctx, keys[0][:], attHistory); err != nil {
+		t.Fatal(err)
+	}
+	proHistory, err := s.ValidatorDB.ProposalHistory(ctx, keys[0][:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	proHistory = client.SetProposedForEpoch(proHistory, 2)
+	proHistory = client.SetProposedForEpoch(proHistory, 5)
+	if err := s.ValidatorDB.SaveProposalHistory(ctx, keys[0][:], proHistory); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := s.GetHistory(ctx, &pb.HistoryRequest{PublicKey: keys[0][:], Epochs: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Attestations) != 2 || res.Attestations[0].TargetEpoch != 6 || res.Attestations[0].SourceEpoch != 5 ||
+		res.Attestations[1].TargetEpoch != 4 || res.Attestations[1].SourceEpoch != 3 {
+		t.Errorf("Unexpected attestations %v", res.Attestations)
+	}
+	if res.LatestProposalEpoch != 5 || len(res.ProposalEpochs) != 1 || res.ProposalEpochs[0] != 5 {
+		t.Errorf("Expected only the proposal of epoch 5 in the last 3 epochs, received %v", res.ProposalEpochs)
+	}
+}
+
+func TestServer_ExitValidator(t *testing.T) {
+	s, validatorClient, keys := testServer(t)
+	res, err := s.ExitValidator(context.Background(), &pb.ExitRequest{PublicKey: keys[0][:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ValidatorIndex != 3 || res.Epoch != 10 {
+		t.Errorf("Unexpected exit %v", res)
+	}
+	if len(validatorClient.exits) != 1 {
+		t.Fatalf("Expected 1 proposed exit, received %d", len(validatorClient.exits))
+	}
+}
+
+func TestServer_GetBeaconNodeHealth(t *testing.T) {
+	s, _, _ := testServer(t)
+	res, err := s.GetBeaconNodeHealth(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.BeaconNodeHealth{
+		Connected:      true,
+		Syncing:        true,
+		Version:        "Prysm/Test",
+		Peers:          2,
+		HeadSlot:       330,
+		FinalizedEpoch: 8,
+	}
+	if !proto.Equal(want, res) {
+		t.Errorf("Wanted %v, received %v", want, res)
+	}
+
+	s.NodeClient = &fakeNodeClient{err: errors.New("connection refused")}
+	res, err = s.GetBeaconNodeHealth(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Connected || res.Error != "connection refused" {
+		t.Errorf("Expected an unreachable beacon node to be reported, received %v", res)
+	}
+}
+
+func TestServer_ListSetGraffiti(t *testing.T) {
+	s, _, keys := testServer(t)
+	ctx := context.Background()
+	source, err := graffiti.NewSource("", "flag graffiti")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Graffiti = source
+
+	if _, err := s.SetGraffiti(ctx, &pb.SetGraffitiRequest{PublicKey: keys[0][:], Graffiti: "validator {{.ValidatorIndex}}"}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.ListGraffiti(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Keys) != 2 {
+		t.Fatalf("Expected the graffiti of 2 keys, received %v", res.Keys)
+	}
+	for _, key := range res.Keys {
+		want := &pb.KeyGraffiti{PublicKey: key.PublicKey, Template: "flag graffiti", Origin: graffiti.OriginFlag}
+		if bytesutil.ToBytes48(key.PublicKey) == keys[0] {
+			want = &pb.KeyGraffiti{PublicKey: key.PublicKey, Template: "validator {{.ValidatorIndex}}", Origin: graffiti.OriginKey}
+		}
+		if !proto.Equal(key, want) {
+			t.Errorf("Wanted %v, received %v", want, key)
+		}
+	}
+
+	_, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{PublicKey: keys[1][:], Graffiti: "{{.Unknown"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument setting an invalid template, received %v", err)
+	}
+	unknown := bls.RandKey().PublicKey().Marshal()
+	_, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{PublicKey: unknown, Graffiti: "graffiti"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound setting the graffiti of an unknown key, received %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/admin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// httpPathPrefix is the path prefix of the JSON admin API.
+const httpPathPrefix = "/admin/v1/"
+
+// maxBodySize is the largest request body accepted by the JSON admin API.
+const maxBodySize = 1 << 20
+
+var marshaler = &jsonpb.Marshaler{EmitDefaults: true, OrigName: true}
+
+// httpRoute maps an HTTP method and path to a method of the admin server. Requests and
+// responses are the JSON encoding of the gRPC messages.
+type httpRoute struct {
+	method  string
+	path    string
+	request func() proto.Message
+	call    func(ctx context.Context, req proto.Message) (proto.Message, error)
+}
+
+func adminRoutes(srv pb.AdminServer) []httpRoute {
+	empty := func() proto.Message { return &ptypes.Empty{} }
+	return []httpRoute{
+		{http.MethodGet, "keys", empty, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.ListKeys(ctx, req.(*ptypes.Empty))
+		}},
+		{http.MethodPost, "keys", func() proto.Message { return &pb.AddKeyRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.AddKey(ctx, req.(*pb.AddKeyRequest))
+		}},
+		{http.MethodPost, "keys/remove", func() proto.Message { return &pb.RemoveKeyRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.RemoveKey(ctx, req.(*pb.RemoveKeyRequest))
+		}},
+		{http.MethodGet, "validators", empty, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.ListValidators(ctx, req.(*ptypes.Empty))
+		}},
+		{http.MethodPost, "validators/history", func() proto.Message { return &pb.HistoryRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.GetHistory(ctx, req.(*pb.HistoryRequest))
+		}},
+		{http.MethodPost, "validators/pause", func() proto.Message { return &pb.PauseKeyRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.PauseKey(ctx, req.(*pb.PauseKeyRequest))
+		}},
+		{http.MethodPost, "validators/resume", func() proto.Message { return &pb.ResumeKeyRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.ResumeKey(ctx, req.(*pb.ResumeKeyRequest))
+		}},
+		{http.MethodPost, "validators/exit", func() proto.Message { return &pb.ExitRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.ExitValidator(ctx, req.(*pb.ExitRequest))
+		}},
+		{http.MethodGet, "health", empty, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.GetBeaconNodeHealth(ctx, req.(*ptypes.Empty))
+		}},
+		{http.MethodGet, "graffiti", empty, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.ListGraffiti(ctx, req.(*ptypes.Empty))
+		}},
+		{http.MethodPost, "graffiti", func() proto.Message { return &pb.SetGraffitiRequest{} }, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return srv.SetGraffiti(ctx, req.(*pb.SetGraffitiRequest))
+		}},
+	}
+}
+
+// gateway serves the admin server as a JSON API under the path prefix. Callers authenticate
+// with the same bearer token as gRPC callers.
+type gateway struct {
+	service *Service
+	routes  []httpRoute
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	if err := g.service.authenticate(ctx); err != nil {
+		writeError(w, err)
+		return
+	}
+	path := r.URL.Path[len(httpPathPrefix):]
+	pathFound := false
+	for _, rt := range g.routes {
+		if rt.path != path {
+			continue
+		}
+		pathFound = true
+		if rt.method != r.Method {
+			continue
+		}
+		req := rt.request()
+		if r.Method == http.MethodPost {
+			if err := jsonpb.Unmarshal(http.MaxBytesReader(w, r.Body, maxBodySize), req); err != nil {
+				writeError(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err))
+				return
+			}
+		}
+		res, err := rt.call(ctx, req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := marshaler.Marshal(w, res); err != nil {
+			log.WithError(err).Debug("Could not write response")
+		}
+		return
+	}
+	if pathFound {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	writeError(w, status.Error(codes.NotFound, "Route not found"))
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.FailedPrecondition:
+		code = http.StatusConflict
+	case codes.Unimplemented:
+		code = http.StatusNotImplemented
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	}
+	writeJSONError(w, code, st.Message())
+}
+
+func writeJSONError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
+		log.WithError(err).Debug("Could not write error response")
+	}
+}
@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/admin"
+)
+
+type fakeAdminServer struct {
+	pb.UnimplementedAdminServer
+	exitRequests     []*pb.ExitRequest
+	graffitiRequests []*pb.SetGraffitiRequest
+}
+
+func (f *fakeAdminServer) ListKeys(context.Context, *ptypes.Empty) (*pb.ListKeysResponse, error) {
+	return &pb.ListKeysResponse{PublicKeys: [][]byte{{1, 2}}}, nil
+}
+
+func (f *fakeAdminServer) ExitValidator(_ context.Context, req *pb.ExitRequest) (*pb.ExitResponse, error) {
+	f.exitRequests = append(f.exitRequests, req)
+	return &pb.ExitResponse{ValidatorIndex: 4, Epoch: 10}, nil
+}
+
+func (f *fakeAdminServer) SetGraffiti(_ context.Context, req *pb.SetGraffitiRequest) (*ptypes.Empty, error) {
+	f.graffitiRequests = append(f.graffitiRequests, req)
+	return &ptypes.Empty{}, nil
+}
+
+func serveGateway(g *gateway, method string, path string, token string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	return w
+}
+
+func TestGateway(t *testing.T) {
+	srv := &fakeAdminServer{}
+	g := &gateway{service: &Service{token: "secret"}, routes: adminRoutes(srv)}
+
+	if w := serveGateway(g, http.MethodGet, "/admin/v1/keys", "", ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("Wanted status %d without a token, received %d", http.StatusUnauthorized, w.Code)
+	}
+	w := serveGateway(g, http.MethodGet, "/admin/v1/keys", "secret", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, received %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var keys struct {
+		PublicKeys []string `json:"public_keys"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &keys); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys.PublicKeys) != 1 || keys.PublicKeys[0] != "AQI=" {
+		t.Errorf("Unexpected keys %s", w.Body.String())
+	}
+
+	w = serveGateway(g, http.MethodPost, "/admin/v1/validators/exit", "secret", `{"public_key":"AQI="}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, received %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if len(srv.exitRequests) != 1 || string(srv.exitRequests[0].PublicKey) != "\x01\x02" {
+		t.Errorf("Unexpected exit requests %v", srv.exitRequests)
+	}
+	if !strings.Contains(w.Body.String(), `"validator_index":"4"`) {
+		t.Errorf("Unexpected exit response %s", w.Body.String())
+	}
+
+	w = serveGateway(g, http.MethodPost, "/admin/v1/graffiti", "secret", `{"public_key":"AQI=","graffiti":"validator {{.ValidatorIndex}}"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Wanted status %d, received %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if len(srv.graffitiRequests) != 1 || srv.graffitiRequests[0].Graffiti != "validator {{.ValidatorIndex}}" {
+		t.Errorf("Unexpected graffiti requests %v", srv.graffitiRequests)
+	}
+
+	if w := serveGateway(g, http.MethodPost, "/admin/v1/validators/exit", "secret", `{"public_key":`); w.Code != http.StatusBadRequest {
+		t.Errorf("Wanted status %d for an invalid body, received %d", http.StatusBadRequest, w.Code)
+	}
+	if w := serveGateway(g, http.MethodGet, "/admin/v1/validators/exit", "secret", ""); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Wanted status %d for an unsupported method, received %d", http.StatusMethodNotAllowed, w.Code)
+	}
+	if w := serveGateway(g, http.MethodPost, "/admin/v1/keys/remove", "secret", `{}`); w.Code != http.StatusNotImplemented {
+		t.Errorf("Wanted status %d for an unimplemented method, received %d", http.StatusNotImplemented, w.Code)
+	}
+	if w := serveGateway(g, http.MethodGet, "/admin/v1/unknown", "secret", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Wanted status %d for an unknown route, received %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,278 @@
+// Package rpc defines the gRPC server through which a running validator client is administered.
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/admin"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/rpc/admin"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "rpc")
+
+const bearerPrefix = "Bearer "
+
+// Service defining the admin RPC server of a validator client, and its JSON API. Callers
+// authenticate with an `authorization: Bearer <token>` header holding the token of the token file.
+// Secret keys are only accepted when the server is configured with a TLS certificate.
+type Service struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
+	host             string
+	port             int
+	httpPort         int
+	tokenFile        string
+	token            string
+	tlsCert          string
+	tlsKey           string
+	keyManager       keymanager.KeyManager
+	validatorService *client.ValidatorService
+	listener         net.Listener
+	grpcServer       *grpc.Server
+	httpServer       *http.Server
+	startErr         error
+}
+
+// Config options for the admin RPC server.
+type Config struct {
+	Host             string
+	Port             int
+	HTTPPort         int
+	TokenFile        string
+	TLSCert          string
+	TLSKey           string
+	KeyManager       keymanager.KeyManager
+	ValidatorService *client.ValidatorService
+}
+
+// NewService instantiates a new admin RPC service instance that will
+// be registered into a running validator client.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:              ctx,
+		cancel:           cancel,
+		host:             cfg.Host,
+		port:             cfg.Port,
+		httpPort:         cfg.HTTPPort,
+		tokenFile:        cfg.TokenFile,
+		tlsCert:          cfg.TLSCert,
+		tlsKey:           cfg.TLSKey,
+		keyManager:       cfg.KeyManager,
+		validatorService: cfg.ValidatorService,
+	}
+}
+
+// Start the admin RPC server.
+func (s *Service) Start() {
+	token, err := loadOrCreateToken(s.tokenFile)
+	if err != nil {
+		log.Errorf("Could not load admin RPC token: %v", err)
+		s.startErr = err
+		return
+	}
+	s.token = token
+	if (s.tlsCert == "") != (s.tlsKey == "") {
+		s.startErr = errors.New("admin RPC TLS requires both a certificate and a key")
+		log.Errorf("Could not start admin RPC: %v", s.startErr)
+		return
+	}
+	conn := s.validatorService.Connection()
+	if conn == nil {
+		s.startErr = errors.New("validator client is not connected to a beacon node")
+		log.Errorf("Could not start admin RPC: %v", s.startErr)
+		return
+	}
+	adminServer := &admin.Server{
+		KeyManager:      s.keyManager,
+		KeyPauser:       s.validatorService,
+		ValidatorDB:     s.validatorService.ValidatorDB(),
+		ValidatorClient: ethpb.NewBeaconNodeValidatorClient(conn),
+		BeaconClient:    ethpb.NewBeaconChainClient(conn),
+		NodeClient:      ethpb.NewNodeClient(conn),
+		Graffiti:        s.validatorService.Graffiti(),
+		TLS:             s.tlsCert != "",
+	}
+
+	address := fmt.Sprintf("%s:%d", s.host, s.port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+		s.startErr = err
+		return
+	}
+	s.listener = lis
+	log.WithFields(logrus.Fields{
+		"address":   address,
+		"tokenFile": s.tokenFile,
+	}).Info("Admin RPC listening on port")
+
+	opts := []grpc.ServerOption{
+		grpc.StreamInterceptor(middleware.ChainStreamServer(
+			recovery.StreamServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.StreamServerInterceptor,
+			s.authStreamInterceptor,
+		)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.UnaryServerInterceptor,
+			s.authUnaryInterceptor,
+		)),
+	}
+	if s.tlsCert != "" {
+		creds, err := credentials.NewServerTLSFromFile(s.tlsCert, s.tlsKey)
+		if err != nil {
+			log.Errorf("Could not load admin RPC TLS credentials: %v", err)
+			s.startErr = err
+			return
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		log.Warn("Admin RPC is not using TLS, adding keys is disabled. Pass the admin-tls-cert and admin-tls-key flags to enable it")
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	pb.RegisterAdminServer(s.grpcServer, adminServer)
+
+	go func() {
+		if s.listener != nil {
+			if err := s.grpcServer.Serve(s.listener); err != nil {
+				log.Errorf("Could not serve admin RPC: %v", err)
+			}
+		}
+	}()
+
+	if s.httpPort > 0 {
+		mux := http.NewServeMux()
+		mux.Handle(httpPathPrefix, &gateway{service: s, routes: adminRoutes(adminServer)})
+		httpAddress := fmt.Sprintf("%s:%d", s.host, s.httpPort)
+		s.httpServer = &http.Server{Addr: httpAddress, Handler: mux}
+		log.WithField("address", httpAddress).Info("Admin JSON API listening on port")
+		go func() {
+			var err error
+			if s.tlsCert != "" {
+				err = s.httpServer.ListenAndServeTLS(s.tlsCert, s.tlsKey)
+			} else {
+				err = s.httpServer.ListenAndServe()
+			}
+			if err != http.ErrServerClosed {
+				log.Errorf("Could not serve admin JSON API: %v", err)
+				s.startErr = err
+			}
+		}()
+	}
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.httpServer != nil {
+		if err := s.httpServer.Close(); err != nil {
+			log.WithError(err).Debug("Could not close admin JSON API server")
+		}
+	}
+	if s.listener != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of admin RPC server")
+	}
+	return nil
+}
+
+// Status returns nil or an error if the service failed to start.
+func (s *Service) Status() error {
+	return s.startErr
+}
+
+func (s *Service) authUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Service) authStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := s.authenticate(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authenticate returns an error unless the caller presents the admin token.
+func (s *Service) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get("authorization") {
+		if !strings.HasPrefix(auth, bearerPrefix) {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, bearerPrefix)), []byte(s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "a valid admin token is required")
+}
+
+// loadOrCreateToken reads the token of the token file, generating a random token readable by the
+// owner only if the file does not exist.
+func loadOrCreateToken(path string) (string, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err == nil {
+		token := strings.TrimSpace(string(enc))
+		if token == "" {
+			return "", errors.Errorf("token file %s is empty", path)
+		}
+		return token, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", errors.Wrap(err, "could not read token file")
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "could not generate token")
+	}
+	token := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+		return "", errors.Wrap(err, "could not write token file")
+	}
+	log.WithField("tokenFile", path).Info("Generated admin RPC token")
+	return token, nil
+}
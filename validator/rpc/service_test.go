@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadOrCreateToken(t *testing.T) {
+	dir := filepath.Join(testutil.TempDir(), "admintoken")
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Log(err)
+		}
+	}()
+	path := filepath.Join(dir, "admin.token")
+	token, err := loadOrCreateToken(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(token) != 64 {
+		t.Errorf("Expected a 32 byte hex token, received %q", token)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected the token file to be readable by the owner only, received mode %v", info.Mode())
+	}
+	loaded, err := loadOrCreateToken(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded != token {
+		t.Errorf("Expected the token of the token file %q, received %q", token, loaded)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadOrCreateToken(path); err == nil {
+		t.Error("Expected an empty token file to be rejected")
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	s := &Service{token: "secret"}
+	tests := []struct {
+		auth string
+		want codes.Code
+	}{
+		{"Bearer secret", codes.OK},
+		{"Bearer wrong", codes.Unauthenticated},
+		{"secret", codes.Unauthenticated},
+		{"", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.auth != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.auth))
+		}
+		if code := status.Code(s.authenticate(ctx)); code != tt.want {
+			t.Errorf("Expected %v for authorization %q, received %v", tt.want, tt.auth, code)
+		}
+	}
+}
@@ -83,8 +83,23 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisablePenaltyRewardLogFlag,
 			flags.UnencryptedKeysFlag,
 			flags.GraffitiFlag,
+			flags.GraffitiFileFlag,
 			flags.GrpcMaxCallRecvMsgSizeFlag,
 			flags.AccountMetricsFlag,
+			flags.RecordPerformanceFlag,
+			flags.KeyReloadIntervalFlag,
+			flags.DoppelgangerEpochsFlag,
+			flags.HADirFlag,
+			flags.HAInstanceFlag,
+			flags.HALeaseDurationFlag,
+			flags.ProtectionDBURLFlag,
+			flags.EnableAdminRPCFlag,
+			flags.AdminRPCHostFlag,
+			flags.AdminRPCPortFlag,
+			flags.AdminHTTPPortFlag,
+			flags.AdminTokenFileFlag,
+			flags.AdminTLSCertFlag,
+			flags.AdminTLSKeyFlag,
 		},
 	},
 	{